@@ -40,6 +40,7 @@ const (
 	LangGo Lang = iota
 	LangJava
 	LangObjC
+	LangTS
 )
 
 // Bind generates a Go wrapper around a contract ABI. This wrapper isn't meant
@@ -76,6 +77,7 @@ func Bind(types []string, abis []string, bytecodes []string, runtimebytecodes []
 			calls     = make(map[string]*tmplMethod)
 			transacts = make(map[string]*tmplMethod)
 			events    = make(map[string]*tmplEvent)
+			errs      = make(map[string]*tmplError)
 			fallback  *tmplMethod
 			receive   *tmplMethod
 
@@ -86,7 +88,12 @@ func Bind(types []string, abis []string, bytecodes []string, runtimebytecodes []
 			callIdentifiers     = make(map[string]bool)
 			transactIdentifiers = make(map[string]bool)
 			eventIdentifiers    = make(map[string]bool)
+			errorIdentifiers    = make(map[string]bool)
 		)
+		// All methods of a TypeScript binding are defined in a single class.
+		if lang == LangTS {
+			transactIdentifiers = callIdentifiers
+		}
 		for _, original := range evmABI.Methods {
 			// Normalize the method for capital cases and non-anonymous inputs/outputs
 			normalized := original
@@ -157,6 +164,28 @@ func Bind(types []string, abis []string, bytecodes []string, runtimebytecodes []
 			// Append the event to the accumulator list
 			events[original.Name] = &tmplEvent{Original: original, Normalized: normalized}
 		}
+		for _, original := range evmABI.Errors {
+			// Normalize the error for capital cases and non-anonymous inputs
+			normalized := original
+
+			// Ensure there is no duplicated identifier
+			normalizedName := capitalise(alias(aliases, original.Name))
+			if errorIdentifiers[normalizedName] {
+				return "", fmt.Errorf("duplicated identifier \"%s\"(normalized \"%s\"), use --alias for renaming", original.Name, normalizedName)
+			}
+			errorIdentifiers[normalizedName] = true
+			normalized.Name = normalizedName
+
+			normalized.Inputs = make([]abi.Argument, len(original.Inputs))
+			copy(normalized.Inputs, original.Inputs)
+			for _, input := range normalized.Inputs {
+				if hasStruct(input.Type) {
+					bindStructType[lang](input.Type, structs)
+				}
+			}
+			// Append the error to the accumulator list
+			errs[original.Name] = &tmplError{Original: original, Normalized: normalized}
+		}
 		// Add two special fallback functions if they exist
 		if evmABI.HasFallback() {
 			fallback = &tmplMethod{Original: evmABI.Fallback}
@@ -180,6 +209,7 @@ func Bind(types []string, abis []string, bytecodes []string, runtimebytecodes []
 			Fallback:        fallback,
 			Receive:         receive,
 			Events:          events,
+			Errors:          errs,
 			Libraries:       make(map[string]string),
 		}
 		// Function 4-byte signatures are stored in the same sequence
@@ -244,6 +274,7 @@ func Bind(types []string, abis []string, bytecodes []string, runtimebytecodes []
 var bindType = map[Lang]func(kind abi.Type, structs map[string]*tmplStruct) string{
 	LangGo:   bindTypeGo,
 	LangJava: bindTypeJava,
+	LangTS:   bindTypeTS,
 }
 
 // bindBasicTypeGo converts basic solidity types(except array, slice and tuple) to Go one.
@@ -366,6 +397,7 @@ func bindTypeJava(kind abi.Type, structs map[string]*tmplStruct) string {
 var bindTopicType = map[Lang]func(kind abi.Type, structs map[string]*tmplStruct) string{
 	LangGo:   bindTopicTypeGo,
 	LangJava: bindTopicTypeJava,
+	LangTS:   bindTopicTypeTS,
 }
 
 // bindTopicTypeGo converts a Solidity topic type to a Go one. It is almost the same
@@ -407,6 +439,7 @@ func bindTopicTypeJava(kind abi.Type, structs map[string]*tmplStruct) string {
 var bindStructType = map[Lang]func(kind abi.Type, structs map[string]*tmplStruct) string{
 	LangGo:   bindStructTypeGo,
 	LangJava: bindStructTypeJava,
+	LangTS:   bindStructTypeTS,
 }
 
 // bindStructTypeGo converts a Solidity tuple type to a Go one and records the mapping
@@ -485,11 +518,79 @@ func bindStructTypeJava(kind abi.Type, structs map[string]*tmplStruct) string {
 	}
 }
 
+// bindBasicTypeTS converts basic solidity types(except array, slice and tuple) to TypeScript one.
+func bindBasicTypeTS(kind abi.Type) string {
+	switch kind.T {
+	case abi.AddressTy, abi.StringTy, abi.FixedBytesTy, abi.BytesTy, abi.FunctionTy:
+		// Addresses and byte arrays are represented as hex strings.
+		return "string"
+	case abi.IntTy, abi.UintTy:
+		return "bigint"
+	case abi.BoolTy:
+		return "boolean"
+	default:
+		return "unknown"
+	}
+}
+
+// bindTypeTS converts a Solidity type to a TypeScript one.
+func bindTypeTS(kind abi.Type, structs map[string]*tmplStruct) string {
+	switch kind.T {
+	case abi.TupleTy:
+		return structs[kind.TupleRawName+kind.String()].Name
+	case abi.ArrayTy, abi.SliceTy:
+		return bindTypeTS(*kind.Elem, structs) + "[]"
+	default:
+		return bindBasicTypeTS(kind)
+	}
+}
+
+// bindTopicTypeTS converts a Solidity topic type to a TypeScript one. Indexed dynamic
+// types are represented as the hex string of their hashes, which is a string too.
+func bindTopicTypeTS(kind abi.Type, structs map[string]*tmplStruct) string {
+	if kind.T == abi.StringTy || kind.T == abi.BytesTy || kind.T == abi.TupleTy || kind.T == abi.SliceTy || kind.T == abi.ArrayTy {
+		return "string"
+	}
+	return bindTypeTS(kind, structs)
+}
+
+// bindStructTypeTS converts a Solidity tuple type to a TypeScript interface and records
+// the mapping in the given map.
+// Notably, this function will resolve and record nested struct recursively.
+func bindStructTypeTS(kind abi.Type, structs map[string]*tmplStruct) string {
+	switch kind.T {
+	case abi.TupleTy:
+		id := kind.TupleRawName + kind.String()
+		if s, exist := structs[id]; exist {
+			return s.Name
+		}
+		var fields []*tmplField
+		for i, elem := range kind.TupleElems {
+			field := bindStructTypeTS(*elem, structs)
+			fields = append(fields, &tmplField{Type: field, Name: kind.TupleRawNames[i], SolKind: *elem})
+		}
+		name := kind.TupleRawName
+		if name == "" {
+			name = fmt.Sprintf("Struct%d", len(structs))
+		}
+		structs[id] = &tmplStruct{
+			Name:   name,
+			Fields: fields,
+		}
+		return name
+	case abi.ArrayTy, abi.SliceTy:
+		return bindStructTypeTS(*kind.Elem, structs) + "[]"
+	default:
+		return bindBasicTypeTS(kind)
+	}
+}
+
 // namedType is a set of functions that transform language specific types to
 // named versions that my be used inside method names.
 var namedType = map[Lang]func(string, abi.Type) string{
 	LangGo:   func(string, abi.Type) string { panic("this shouldn't be needed") },
 	LangJava: namedTypeJava,
+	LangTS:   func(string, abi.Type) string { panic("this shouldn't be needed") },
 }
 
 // namedTypeJava converts some primitive data types to named variants that can
@@ -532,6 +633,7 @@ func alias(aliases map[string]string, n string) string {
 var methodNormalizer = map[Lang]func(string) string{
 	LangGo:   abi.ToCamelCase,
 	LangJava: decapitalise,
+	LangTS:   decapitalise,
 }
 
 // capitalise makes a camel-case string which starts with an upper case character.
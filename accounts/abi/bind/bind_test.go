@@ -2184,3 +2184,61 @@ public class Test {
 		}
 	}
 }
+
+const customErrorABI = `
+[
+	{"type":"constructor","inputs":[{"name":"supply","type":"uint256"}]},
+	{"type":"function","name":"balanceOf","stateMutability":"view","inputs":[{"name":"owner","type":"address"}],"outputs":[{"name":"","type":"uint256"}]},
+	{"type":"function","name":"info","stateMutability":"view","inputs":[],"outputs":[{"name":"name","type":"string"},{"name":"total","type":"uint256"}]},
+	{"type":"function","name":"transfer","stateMutability":"nonpayable","inputs":[{"name":"to","type":"address"},{"name":"amount","type":"uint256"}],"outputs":[{"name":"","type":"bool"}]},
+	{"type":"event","name":"Transfer","anonymous":false,"inputs":[{"name":"from","type":"address","indexed":true},{"name":"to","type":"address","indexed":true},{"name":"memo","type":"string","indexed":true},{"name":"amount","type":"uint256","indexed":false}]},
+	{"type":"error","name":"InsufficientBalance","inputs":[{"name":"available","type":"uint256"},{"name":"required","type":"uint256"}]},
+	{"type":"error","name":"Unauthorized","inputs":[{"name":"caller","type":"address"}]}
+]
+`
+
+// Tests that the Go bindings contain the helpers unpacking the custom errors.
+func TestGolangCustomErrorBindings(t *testing.T) {
+	code, err := Bind([]string{"Token"}, []string{customErrorABI}, []string{"0x6080"}, []string{""}, nil, "bindtest", LangGo, nil, nil)
+	if err != nil {
+		t.Fatalf("failed to generate binding: %v", err)
+	}
+	for _, want := range []string{
+		"type TokenInsufficientBalanceError struct",
+		"func (_Token *Token) UnpackInsufficientBalance(data []byte) (*TokenInsufficientBalanceError, error)",
+		"func (_Token *Token) UnpackUnauthorized(data []byte) (*TokenUnauthorizedError, error)",
+		"func (_Token *Token) UnpackError(data []byte) (interface{}, error)",
+		"parsed.Errors[\"Unauthorized\"].Unpack(&out.Caller, data)",
+	} {
+		if !strings.Contains(code, want) {
+			t.Errorf("binding missing %q", want)
+		}
+	}
+}
+
+// Tests that the TypeScript bindings are generated with the typed methods, filterers and errors.
+func TestTypeScriptBindings(t *testing.T) {
+	code, err := Bind([]string{"Token"}, []string{customErrorABI}, []string{"0x6080"}, []string{""}, nil, "", LangTS, nil, nil)
+	if err != nil {
+		t.Fatalf("failed to generate binding: %v", err)
+	}
+	for _, want := range []string{
+		`import { Indexed, Interface, Result } from "ethers";`,
+		`export const TokenBin = "0x6080";`,
+		"export class Token extends BoundContract {",
+		"static async deploy(provider: KlaytnProvider, opts: TransactOptions, arg0: bigint): Promise<string>",
+		"async balanceOf(owner: string, opts: CallOptions = {}): Promise<bigint>",
+		"async info(opts: CallOptions = {}): Promise<{ name: string; total: bigint; }>",
+		"async transfer(to: string, amount: bigint, opts: TransactOptions): Promise<string>",
+		`this.transactRaw("transfer(address,uint256)", [to, amount], opts)`,
+		"async filterTransfer(opts: FilterOptions = {}, from?: string | string[] | null, to?: string | string[] | null, memo?: string | string[] | null): Promise<TokenTransferEvent[]>",
+		"parseTransfer(log: KlaytnLog): TokenTransferEvent | null",
+		"| TokenInsufficientBalanceError\n\t\t| TokenUnauthorizedError;",
+		"decodeError(data: string): TokenError | null",
+		`case "InsufficientBalance(uint256,uint256)":`,
+	} {
+		if !strings.Contains(code, want) {
+			t.Errorf("binding missing %q", want)
+		}
+	}
+}
@@ -43,6 +43,7 @@ type tmplContract struct {
 	Fallback        *tmplMethod            // Additional special fallback function
 	Receive         *tmplMethod            // Additional special receive function
 	Events          map[string]*tmplEvent  // Contract events accessors
+	Errors          map[string]*tmplError  // Contract custom errors accessors
 	Libraries       map[string]string      // Same as tmplData, but filtered to only keep what the contract needs
 	Library         bool                   // Indicator whether the contract is a library
 }
@@ -62,6 +63,13 @@ type tmplEvent struct {
 	Normalized abi.Event // Normalized version of the parsed fields
 }
 
+// tmplError is a wrapper around an abi.Error that contains a few preprocessed
+// and cached data fields.
+type tmplError struct {
+	Original   abi.Error // Original error as parsed by the abi package
+	Normalized abi.Error // Normalized version of the parsed fields
+}
+
 // tmplField is a wrapper around a struct field with binding language
 // struct type definition and relative filed name.
 type tmplField struct {
@@ -82,6 +90,7 @@ type tmplStruct struct {
 var tmplSource = map[Lang]string{
 	LangGo:   tmplSourceGo,
 	LangJava: tmplSourceJava,
+	LangTS:   tmplSourceTS,
 }
 
 // tmplSourceGo is the Go source template that the generated Go contract binding
@@ -424,6 +433,53 @@ var (
 		}
 	{{end}}
 
+	{{range .Errors}}
+		// {{$contract.Type}}{{.Normalized.Name}}Error represents a {{.Original.RawName}} error raised by the {{$contract.Type}} contract.
+		type {{$contract.Type}}{{.Normalized.Name}}Error struct { {{range .Normalized.Inputs}}
+			{{capitalise .Name}} {{bindtype .Type $structs}}; {{end}}
+		}
+
+		// Unpack{{.Normalized.Name}} unpacks the revert data of the custom error 0x{{printf "%x" .Original.Selector}}.
+		//
+		// Solidity: {{.Original.String}}
+		func (_{{$contract.Type}} *{{$contract.Type}}) Unpack{{.Normalized.Name}}(data []byte) (*{{$contract.Type}}{{.Normalized.Name}}Error, error) {
+			parsed, err := {{$contract.Type}}MetaData.GetAbi()
+			if err != nil {
+				return nil, err
+			}
+			out := new({{$contract.Type}}{{.Normalized.Name}}Error)
+			if err := parsed.Errors["{{.Original.Name}}"].Unpack({{if eq (len .Normalized.Inputs) 1}}{{range .Normalized.Inputs}}&out.{{capitalise .Name}}{{end}}{{else}}out{{end}}, data); err != nil {
+				return nil, err
+			}
+			return out, nil
+		}
+	{{end}}
+
+	{{if .Errors}}
+		// UnpackError unpacks the revert data into the custom error matching the selector.
+		// The returned value is a pointer to one of the error types of the {{$contract.Type}} contract.
+		func (_{{$contract.Type}} *{{$contract.Type}}) UnpackError(data []byte) (interface{}, error) {
+			parsed, err := {{$contract.Type}}MetaData.GetAbi()
+			if err != nil {
+				return nil, err
+			}
+			e, err := parsed.ErrorByID(data)
+			if err != nil {
+				return nil, err
+			}
+			switch e.Name {
+			{{range .Errors}}case "{{.Original.Name}}":
+				out, err := _{{$contract.Type}}.Unpack{{.Normalized.Name}}(data)
+				if err != nil {
+					return nil, err
+				}
+				return out, nil
+			{{end}}
+			}
+			return nil, errors.New("unknown error: " + e.Sig)
+		}
+	{{end}}
+
 	{{range .Events}}
 		// {{$contract.Type}}{{.Normalized.Name}}Iterator is returned from Filter{{.Normalized.Name}} and is used to iterate over the raw logs and unpacked data for {{.Normalized.Name}} events raised by the {{$contract.Type}} contract.
 		type {{$contract.Type}}{{.Normalized.Name}}Iterator struct {
@@ -705,3 +761,345 @@ import java.util.*;
 }
 {{end}}
 `
+
+// tmplSourceTS is the TypeScript source template that the generated TypeScript contract
+// binding is based on. The binding encodes and decodes the data with ethers (v6) and
+// sends the requests to a Klaytn node through an EIP-1193 provider. Transactions are sent
+// from the accounts managed by the node, optionally as the Klaytn transaction types.
+const tmplSourceTS = `
+// Code generated - DO NOT EDIT.
+// This file is a generated binding and any manual changes will be lost.
+/* eslint-disable */
+
+import { Indexed, Interface, Result } from "ethers";
+
+/** KlaytnTxType is the Klaytn transaction type used to send contract transactions. */
+export enum KlaytnTxType {
+	Legacy = 0x00,
+	SmartContractDeploy = 0x28,
+	FeeDelegatedSmartContractDeploy = 0x29,
+	FeeDelegatedSmartContractDeployWithRatio = 0x2a,
+	SmartContractExecution = 0x30,
+	FeeDelegatedSmartContractExecution = 0x31,
+	FeeDelegatedSmartContractExecutionWithRatio = 0x32,
+	EthereumAccessList = 0x7801,
+	EthereumDynamicFee = 0x7802,
+}
+
+/** KlaytnProvider is an EIP-1193 provider connected to a Klaytn node. */
+export interface KlaytnProvider {
+	request(args: { method: string; params?: unknown[] }): Promise<unknown>;
+}
+
+/** CallOptions is the options of a contract call. */
+export interface CallOptions {
+	from?: string;
+	value?: bigint;
+	blockTag?: string | bigint;
+}
+
+/**
+ * TransactOptions is the options of a contract transaction. The sender must be an account unlocked
+ * in the node. If the fee payer is given, the transaction is sent as a fee delegated transaction,
+ * whose fee payer must be unlocked in the node too.
+ */
+export interface TransactOptions {
+	from: string;
+	value?: bigint;
+	gas?: bigint;
+	gasPrice?: bigint;
+	maxFeePerGas?: bigint;
+	maxPriorityFeePerGas?: bigint;
+	nonce?: bigint;
+	txType?: KlaytnTxType;
+	feePayer?: string;
+	feeRatio?: number;
+}
+
+/** FilterOptions is the block range of a log filtering. */
+export interface FilterOptions {
+	fromBlock?: string | bigint;
+	toBlock?: string | bigint;
+}
+
+/** KlaytnLog is a log returned by klay_getLogs. */
+export interface KlaytnLog {
+	address: string;
+	topics: string[];
+	data: string;
+	blockNumber: string;
+	blockHash: string;
+	transactionHash: string;
+	transactionIndex: string;
+	logIndex: string;
+	removed?: boolean;
+}
+
+const toQuantity = (value: bigint | number): string => "0x" + BigInt(value).toString(16);
+
+const toBlockTag = (block?: string | bigint): string =>
+	block === undefined ? "latest" : typeof block === "bigint" ? toQuantity(block) : block;
+
+// normalize converts the decoded values of ethers into plain values. Tuples with named
+// components become objects, and the indexed dynamic values become their hashes.
+function normalize(value: any): any {
+	if (value instanceof Indexed) {
+		return value.hash;
+	}
+	if (value instanceof Result) {
+		if (value.length === 0) {
+			return [];
+		}
+		try {
+			const obj: any = value.toObject();
+			for (const key of Object.keys(obj)) {
+				obj[key] = normalize(obj[key]);
+			}
+			return obj;
+		} catch {
+			return Array.from(value, normalize);
+		}
+	}
+	return value;
+}
+
+// sendTransaction sends the transaction from the account unlocked in the node. A fee delegated
+// transaction is signed by the sender first, and then sent by the fee payer.
+async function sendTransaction(provider: KlaytnProvider, tx: Record<string, unknown>, opts: TransactOptions, deploy: boolean): Promise<string> {
+	tx.from = opts.from;
+	for (const field of ["value", "gas", "gasPrice", "maxFeePerGas", "maxPriorityFeePerGas", "nonce"] as const) {
+		const value = opts[field];
+		if (value !== undefined) {
+			tx[field] = toQuantity(value);
+		}
+	}
+	if (opts.txType !== undefined) {
+		tx.typeInt = opts.txType;
+	}
+	if (opts.feePayer === undefined) {
+		if (tx.typeInt !== undefined && tx.value === undefined) {
+			tx.value = "0x0"; // value is required for Klaytn transaction types
+		}
+		return (await provider.request({ method: "klay_sendTransaction", params: [tx] })) as string;
+	}
+	tx.feePayer = opts.feePayer;
+	if (opts.feeRatio !== undefined) {
+		tx.feeRatio = opts.feeRatio;
+	}
+	if (tx.typeInt === undefined) {
+		if (deploy) {
+			tx.typeInt = opts.feeRatio !== undefined ? KlaytnTxType.FeeDelegatedSmartContractDeployWithRatio : KlaytnTxType.FeeDelegatedSmartContractDeploy;
+		} else {
+			tx.typeInt = opts.feeRatio !== undefined ? KlaytnTxType.FeeDelegatedSmartContractExecutionWithRatio : KlaytnTxType.FeeDelegatedSmartContractExecution;
+		}
+	}
+	if (tx.value === undefined) {
+		tx.value = "0x0";
+	}
+	const signed = (await provider.request({ method: "klay_signTransaction", params: [tx] })) as { tx: Record<string, unknown> };
+	for (const field of ["nonce", "gas", "gasPrice"]) {
+		tx[field] = signed.tx[field];
+	}
+	tx.signatures = signed.tx.signatures;
+	return (await provider.request({ method: "klay_sendTransactionAsFeePayer", params: [tx] })) as string;
+}
+
+/** BoundContract is the base of the generated contract bindings. */
+export class BoundContract {
+	readonly iface: Interface;
+
+	constructor(readonly address: string, protected readonly provider: KlaytnProvider, abi: ReadonlyArray<object>) {
+		this.iface = new Interface(abi as any);
+	}
+
+	protected async callRaw(signature: string, args: ReadonlyArray<unknown>, opts: CallOptions = {}): Promise<Result> {
+		const tx: Record<string, string> = { to: this.address, data: this.iface.encodeFunctionData(signature, args) };
+		if (opts.from !== undefined) {
+			tx.from = opts.from;
+		}
+		if (opts.value !== undefined) {
+			tx.value = toQuantity(opts.value);
+		}
+		let out: string;
+		try {
+			out = (await this.provider.request({ method: "klay_call", params: [tx, toBlockTag(opts.blockTag)] })) as string;
+		} catch (e) {
+			throw this.revertError(e);
+		}
+		if (out === "0x" && this.iface.getFunction(signature)!.outputs.length > 0) {
+			throw new Error("empty return data, the contract may not exist at " + this.address);
+		}
+		return this.iface.decodeFunctionResult(signature, out);
+	}
+
+	protected async transactRaw(signature: string, args: ReadonlyArray<unknown>, opts: TransactOptions): Promise<string> {
+		const tx = { to: this.address, data: this.iface.encodeFunctionData(signature, args) };
+		return sendTransaction(this.provider, tx, opts, false);
+	}
+
+	protected async filterRaw(signature: string, topics: ReadonlyArray<unknown>, opts: FilterOptions = {}): Promise<Array<{ args: Result; log: KlaytnLog }>> {
+		const filter = {
+			address: this.address,
+			fromBlock: toBlockTag(opts.fromBlock),
+			toBlock: toBlockTag(opts.toBlock),
+			topics: this.iface.encodeFilterTopics(signature, topics),
+		};
+		const logs = (await this.provider.request({ method: "klay_getLogs", params: [filter] })) as KlaytnLog[];
+		return logs.map((log) => ({ args: this.iface.decodeEventLog(signature, log.data, log.topics), log }));
+	}
+
+	protected parseRaw(signature: string, log: KlaytnLog): Result | null {
+		const event = this.iface.getEvent(signature)!;
+		if (log.topics.length === 0 || log.topics[0].toLowerCase() !== event.topicHash.toLowerCase()) {
+			return null;
+		}
+		return this.iface.decodeEventLog(event, log.data, log.topics);
+	}
+
+	// revertError replaces the error of a reverted call with the one describing the revert reason.
+	protected revertError(e: unknown): unknown {
+		const data = (e as { data?: unknown } | null)?.data;
+		if (typeof data === "string" && data.length >= 10) {
+			const decoded = this.iface.parseError(data);
+			if (decoded !== null) {
+				const err = new Error("execution reverted: " + decoded.signature) as Error & { revert?: unknown; data?: string };
+				err.revert = { name: decoded.name, args: normalize(decoded.args) };
+				err.data = data;
+				return err;
+			}
+		}
+		return e;
+	}
+}
+
+{{$structs := .Structs}}
+{{range $structs}}
+	/** {{.Name}} is an auto generated TypeScript binding around an user-defined struct. */
+	export interface {{.Name}} {
+	{{range $field := .Fields}}	{{$field.Name}}: {{$field.Type}};
+	{{end}}}
+{{end}}
+
+{{range $contract := .Contracts}}
+	/** {{.Type}}ABI is the input ABI used to generate the binding from. */
+	export const {{.Type}}ABI: ReadonlyArray<object> = JSON.parse("{{.InputABI}}");
+	{{if .InputBin}}
+	/** {{.Type}}Bin is the compiled bytecode used for deploying new contracts. */
+	export const {{.Type}}Bin = "0x{{.InputBin}}";
+	{{end}}
+
+	{{range .Events}}
+	/** {{$contract.Type}}{{capitalise .Normalized.Name}}Event represents a {{.Original.RawName}} event raised by the {{$contract.Type}} contract. */
+	export interface {{$contract.Type}}{{capitalise .Normalized.Name}}Event {
+	{{range .Normalized.Inputs}}	{{.Name}}: {{if .Indexed}}{{bindtopictype .Type $structs}}{{else}}{{bindtype .Type $structs}}{{end}};
+	{{end}}	raw: KlaytnLog;
+	}
+	{{end}}
+
+	{{range .Errors}}
+	/** {{$contract.Type}}{{.Normalized.Name}}Error represents a {{.Original.RawName}} error raised by the {{$contract.Type}} contract. */
+	export interface {{$contract.Type}}{{.Normalized.Name}}Error {
+		errorName: "{{.Normalized.Name}}";
+	{{range .Normalized.Inputs}}	{{.Name}}: {{bindtype .Type $structs}};
+	{{end}}}
+	{{end}}
+	{{if .Errors}}
+	/** {{.Type}}Error is one of the custom errors of the {{.Type}} contract. */
+	export type {{.Type}}Error ={{range .Errors}}
+		| {{$contract.Type}}{{.Normalized.Name}}Error{{end}};
+	{{end}}
+
+	/** {{.Type}} is an auto generated TypeScript binding around a Klaytn contract. */
+	export class {{.Type}} extends BoundContract {
+		constructor(address: string, provider: KlaytnProvider) {
+			super(address, provider, {{.Type}}ABI);
+		}
+		{{if .InputBin}}
+		/**
+		 * deploy sends a transaction deploying a new {{.Type}} contract, and returns the transaction hash.
+		 * The address of the contract can be found in the receipt of the transaction.
+		 */
+		static async deploy(provider: KlaytnProvider, opts: TransactOptions{{range $i, $_ := .Constructor.Inputs}}, arg{{$i}}: {{bindtype .Type $structs}}{{end}}): Promise<string> {
+			const iface = new Interface({{.Type}}ABI as any);
+			const data = {{.Type}}Bin + iface.encodeDeploy([{{range $i, $_ := .Constructor.Inputs}}{{if $i}}, {{end}}arg{{$i}}{{end}}]).slice(2);
+			const tx: Record<string, unknown> = { data };
+			if (opts.txType !== undefined && opts.txType !== KlaytnTxType.Legacy) {
+				tx.humanReadable = false;
+				tx.codeFormat = 0; // EVM
+			}
+			return sendTransaction(provider, tx, opts, true);
+		}
+		{{end}}
+		{{range .Calls}}
+		/**
+		 * {{.Normalized.Name}} is a free data retrieval call binding the contract method 0x{{printf "%x" .Original.ID}}.
+		 *
+		 * Solidity: {{.Original.String}}
+		 */
+		async {{.Normalized.Name}}({{range .Normalized.Inputs}}{{.Name}}: {{bindtype .Type $structs}}, {{end}}opts: CallOptions = {}): Promise<{{if eq (len .Normalized.Outputs) 0}}void{{else if eq (len .Normalized.Outputs) 1}}{{range .Normalized.Outputs}}{{bindtype .Type $structs}}{{end}}{{else if .Structured}}{ {{range .Original.Outputs}}{{.Name}}: {{bindtype .Type $structs}}; {{end}}}{{else}}[{{range $i, $_ := .Normalized.Outputs}}{{if $i}}, {{end}}{{bindtype .Type $structs}}{{end}}]{{end}}> {
+			{{if eq (len .Normalized.Outputs) 0}}await {{else}}const out = await {{end}}this.callRaw("{{.Original.Sig}}", [{{range $i, $_ := .Normalized.Inputs}}{{if $i}}, {{end}}{{.Name}}{{end}}], opts);
+			{{if eq (len .Normalized.Outputs) 1}}return normalize(out[0]);
+			{{else if .Structured}}return { {{range $i, $_ := .Original.Outputs}}{{.Name}}: normalize(out[{{$i}}]), {{end}}};
+			{{else if gt (len .Normalized.Outputs) 1}}return [{{range $i, $_ := .Normalized.Outputs}}{{if $i}}, {{end}}normalize(out[{{$i}}]){{end}}];
+			{{end}}
+		}
+		{{end}}
+		{{range .Transacts}}
+		/**
+		 * {{.Normalized.Name}} is a paid mutator transaction binding the contract method 0x{{printf "%x" .Original.ID}}.
+		 * It returns the hash of the sent transaction.
+		 *
+		 * Solidity: {{.Original.String}}
+		 */
+		async {{.Normalized.Name}}({{range .Normalized.Inputs}}{{.Name}}: {{bindtype .Type $structs}}, {{end}}opts: TransactOptions): Promise<string> {
+			return this.transactRaw("{{.Original.Sig}}", [{{range $i, $_ := .Normalized.Inputs}}{{if $i}}, {{end}}{{.Name}}{{end}}], opts);
+		}
+		{{end}}
+		{{range .Events}}
+		/**
+		 * filter{{capitalise .Normalized.Name}} retrieves the {{.Original.RawName}} events. Each indexed argument filters the events
+		 * by a value or any of a list of values, and matches all if it is omitted or null.
+		 *
+		 * Solidity: {{.Original.String}}
+		 */
+		async filter{{capitalise .Normalized.Name}}(opts: FilterOptions = {}{{range .Normalized.Inputs}}{{if .Indexed}}, {{.Name}}?: {{bindtype .Type $structs}} | {{bindtype .Type $structs}}[] | null{{end}}{{end}}): Promise<{{$contract.Type}}{{capitalise .Normalized.Name}}Event[]> {
+			const logs = await this.filterRaw("{{.Original.Sig}}", [{{range $i, $_ := .Normalized.Inputs}}{{if $i}}, {{end}}{{if .Indexed}}{{.Name}} ?? null{{else}}null{{end}}{{end}}], opts);
+			return logs.map(({ args, log }) => ({
+			{{range $i, $_ := .Normalized.Inputs}}	{{.Name}}: normalize(args[{{$i}}]),
+			{{end}}	raw: log,
+			}));
+		}
+
+		/**
+		 * parse{{capitalise .Normalized.Name}} decodes the log into a {{.Original.RawName}} event, or returns null if the log is not the event.
+		 *
+		 * Solidity: {{.Original.String}}
+		 */
+		parse{{capitalise .Normalized.Name}}(log: KlaytnLog): {{$contract.Type}}{{capitalise .Normalized.Name}}Event | null {
+			const args = this.parseRaw("{{.Original.Sig}}", log);
+			if (args === null) {
+				return null;
+			}
+			return {
+			{{range $i, $_ := .Normalized.Inputs}}	{{.Name}}: normalize(args[{{$i}}]),
+			{{end}}	raw: log,
+			};
+		}
+		{{end}}
+		{{if .Errors}}
+		/** decodeError decodes the revert data into the custom error of the contract, or returns null if it matches none. */
+		decodeError(data: string): {{.Type}}Error | null {
+			const decoded = this.iface.parseError(data);
+			if (decoded === null) {
+				return null;
+			}
+			switch (decoded.signature) {
+			{{range .Errors}}case "{{.Original.Sig}}":
+				return { errorName: "{{.Normalized.Name}}", {{range $i, $_ := .Normalized.Inputs}}{{.Name}}: normalize(decoded.args[{{$i}}]), {{end}}};
+			{{end}}}
+			return null;
+		}
+		{{end}}
+	}
+{{end}}
+`
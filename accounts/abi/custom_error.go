@@ -0,0 +1,109 @@
+// Copyright 2024 The klaytn Authors
+// This file is part of the klaytn library.
+//
+// The klaytn library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The klaytn library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the klaytn library. If not, see <http://www.gnu.org/licenses/>.
+
+package abi
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/klaytn/klaytn/common"
+	"github.com/klaytn/klaytn/crypto"
+)
+
+// Error is a custom error introduced in solidity v0.8.4, which is raised by the
+// revert statement. The revert data of a custom error is abi-encoded as if it were
+// a call to a function of the same name and inputs.
+type Error struct {
+	// Name is the error name used for internal representation. It's derived from
+	// the raw name and a suffix will be added in the case of an error overload.
+	Name string
+	// RawName is the raw error name parsed from ABI.
+	RawName string
+	Inputs  Arguments
+	str     string
+	// Sig contains the string signature according to the ABI spec.
+	// e.g.	 error foo(uint32 a, int b) = "foo(uint32,int256)"
+	Sig string
+	// ID returns the canonical representation of the error's signature. The first
+	// 4 bytes of the ID are the selector of the revert data.
+	ID common.Hash
+}
+
+// NewError creates a new Error.
+// It sanitizes the input arguments to remove unnamed arguments.
+// It also precomputes the id, signature and string representation
+// of the error.
+func NewError(name, rawName string, inputs Arguments) Error {
+	names := make([]string, len(inputs))
+	types := make([]string, len(inputs))
+	for i, input := range inputs {
+		if input.Name == "" {
+			inputs[i] = Argument{
+				Name: fmt.Sprintf("arg%d", i),
+				Type: input.Type,
+			}
+		} else {
+			inputs[i] = input
+		}
+		// string representation
+		names[i] = fmt.Sprintf("%v %v", input.Type, inputs[i].Name)
+		// sig representation
+		types[i] = input.Type.String()
+	}
+
+	str := fmt.Sprintf("error %v(%v)", rawName, strings.Join(names, ", "))
+	sig := fmt.Sprintf("%v(%v)", rawName, strings.Join(types, ","))
+	id := common.BytesToHash(crypto.Keccak256([]byte(sig)))
+
+	return Error{
+		Name:    name,
+		RawName: rawName,
+		Inputs:  inputs,
+		str:     str,
+		Sig:     sig,
+		ID:      id,
+	}
+}
+
+func (e Error) String() string {
+	return e.str
+}
+
+// Selector returns the 4-byte selector prefixing the revert data of the error.
+func (e Error) Selector() []byte {
+	return e.ID[:4]
+}
+
+// Unpack unpacks the revert data of the error into v, which is a pointer to a struct
+// whose fields are named after the inputs, or a pointer to a value if the error has
+// a single input.
+func (e Error) Unpack(v interface{}, data []byte) error {
+	if len(data) < 4 || !bytes.Equal(data[:4], e.Selector()) {
+		return errors.New("abi: revert data does not match the error selector")
+	}
+	return e.Inputs.Unpack(v, data[4:])
+}
+
+// UnpackValues unpacks the revert data of the error into a list of values.
+func (e Error) UnpackValues(data []byte) ([]interface{}, error) {
+	if len(data) < 4 || !bytes.Equal(data[:4], e.Selector()) {
+		return nil, errors.New("abi: revert data does not match the error selector")
+	}
+	return e.Inputs.UnpackValues(data[4:])
+}
@@ -0,0 +1,94 @@
+// Copyright 2024 The klaytn Authors
+// This file is part of the klaytn library.
+//
+// The klaytn library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The klaytn library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the klaytn library. If not, see <http://www.gnu.org/licenses/>.
+
+package abi
+
+import (
+	"math/big"
+	"strings"
+	"testing"
+
+	"github.com/klaytn/klaytn/common"
+	"github.com/klaytn/klaytn/crypto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const customErrorABI = `[
+	{"type": "error", "name": "InsufficientBalance", "inputs": [{"name": "available", "type": "uint256"}, {"name": "required", "type": "uint256"}]},
+	{"type": "error", "name": "Unauthorized", "inputs": [{"name": "", "type": "address"}]},
+	{"type": "error", "name": "Unauthorized", "inputs": []},
+	{"type": "function", "name": "transfer", "inputs": [{"name": "to", "type": "address"}, {"name": "amount", "type": "uint256"}], "outputs": []}
+]`
+
+func TestCustomErrorParsing(t *testing.T) {
+	parsed, err := JSON(strings.NewReader(customErrorABI))
+	require.NoError(t, err)
+	require.Len(t, parsed.Errors, 3)
+
+	e := parsed.Errors["InsufficientBalance"]
+	assert.Equal(t, "InsufficientBalance(uint256,uint256)", e.Sig)
+	assert.Equal(t, "error InsufficientBalance(uint256 available, uint256 required)", e.String())
+	assert.Equal(t, crypto.Keccak256([]byte(e.Sig))[:4], e.Selector())
+
+	// overloaded errors and unnamed inputs
+	assert.Equal(t, "Unauthorized(address)", parsed.Errors["Unauthorized"].Sig)
+	assert.Equal(t, "arg0", parsed.Errors["Unauthorized"].Inputs[0].Name)
+	assert.Equal(t, "Unauthorized()", parsed.Errors["Unauthorized0"].Sig)
+	assert.Equal(t, "Unauthorized", parsed.Errors["Unauthorized0"].RawName)
+}
+
+func TestCustomErrorUnpack(t *testing.T) {
+	parsed, err := JSON(strings.NewReader(customErrorABI))
+	require.NoError(t, err)
+
+	e := parsed.Errors["InsufficientBalance"]
+	args, err := e.Inputs.Pack(big.NewInt(1), big.NewInt(2))
+	require.NoError(t, err)
+	data := append(e.Selector(), args...)
+
+	found, err := parsed.ErrorByID(data)
+	require.NoError(t, err)
+	assert.Equal(t, "InsufficientBalance", found.Name)
+
+	var out struct {
+		Available *big.Int
+		Required  *big.Int
+	}
+	require.NoError(t, e.Unpack(&out, data))
+	assert.Equal(t, big.NewInt(1), out.Available)
+	assert.Equal(t, big.NewInt(2), out.Required)
+
+	values, err := e.UnpackValues(data)
+	require.NoError(t, err)
+	assert.Equal(t, []interface{}{big.NewInt(1), big.NewInt(2)}, values)
+
+	// a single input is unpacked into a value
+	unauthorized := parsed.Errors["Unauthorized"]
+	addr := common.HexToAddress("0x0000000000000000000000000000000000000400")
+	args, err = unauthorized.Inputs.Pack(addr)
+	require.NoError(t, err)
+	var account common.Address
+	require.NoError(t, unauthorized.Unpack(&account, append(unauthorized.Selector(), args...)))
+	assert.Equal(t, addr, account)
+
+	// the selector mismatches
+	assert.Error(t, unauthorized.Unpack(&account, data))
+	_, err = parsed.ErrorByID([]byte{0xde, 0xad, 0xbe, 0xef})
+	assert.Error(t, err)
+	_, err = parsed.ErrorByID([]byte{0xde})
+	assert.Error(t, err)
+}
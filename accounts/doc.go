@@ -31,6 +31,7 @@ Each file provides the following features
  - errors.go	: Provides various account related error variables and helper functions
  - hd.go		: Defines derivation paths for Klaytn and parser function to derive the path from a path string. Klaytn uses 8217 as its coin type
  - manager.go 	: Provides `Manager` which is an overarching account manager that can communicate with various backends for signing transactions
+ - mnemonic.go	: Provides functions to generate a BIP-39 mnemonic and derive keys from it along a derivation path
  - url.go 	: Provides `URL` struct which represents the canonical identification URL of a wallet or account
 */
 package accounts
@@ -0,0 +1,289 @@
+// Modifications Copyright 2024 The klaytn Authors
+// Copyright 2019 The go-ethereum Authors
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+//
+// This file is derived from accounts/external/backend.go (2019/09/02).
+// Modified and improved for the klaytn development.
+
+package external
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"reflect"
+	"sync"
+
+	"github.com/klaytn/klaytn"
+	"github.com/klaytn/klaytn/accounts"
+	"github.com/klaytn/klaytn/blockchain/types"
+	"github.com/klaytn/klaytn/common"
+	"github.com/klaytn/klaytn/common/hexutil"
+	"github.com/klaytn/klaytn/event"
+	"github.com/klaytn/klaytn/log"
+	"github.com/klaytn/klaytn/networks/rpc"
+	"github.com/klaytn/klaytn/rlp"
+)
+
+var logger = log.NewModuleLogger(log.AccountsExternal)
+
+var (
+	errPassphraseNotSupported = errors.New("passphrase-operations not supported on external signers")
+	errDeriveNotSupported     = errors.New("operation not supported on external signers")
+
+	// ErrTxModified is returned if the signed transaction returned by the external signer
+	// is not the transaction which was requested to be signed.
+	ErrTxModified = errors.New("the transaction was modified by the external signer")
+)
+
+// ExternalBackend is an accounts.Backend with a single wallet forwarding
+// the signing requests to an external signer.
+type ExternalBackend struct {
+	signers []accounts.Wallet
+}
+
+func (eb *ExternalBackend) Wallets() []accounts.Wallet {
+	return eb.signers
+}
+
+// NewExternalBackend connects to the external signer at the given endpoint, which
+// can be an IPC path or an HTTP URL.
+func NewExternalBackend(endpoint string) (*ExternalBackend, error) {
+	signer, err := NewExternalSigner(endpoint)
+	if err != nil {
+		return nil, err
+	}
+	return &ExternalBackend{
+		signers: []accounts.Wallet{signer},
+	}, nil
+}
+
+func (eb *ExternalBackend) Subscribe(sink chan<- accounts.WalletEvent) event.Subscription {
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		<-quit
+		return nil
+	})
+}
+
+// ExternalSigner provides an API to interact with an external signer (ksigner).
+// It proxies request to the external signer while forwarding relevant
+// request headers.
+type ExternalSigner struct {
+	client   *rpc.Client
+	endpoint string
+	status   string
+	cacheMu  sync.RWMutex
+	cache    []accounts.Account
+}
+
+// NewExternalSigner dials the external signer at the given endpoint.
+func NewExternalSigner(endpoint string) (*ExternalSigner, error) {
+	client, err := rpc.Dial(endpoint)
+	if err != nil {
+		return nil, err
+	}
+	extsigner := &ExternalSigner{
+		client:   client,
+		endpoint: endpoint,
+	}
+	// Check if reachable
+	version, err := extsigner.pingVersion()
+	if err != nil {
+		return nil, err
+	}
+	extsigner.status = fmt.Sprintf("ok [version=%v]", version)
+	logger.Info("Connected to the external signer", "endpoint", endpoint, "version", version)
+	return extsigner, nil
+}
+
+// NewExternalSignerWithClient returns an ExternalSigner communicating over the given client.
+func NewExternalSignerWithClient(client *rpc.Client, endpoint string) (*ExternalSigner, error) {
+	extsigner := &ExternalSigner{
+		client:   client,
+		endpoint: endpoint,
+	}
+	version, err := extsigner.pingVersion()
+	if err != nil {
+		return nil, err
+	}
+	extsigner.status = fmt.Sprintf("ok [version=%v]", version)
+	return extsigner, nil
+}
+
+func (api *ExternalSigner) URL() accounts.URL {
+	return accounts.URL{
+		Scheme: "extapi",
+		Path:   api.endpoint,
+	}
+}
+
+func (api *ExternalSigner) Status() (string, error) {
+	return api.status, nil
+}
+
+func (api *ExternalSigner) Open(passphrase string) error {
+	return errors.New("operation not supported on external signers")
+}
+
+func (api *ExternalSigner) Close() error {
+	return errors.New("operation not supported on external signers")
+}
+
+func (api *ExternalSigner) Accounts() []accounts.Account {
+	var accnts []accounts.Account
+	res, err := api.listAccounts()
+	if err != nil {
+		logger.Error("account listing failed", "error", err)
+		return accnts
+	}
+	for _, addr := range res {
+		accnts = append(accnts, accounts.Account{
+			URL: accounts.URL{
+				Scheme: "extapi",
+				Path:   api.endpoint,
+			},
+			Address: addr,
+		})
+	}
+	api.cacheMu.Lock()
+	api.cache = accnts
+	api.cacheMu.Unlock()
+	return accnts
+}
+
+func (api *ExternalSigner) Contains(account accounts.Account) bool {
+	api.cacheMu.RLock()
+	defer api.cacheMu.RUnlock()
+	if api.cache == nil {
+		// If we haven't already fetched the accounts, it's time to do so now
+		api.cacheMu.RUnlock()
+		api.Accounts()
+		api.cacheMu.RLock()
+	}
+	for _, a := range api.cache {
+		if a.Address == account.Address && (account.URL == (accounts.URL{}) || account.URL == api.URL()) {
+			return true
+		}
+	}
+	return false
+}
+
+func (api *ExternalSigner) Derive(path accounts.DerivationPath, pin bool) (accounts.Account, error) {
+	return accounts.Account{}, errDeriveNotSupported
+}
+
+func (api *ExternalSigner) SelfDerive(base accounts.DerivationPath, chain klaytn.ChainReader) {
+	logger.Error("operation SelfDerive not supported on external signers")
+}
+
+// SignHash requests the external signer to sign the given hash.
+// The produced signature is in the [R || S || V] format where V is 0 or 1.
+func (api *ExternalSigner) SignHash(account accounts.Account, hash []byte) ([]byte, error) {
+	var res hexutil.Bytes
+	if err := api.client.Call(&res, "account_signHash", account.Address, hexutil.Bytes(hash)); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// SignTx requests the external signer to sign the given transaction as a sender.
+// The external signer signs the transaction with the keys of the role required by
+// the transaction type, e.g., RoleAccountUpdate for account update transactions.
+func (api *ExternalSigner) SignTx(account accounts.Account, tx *types.Transaction, chainID *big.Int) (*types.Transaction, error) {
+	if chainID == nil {
+		return nil, errors.New("chain id is nil")
+	}
+	signed, err := api.signTransaction("account_signTransaction", account, tx, chainID)
+	if err != nil {
+		return nil, err
+	}
+	// The signer must not modify the transaction contents.
+	signer := types.LatestSignerForChainID(chainID)
+	if signer.Hash(tx) != signer.Hash(signed) {
+		return nil, ErrTxModified
+	}
+	return signed, nil
+}
+
+// SignTxAsFeePayer requests the external signer to sign the given transaction as a fee payer
+// with the keys of RoleFeePayer.
+func (api *ExternalSigner) SignTxAsFeePayer(account accounts.Account, tx *types.Transaction, chainID *big.Int) (*types.Transaction, error) {
+	if chainID == nil {
+		return nil, errors.New("chain id is nil")
+	}
+	signed, err := api.signTransaction("account_signTransactionAsFeePayer", account, tx, chainID)
+	if err != nil {
+		return nil, err
+	}
+	// The signer must not modify the transaction contents and the signatures of the sender.
+	signer := types.LatestSignerForChainID(chainID)
+	origHash, err := signer.HashFeePayer(tx)
+	if err != nil {
+		return nil, err
+	}
+	signedHash, err := signer.HashFeePayer(signed)
+	if err != nil {
+		return nil, err
+	}
+	if origHash != signedHash || !reflect.DeepEqual(tx.RawSignatureValues(), signed.RawSignatureValues()) {
+		return nil, ErrTxModified
+	}
+	return signed, nil
+}
+
+func (api *ExternalSigner) signTransaction(method string, account accounts.Account, tx *types.Transaction, chainID *big.Int) (*types.Transaction, error) {
+	rawTx, err := rlp.EncodeToBytes(tx)
+	if err != nil {
+		return nil, err
+	}
+	var res hexutil.Bytes
+	if err := api.client.Call(&res, method, account.Address, hexutil.Bytes(rawTx), (*hexutil.Big)(chainID)); err != nil {
+		return nil, err
+	}
+	signed := new(types.Transaction)
+	if err := rlp.DecodeBytes(res, signed); err != nil {
+		return nil, err
+	}
+	return signed, nil
+}
+
+func (api *ExternalSigner) SignHashWithPassphrase(account accounts.Account, passphrase string, hash []byte) ([]byte, error) {
+	return nil, errPassphraseNotSupported
+}
+
+func (api *ExternalSigner) SignTxWithPassphrase(account accounts.Account, passphrase string, tx *types.Transaction, chainID *big.Int) (*types.Transaction, error) {
+	return nil, errPassphraseNotSupported
+}
+
+func (api *ExternalSigner) SignTxAsFeePayerWithPassphrase(account accounts.Account, passphrase string, tx *types.Transaction, chainID *big.Int) (*types.Transaction, error) {
+	return nil, errPassphraseNotSupported
+}
+
+func (api *ExternalSigner) listAccounts() ([]common.Address, error) {
+	var res []common.Address
+	if err := api.client.Call(&res, "account_list"); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+func (api *ExternalSigner) pingVersion() (string, error) {
+	var v string
+	if err := api.client.Call(&v, "account_version"); err != nil {
+		return "", err
+	}
+	return v, nil
+}
@@ -0,0 +1,265 @@
+// Copyright 2024 The klaytn Authors
+// This file is part of the klaytn library.
+//
+// The klaytn library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The klaytn library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the klaytn library. If not, see <http://www.gnu.org/licenses/>.
+
+package external
+
+import (
+	"bytes"
+	"context"
+	"crypto/ecdsa"
+	"encoding/json"
+	"math/big"
+	"strings"
+	"testing"
+
+	"github.com/klaytn/klaytn/accounts"
+	"github.com/klaytn/klaytn/accounts/keystore"
+	"github.com/klaytn/klaytn/blockchain/types"
+	"github.com/klaytn/klaytn/blockchain/types/accountkey"
+	"github.com/klaytn/klaytn/common"
+	"github.com/klaytn/klaytn/common/hexutil"
+	"github.com/klaytn/klaytn/common/math"
+	"github.com/klaytn/klaytn/crypto"
+	"github.com/klaytn/klaytn/networks/rpc"
+	"github.com/klaytn/klaytn/rlp"
+	"github.com/klaytn/klaytn/signer"
+	"github.com/pborman/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var testChainID = big.NewInt(1001)
+
+// newRoleBasedKey returns a key with one RoleTransaction key, two RoleAccountUpdate keys
+// and one RoleFeePayer key.
+func newRoleBasedKey(t *testing.T) *keystore.KeyV4 {
+	var prvs [][]*ecdsa.PrivateKey
+	for _, n := range []int{1, 2, 1} {
+		var keys []*ecdsa.PrivateKey
+		for i := 0; i < n; i++ {
+			prv, err := crypto.GenerateKey()
+			require.NoError(t, err)
+			keys = append(keys, prv)
+		}
+		prvs = append(prvs, keys)
+	}
+	return &keystore.KeyV4{
+		Id:          uuid.NewRandom(),
+		Address:     common.HexToAddress("0x000000000000000000000000000000000000beef"),
+		PrivateKeys: prvs,
+	}
+}
+
+func newTestSigner(t *testing.T, service interface{}) *ExternalSigner {
+	server := rpc.NewServer()
+	require.NoError(t, server.RegisterName("account", service))
+	t.Cleanup(server.Stop)
+
+	extsigner, err := NewExternalSignerWithClient(rpc.DialInProc(server), "inproc")
+	require.NoError(t, err)
+	return extsigner
+}
+
+func pubkeysOf(prvs []*ecdsa.PrivateKey) []*ecdsa.PublicKey {
+	pubs := make([]*ecdsa.PublicKey, len(prvs))
+	for i, prv := range prvs {
+		pubs[i] = &prv.PublicKey
+	}
+	return pubs
+}
+
+func TestExternalSigner_SignTx(t *testing.T) {
+	key := newRoleBasedKey(t)
+	extsigner := newTestSigner(t, signer.NewSignerAPI([]keystore.Key{key}, nil, nil))
+	account := accounts.Account{Address: key.Address}
+
+	assert.Equal(t, []common.Address{key.Address}, []common.Address{extsigner.Accounts()[0].Address})
+	assert.True(t, extsigner.Contains(account))
+
+	s := types.LatestSignerForChainID(testChainID)
+
+	// A value transfer is signed with the RoleTransaction key.
+	tx, err := types.NewTransactionWithMap(types.TxTypeValueTransfer, map[types.TxValueKeyType]interface{}{
+		types.TxValueKeyNonce:    uint64(0),
+		types.TxValueKeyTo:       common.HexToAddress("0x000000000000000000000000000000000000abcd"),
+		types.TxValueKeyAmount:   big.NewInt(1),
+		types.TxValueKeyGasLimit: uint64(100000),
+		types.TxValueKeyGasPrice: big.NewInt(25),
+		types.TxValueKeyFrom:     key.Address,
+	})
+	require.NoError(t, err)
+	signed, err := extsigner.SignTx(account, tx, testChainID)
+	require.NoError(t, err)
+	pubs, err := types.SenderPubkey(s, signed)
+	require.NoError(t, err)
+	assert.Equal(t, pubkeysOf(key.PrivateKeys[accountkey.RoleTransaction]), pubs)
+
+	// An account update is signed with all RoleAccountUpdate keys.
+	tx, err = types.NewTransactionWithMap(types.TxTypeAccountUpdate, map[types.TxValueKeyType]interface{}{
+		types.TxValueKeyNonce:      uint64(1),
+		types.TxValueKeyGasLimit:   uint64(100000),
+		types.TxValueKeyGasPrice:   big.NewInt(25),
+		types.TxValueKeyFrom:       key.Address,
+		types.TxValueKeyAccountKey: accountkey.NewAccountKeyLegacy(),
+	})
+	require.NoError(t, err)
+	signed, err = extsigner.SignTx(account, tx, testChainID)
+	require.NoError(t, err)
+	pubs, err = types.SenderPubkey(s, signed)
+	require.NoError(t, err)
+	assert.Equal(t, pubkeysOf(key.PrivateKeys[accountkey.RoleAccountUpdate]), pubs)
+
+	// The signer does not sign for the other senders.
+	_, err = extsigner.SignTx(accounts.Account{Address: common.HexToAddress("0x1")}, tx, testChainID)
+	assert.Error(t, err)
+
+	// The passphrase is never sent to the external signer.
+	_, err = extsigner.SignTxWithPassphrase(account, "passphrase", tx, testChainID)
+	assert.Equal(t, errPassphraseNotSupported, err)
+}
+
+func TestExternalSigner_SignTxAsFeePayer(t *testing.T) {
+	key := newRoleBasedKey(t)
+	extsigner := newTestSigner(t, signer.NewSignerAPI([]keystore.Key{key}, nil, nil))
+	s := types.LatestSignerForChainID(testChainID)
+
+	senderPrv, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	tx, err := types.NewTransactionWithMap(types.TxTypeFeeDelegatedValueTransfer, map[types.TxValueKeyType]interface{}{
+		types.TxValueKeyNonce:    uint64(0),
+		types.TxValueKeyTo:       common.HexToAddress("0x000000000000000000000000000000000000abcd"),
+		types.TxValueKeyAmount:   big.NewInt(1),
+		types.TxValueKeyGasLimit: uint64(100000),
+		types.TxValueKeyGasPrice: big.NewInt(25),
+		types.TxValueKeyFrom:     crypto.PubkeyToAddress(senderPrv.PublicKey),
+		types.TxValueKeyFeePayer: key.Address,
+	})
+	require.NoError(t, err)
+	require.NoError(t, tx.Sign(s, senderPrv))
+
+	signed, err := extsigner.SignTxAsFeePayer(accounts.Account{Address: key.Address}, tx, testChainID)
+	require.NoError(t, err)
+	pubs, err := types.SenderFeePayerPubkey(s, signed)
+	require.NoError(t, err)
+	assert.Equal(t, pubkeysOf(key.PrivateKeys[accountkey.RoleFeePayer]), pubs)
+	assert.Equal(t, tx.RawSignatureValues(), signed.RawSignatureValues())
+}
+
+func TestExternalSigner_Rules(t *testing.T) {
+	key := newRoleBasedKey(t)
+	rules := &signer.Rules{
+		AllowedTxTypes: []string{"TxTypeValueTransfer"},
+		MaxValue:       (*math.HexOrDecimal256)(big.NewInt(100)),
+	}
+	audit := new(bytes.Buffer)
+	extsigner := newTestSigner(t, signer.NewSignerAPI([]keystore.Key{key}, rules, signer.NewAuditLogger(audit)))
+	account := accounts.Account{Address: key.Address}
+
+	newValueTransfer := func(value int64) *types.Transaction {
+		tx, err := types.NewTransactionWithMap(types.TxTypeValueTransfer, map[types.TxValueKeyType]interface{}{
+			types.TxValueKeyNonce:    uint64(0),
+			types.TxValueKeyTo:       common.HexToAddress("0x000000000000000000000000000000000000abcd"),
+			types.TxValueKeyAmount:   big.NewInt(value),
+			types.TxValueKeyGasLimit: uint64(100000),
+			types.TxValueKeyGasPrice: big.NewInt(25),
+			types.TxValueKeyFrom:     key.Address,
+		})
+		require.NoError(t, err)
+		return tx
+	}
+
+	_, err := extsigner.SignTx(account, newValueTransfer(100), testChainID)
+	assert.NoError(t, err)
+
+	_, err = extsigner.SignTx(account, newValueTransfer(101), testChainID)
+	assert.ErrorContains(t, err, "value exceeds the limit")
+
+	tx, err := types.NewTransactionWithMap(types.TxTypeAccountUpdate, map[types.TxValueKeyType]interface{}{
+		types.TxValueKeyNonce:      uint64(0),
+		types.TxValueKeyGasLimit:   uint64(100000),
+		types.TxValueKeyGasPrice:   big.NewInt(25),
+		types.TxValueKeyFrom:       key.Address,
+		types.TxValueKeyAccountKey: accountkey.NewAccountKeyLegacy(),
+	})
+	require.NoError(t, err)
+	_, err = extsigner.SignTx(account, tx, testChainID)
+	assert.ErrorContains(t, err, "transaction type is not allowed")
+
+	_, err = extsigner.SignHash(account, crypto.Keccak256([]byte("hash")))
+	assert.ErrorContains(t, err, "signing an arbitrary hash is not allowed")
+
+	// Every request is recorded in the audit log.
+	lines := strings.Split(strings.TrimSpace(audit.String()), "\n")
+	require.Len(t, lines, 4)
+	var records []signer.AuditRecord
+	for _, line := range lines {
+		var record signer.AuditRecord
+		require.NoError(t, json.Unmarshal([]byte(line), &record))
+		records = append(records, record)
+	}
+	assert.True(t, records[0].Approved)
+	assert.Equal(t, "TxTypeValueTransfer", records[0].TxType)
+	assert.False(t, records[1].Approved)
+	assert.Equal(t, "TxTypeAccountUpdate", records[2].TxType)
+	assert.Equal(t, "account_signHash", records[3].Method)
+	assert.False(t, records[3].Approved)
+}
+
+// tamperingSigner signs a transaction different from the requested one.
+type tamperingSigner struct {
+	*signer.SignerAPI
+}
+
+func (s *tamperingSigner) SignTransaction(ctx context.Context, addr common.Address, rawTx hexutil.Bytes, chainID *hexutil.Big) (hexutil.Bytes, error) {
+	tx, err := types.DecodeTxWithoutSigValidation(rawTx)
+	if err != nil {
+		return nil, err
+	}
+	to := common.HexToAddress("0x000000000000000000000000000000000000dead")
+	modified, err := types.NewTransactionWithMap(types.TxTypeValueTransfer, map[types.TxValueKeyType]interface{}{
+		types.TxValueKeyNonce:    tx.Nonce(),
+		types.TxValueKeyTo:       to,
+		types.TxValueKeyAmount:   tx.Value(),
+		types.TxValueKeyGasLimit: tx.Gas(),
+		types.TxValueKeyGasPrice: tx.GasPrice(),
+		types.TxValueKeyFrom:     addr,
+	})
+	if err != nil {
+		return nil, err
+	}
+	raw, err := rlp.EncodeToBytes(modified)
+	if err != nil {
+		return nil, err
+	}
+	return s.SignerAPI.SignTransaction(context.Background(), addr, raw, chainID)
+}
+
+func TestExternalSigner_TxModified(t *testing.T) {
+	key := newRoleBasedKey(t)
+	extsigner := newTestSigner(t, &tamperingSigner{signer.NewSignerAPI([]keystore.Key{key}, nil, nil)})
+
+	tx, err := types.NewTransactionWithMap(types.TxTypeValueTransfer, map[types.TxValueKeyType]interface{}{
+		types.TxValueKeyNonce:    uint64(0),
+		types.TxValueKeyTo:       common.HexToAddress("0x000000000000000000000000000000000000abcd"),
+		types.TxValueKeyAmount:   big.NewInt(1),
+		types.TxValueKeyGasLimit: uint64(100000),
+		types.TxValueKeyGasPrice: big.NewInt(25),
+		types.TxValueKeyFrom:     key.Address,
+	})
+	require.NoError(t, err)
+	_, err = extsigner.SignTx(accounts.Account{Address: key.Address}, tx, testChainID)
+	assert.Equal(t, ErrTxModified, err)
+}
@@ -0,0 +1,28 @@
+// Copyright 2024 The klaytn Authors
+// This file is part of the klaytn library.
+//
+// The klaytn library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The klaytn library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the klaytn library. If not, see <http://www.gnu.org/licenses/>.
+
+/*
+Package external implements an accounts.Backend forwarding the signing requests
+to an external signer over JSON-RPC, so that the keys do not live in the node process.
+
+See the signer package for the API served by the external signer.
+
+# Source Files
+
+Each file contains following contents
+  - backend.go 	: Defines `ExternalBackend` and `ExternalSigner` which implements accounts.Wallet interface
+*/
+package external
@@ -538,6 +538,32 @@ func (ks *KeyStore) ImportECDSA(priv *ecdsa.PrivateKey, passphrase string) (acco
 	return ks.importKey(key, passphrase)
 }
 
+// ImportMnemonic derives `count` keys from the BIP-39 mnemonic starting at the given
+// derivation path and stores them into the key directory, encrypting them with the passphrase.
+// No key is stored if any of the derived accounts already exists.
+func (ks *KeyStore) ImportMnemonic(mnemonic string, path accounts.DerivationPath, count int, passphrase string) ([]accounts.Account, error) {
+	privs, err := accounts.DeriveKeysFromMnemonic(mnemonic, path, count)
+	if err != nil {
+		return nil, err
+	}
+	keys := make([]Key, len(privs))
+	for i, priv := range privs {
+		keys[i] = newKeyFromECDSA(priv)
+		if ks.cache.hasAddress(keys[i].GetAddress()) {
+			return nil, fmt.Errorf("account already exists: %s", keys[i].GetAddress().Hex())
+		}
+	}
+	accs := make([]accounts.Account, 0, len(keys))
+	for _, key := range keys {
+		acc, err := ks.importKey(key, passphrase)
+		if err != nil {
+			return accs, err
+		}
+		accs = append(accs, acc)
+	}
+	return accs, nil
+}
+
 func (ks *KeyStore) importKey(key Key, passphrase string) (accounts.Account, error) {
 	a := accounts.Account{Address: key.GetAddress(), URL: accounts.URL{Scheme: KeyStoreScheme, Path: ks.storage.JoinPath(keyFileName(key.GetAddress()))}}
 	if err := ks.storage.StoreKey(a.URL.Path, key, passphrase); err != nil {
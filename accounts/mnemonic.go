@@ -0,0 +1,101 @@
+// Copyright 2024 The klaytn Authors
+// This file is part of the klaytn library.
+//
+// The klaytn library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The klaytn library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the klaytn library. If not, see <http://www.gnu.org/licenses/>.
+
+package accounts
+
+import (
+	"crypto/ecdsa"
+	"errors"
+	"fmt"
+	"math"
+
+	"github.com/klaytn/klaytn/crypto"
+	"github.com/tyler-smith/go-bip32"
+	"github.com/tyler-smith/go-bip39"
+)
+
+// mnemonicEntropyBits is the entropy size of a generated mnemonic, which results in 24 words.
+const mnemonicEntropyBits = 256
+
+var (
+	ErrInvalidMnemonic     = errors.New("invalid mnemonic")
+	ErrInvalidDeriveCount  = errors.New("the number of accounts to derive must be positive")
+	ErrDerivationPathRange = errors.New("the last component of the derivation path overflows")
+)
+
+// NewMnemonic generates a new 24-word BIP-39 mnemonic.
+func NewMnemonic() (string, error) {
+	entropy, err := bip39.NewEntropy(mnemonicEntropyBits)
+	if err != nil {
+		return "", err
+	}
+	return bip39.NewMnemonic(entropy)
+}
+
+// DeriveKeyFromMnemonic derives the private key at the given BIP-32 path from
+// the BIP-39 mnemonic. The mnemonic is protected by an empty BIP-39 passphrase.
+func DeriveKeyFromMnemonic(mnemonic string, path DerivationPath) (*ecdsa.PrivateKey, error) {
+	keys, err := DeriveKeysFromMnemonic(mnemonic, path, 1)
+	if err != nil {
+		return nil, err
+	}
+	return keys[0], nil
+}
+
+// DeriveKeysFromMnemonic derives `count` private keys from the BIP-39 mnemonic.
+// The first key is derived at the given path and the following keys are derived
+// by incrementing the last component of the path, e.g., m/44'/8217'/0'/0/0,
+// m/44'/8217'/0'/0/1, and so on.
+func DeriveKeysFromMnemonic(mnemonic string, base DerivationPath, count int) ([]*ecdsa.PrivateKey, error) {
+	if count <= 0 {
+		return nil, ErrInvalidDeriveCount
+	}
+	if len(base) == 0 {
+		return nil, errors.New("empty derivation path")
+	}
+	last := base[len(base)-1]
+	if uint64(last)+uint64(count)-1 > math.MaxUint32 || (last < 0x80000000 && uint64(last)+uint64(count)-1 >= 0x80000000) {
+		return nil, ErrDerivationPathRange
+	}
+
+	seed, err := bip39.NewSeedWithErrorChecking(mnemonic, "")
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidMnemonic, err)
+	}
+	parent, err := bip32.NewMasterKey(seed)
+	if err != nil {
+		return nil, err
+	}
+	for _, component := range base[:len(base)-1] {
+		if parent, err = parent.NewChildKey(component); err != nil {
+			return nil, err
+		}
+	}
+
+	keys := make([]*ecdsa.PrivateKey, 0, count)
+	for i := 0; i < count; i++ {
+		child, err := parent.NewChildKey(last + uint32(i))
+		if err != nil {
+			return nil, err
+		}
+		key, err := crypto.ToECDSA(child.Key)
+		if err != nil {
+			return nil, err
+		}
+		keys = append(keys, key)
+	}
+	return keys, nil
+}
@@ -0,0 +1,70 @@
+// Copyright 2024 The klaytn Authors
+// This file is part of the klaytn library.
+//
+// The klaytn library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The klaytn library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the klaytn library. If not, see <http://www.gnu.org/licenses/>.
+
+package accounts
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/klaytn/klaytn/common"
+	"github.com/klaytn/klaytn/crypto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testMnemonic = "abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon about"
+
+func TestDeriveKeysFromMnemonic(t *testing.T) {
+	// The well-known test vector of the BIP-44 Ethereum path.
+	path, err := ParseDerivationPath("m/44'/60'/0'/0/0")
+	require.NoError(t, err)
+	key, err := DeriveKeyFromMnemonic(testMnemonic, path)
+	require.NoError(t, err)
+	assert.Equal(t, common.HexToAddress("0x9858EfFD232B4033E47d90003D41EC34EcaEda94"), crypto.PubkeyToAddress(key.PublicKey))
+
+	// The keys are derived by incrementing the last component of the path.
+	keys, err := DeriveKeysFromMnemonic(testMnemonic, DefaultBaseDerivationPath, 3)
+	require.NoError(t, err)
+	require.Len(t, keys, 3)
+	for i, key := range keys {
+		path := append(DerivationPath{}, DefaultBaseDerivationPath...)
+		path[len(path)-1] += uint32(i)
+		expected, err := DeriveKeyFromMnemonic(testMnemonic, path)
+		require.NoError(t, err)
+		assert.Equal(t, expected, key)
+	}
+	assert.NotEqual(t, keys[0], keys[1])
+
+	// The checksum of the mnemonic is validated.
+	_, err = DeriveKeyFromMnemonic(strings.Replace(testMnemonic, "about", "abandon", 1), DefaultBaseDerivationPath)
+	assert.ErrorIs(t, err, ErrInvalidMnemonic)
+
+	_, err = DeriveKeysFromMnemonic(testMnemonic, DefaultBaseDerivationPath, 0)
+	assert.ErrorIs(t, err, ErrInvalidDeriveCount)
+
+	_, err = DeriveKeysFromMnemonic(testMnemonic, DerivationPath{0x80000000 + 44, 0x7fffffff}, 2)
+	assert.ErrorIs(t, err, ErrDerivationPathRange)
+}
+
+func TestNewMnemonic(t *testing.T) {
+	mnemonic, err := NewMnemonic()
+	require.NoError(t, err)
+	assert.Len(t, strings.Fields(mnemonic), 24)
+
+	_, err = DeriveKeyFromMnemonic(mnemonic, DefaultBaseDerivationPath)
+	assert.NoError(t, err)
+}
@@ -37,6 +37,10 @@ import (
 	"github.com/klaytn/klaytn/rlp"
 )
 
+// maxDeriveCount is the maximum number of accounts derived by a personal_deriveAccount call,
+// since encrypting a key with the standard scrypt parameters takes about a second.
+const maxDeriveCount = 100
+
 // PrivateAccountAPI provides an API to access accounts managed by this node.
 // It offers methods to create, (un)lock en list accounts. Some methods accept
 // passwords and are therefore considered private by default.
@@ -110,21 +114,31 @@ func (s *PrivateAccountAPI) OpenWallet(url string, passphrase *string) error {
 	return wallet.Open(pass)
 }
 
-// DeriveAccount requests a HD wallet to derive a new account, optionally pinning
-// it for later reuse.
-func (s *PrivateAccountAPI) DeriveAccount(url string, path string, pin *bool) (accounts.Account, error) {
-	wallet, err := s.am.Wallet(url)
+// DeriveAccount derives accounts from the BIP-39 mnemonic and adds them to the keystore,
+// encrypting them with the given password. The first account is derived at the given path,
+// e.g., m/44'/8217'/0'/0/0, and the following accounts are derived by incrementing the last
+// component of the path. If count is nil, a single account is derived.
+func (s *PrivateAccountAPI) DeriveAccount(mnemonic string, password string, path string, count *uint64) ([]common.Address, error) {
+	derivPath, err := accounts.ParseDerivationPath(path)
 	if err != nil {
-		return accounts.Account{}, err
+		return nil, err
 	}
-	derivPath, err := accounts.ParseDerivationPath(path)
+	n := uint64(1)
+	if count != nil {
+		n = *count
+	}
+	if n == 0 || n > maxDeriveCount {
+		return nil, fmt.Errorf("count must be in range [1, %d]", maxDeriveCount)
+	}
+	accs, err := fetchKeystore(s.am).ImportMnemonic(mnemonic, derivPath, int(n), password)
 	if err != nil {
-		return accounts.Account{}, err
+		return nil, err
 	}
-	if pin == nil {
-		pin = new(bool)
+	addrs := make([]common.Address, len(accs))
+	for i, acc := range accs {
+		addrs[i] = acc.Address
 	}
-	return wallet.Derive(derivPath, *pin)
+	return addrs, nil
 }
 
 // NewAccount will create a new account and returns the address for the new account.
@@ -98,3 +98,53 @@ func TestPrivateAccountAPI_ImportRawKey(t *testing.T) {
 		require.Equal(t, common.HexToAddress("0x819104a190255e0cedbdd9d5f59a557633d79db2"), addr)
 	}
 }
+
+// TestPrivateAccountAPI_DeriveAccount tests DeriveAccount() adding the accounts derived from a mnemonic.
+func TestPrivateAccountAPI_DeriveAccount(t *testing.T) {
+	keydir, err := ioutil.TempDir("", "klay-test")
+	require.NoError(t, err)
+	defer os.RemoveAll(keydir)
+
+	ks := keystore.NewKeyStore(keydir, keystore.LightScryptN, keystore.LightScryptP)
+	api := PrivateAccountAPI{
+		am:        accounts.NewManager(ks),
+		nonceLock: new(AddrLocker),
+		b:         nil,
+	}
+	mnemonic := "abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon about"
+
+	// 1. Derive a single account at the Ethereum path of the well-known test vector.
+	{
+		addrs, err := api.DeriveAccount(mnemonic, "1234", "m/44'/60'/0'/0/0", nil)
+		require.NoError(t, err)
+		require.Equal(t, []common.Address{common.HexToAddress("0x9858EfFD232B4033E47d90003D41EC34EcaEda94")}, addrs)
+		require.True(t, ks.HasAddress(addrs[0]))
+	}
+
+	// 2. Derive multiple accounts by incrementing the last component of the path.
+	{
+		count := uint64(3)
+		addrs, err := api.DeriveAccount(mnemonic, "1234", "m/44'/8217'/0'/0/0", &count)
+		require.NoError(t, err)
+		require.Len(t, addrs, 3)
+		require.Len(t, ks.Accounts(), 4)
+
+		next, err := api.DeriveAccount(mnemonic, "1234", "m/44'/8217'/0'/0/3", nil)
+		require.NoError(t, err)
+		require.NotContains(t, addrs, next[0])
+	}
+
+	// 3. Since the accounts are already registered, it should fail without storing any key.
+	{
+		count := uint64(5)
+		_, err := api.DeriveAccount(mnemonic, "1234", "m/44'/8217'/0'/0/2", &count)
+		require.Error(t, err)
+		require.Len(t, ks.Accounts(), 5)
+	}
+
+	// 4. Should return an error if the mnemonic is invalid.
+	{
+		_, err := api.DeriveAccount("abandon abandon abandon", "1234", "m/44'/8217'/0'/0/10", nil)
+		require.ErrorIs(t, err, accounts.ErrInvalidMnemonic)
+	}
+}
@@ -22,6 +22,7 @@ package api
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"math/big"
@@ -165,8 +166,9 @@ func (s *PublicKlayAPI) ForkStatus(ctx context.Context, number rpc.BlockNumber)
 		typ := rulesVal.Type().Field(i)
 		status[typ.Name] = val.Interface()
 	}
-	// `IsKIP103` is not defined in the `Rules` struct. Exceptionally, we manually add it
+	// `IsKIP103` and `IsTreasuryRebalance` are not defined in the `Rules` struct. Exceptionally, we manually add them
 	status["IsKIP103"] = s.b.ChainConfig().IsKIP103ForkBlock(blkNum)
+	status["IsTreasuryRebalance"] = s.b.ChainConfig().IsTreasuryRebalanceBlock(blkNum)
 	return status, nil
 }
 
@@ -231,6 +233,16 @@ func (s *PublicBlockChainAPI) GetCode(ctx context.Context, address common.Addres
 	return code, state.Error()
 }
 
+// GetContractABI returns the ABI of the contract at the given address verified by the
+// contract verification service of this node. It returns nil if the contract is not verified.
+func (s *PublicBlockChainAPI) GetContractABI(address common.Address) json.RawMessage {
+	abi, err := s.b.ChainDB().ReadContractABI(address)
+	if err != nil || len(abi) == 0 {
+		return nil
+	}
+	return abi
+}
+
 // GetStorageAt returns the storage from the state at the given address, key and
 // block number. The rpc.LatestBlockNumber and rpc.PendingBlockNumber meta block
 // numbers and hash are also allowed.
@@ -304,6 +316,10 @@ func DoCall(ctx context.Context, b Backend, args CallArgs, blockNrOrHash rpc.Blo
 	if state == nil || err != nil {
 		return nil, 0, err
 	}
+	// The timeout configured for the RPC method overrides the given one.
+	if methodTimeout, ok := rpc.MethodTimeout(ctx); ok {
+		timeout = methodTimeout
+	}
 	// Setup context so it may be cancelled the call has completed
 	// or, in case of unmetered gas, setup a context with a timeout.
 	var cancel context.CancelFunc
@@ -470,15 +486,16 @@ func (s *PublicBlockChainAPI) CreateAccessList(ctx context.Context, args SendTxA
 // StructLogRes stores a structured log emitted by the EVM while replaying a
 // transaction in debug mode
 type StructLogRes struct {
-	Pc      uint64             `json:"pc"`
-	Op      string             `json:"op"`
-	Gas     uint64             `json:"gas"`
-	GasCost uint64             `json:"gasCost"`
-	Depth   int                `json:"depth"`
-	Error   error              `json:"error,omitempty"`
-	Stack   *[]string          `json:"stack,omitempty"`
-	Memory  *[]string          `json:"memory,omitempty"`
-	Storage *map[string]string `json:"storage,omitempty"`
+	Pc               uint64             `json:"pc"`
+	Op               string             `json:"op"`
+	Gas              uint64             `json:"gas"`
+	GasCost          uint64             `json:"gasCost"`
+	Depth            int                `json:"depth"`
+	Error            error              `json:"error,omitempty"`
+	Stack            *[]string          `json:"stack,omitempty"`
+	Memory           *[]string          `json:"memory,omitempty"`
+	Storage          *map[string]string `json:"storage,omitempty"`
+	TransientStorage *map[string]string `json:"transientStorage,omitempty"`
 }
 
 // formatLogs formats EVM returned structured logs for json output
@@ -526,6 +543,13 @@ func FormatLogs(timeout time.Duration, logs []vm.StructLog) ([]StructLogRes, err
 			}
 			formatted[index].Storage = &storage
 		}
+		if len(trace.TransientStorage) > 0 {
+			transientStorage := make(map[string]string)
+			for i, storageValue := range trace.TransientStorage {
+				transientStorage[fmt.Sprintf("%x", i)] = fmt.Sprintf("%x", storageValue)
+			}
+			formatted[index].TransientStorage = &transientStorage
+		}
 	}
 	return formatted, nil
 }
@@ -23,11 +23,22 @@ package api
 import (
 	"context"
 	"fmt"
+	"math/big"
+	"strings"
 
+	"github.com/klaytn/klaytn/accounts/abi"
+	"github.com/klaytn/klaytn/blockchain/asm"
+	"github.com/klaytn/klaytn/blockchain/vm"
+	"github.com/klaytn/klaytn/common"
+	"github.com/klaytn/klaytn/common/hexutil"
+	"github.com/klaytn/klaytn/contracts/kip13"
 	"github.com/klaytn/klaytn/networks/rpc"
+	"github.com/klaytn/klaytn/params"
 	"github.com/klaytn/klaytn/rlp"
 )
 
+var kip13ABI, _ = abi.JSON(strings.NewReader(kip13.InterfaceIdentifierABI))
+
 // PublicDebugAPI is the collection of Klaytn APIs exposed over the public
 // debugging endpoint.
 type PublicDebugAPI struct {
@@ -53,3 +64,60 @@ func (api *PublicDebugAPI) GetBlockRlp(ctx context.Context, blockNrOrHash rpc.Bl
 	}
 	return fmt.Sprintf("%x", encoded), nil
 }
+
+// AnalyzeCode returns the static analysis report of the contract code deployed at the given address.
+// In addition to the bytecode analysis, the implementation address of an EIP-1967 proxy is read
+// from the state and the KIP-13 interfaces supported by the contract are queried at the given block.
+func (api *PublicDebugAPI) AnalyzeCode(ctx context.Context, address common.Address, blockNrOrHash rpc.BlockNumberOrHash) (*asm.CodeReport, error) {
+	state, _, err := api.b.StateAndHeaderByNumberOrHash(ctx, blockNrOrHash)
+	if state == nil || err != nil {
+		return nil, err
+	}
+	code := state.GetCode(address)
+	if len(code) == 0 {
+		return nil, fmt.Errorf("no contract code at %s", address.Hex())
+	}
+
+	report := asm.AnalyzeCode(code)
+	if report.Proxy != nil && report.Proxy.Type == asm.ProxyTypeEIP1967 {
+		impl := common.BytesToAddress(state.GetState(address, asm.EIP1967ImplementationSlot).Bytes())
+		if !common.EmptyAddress(impl) {
+			report.Proxy.Implementation = &impl
+		}
+	}
+
+	// A KIP-13 compliant contract supports KIP-13 itself but not the invalid interface ID.
+	if api.supportsInterface(ctx, address, blockNrOrHash, kip13.InterfaceIDKIP13) &&
+		!api.supportsInterface(ctx, address, blockNrOrHash, kip13.InterfaceIDInvalid) {
+		report.Interfaces = append(report.Interfaces, "KIP-13")
+		for _, iface := range kip13.KnownInterfaces {
+			if api.supportsInterface(ctx, address, blockNrOrHash, iface.ID) {
+				report.Interfaces = append(report.Interfaces, iface.Name)
+			}
+		}
+	}
+	return report, nil
+}
+
+// supportsInterface calls `supportsInterface(interfaceID)` of the contract and returns the result.
+// Any failure of the call is regarded as not supporting the interface.
+func (api *PublicDebugAPI) supportsInterface(ctx context.Context, address common.Address, blockNrOrHash rpc.BlockNumberOrHash, interfaceID [4]byte) bool {
+	input, err := kip13ABI.Pack("supportsInterface", interfaceID)
+	if err != nil {
+		return false
+	}
+	args := CallArgs{
+		To:   &address,
+		Gas:  hexutil.Uint64(params.TxGas + uint64(len(input))*params.TxDataGas + kip13.SupportsInterfaceGas),
+		Data: input,
+	}
+	result, _, err := DoCall(ctx, api.b, args, blockNrOrHash, vm.Config{}, api.b.RPCEVMTimeout(), big.NewInt(0))
+	if err != nil || result.Failed() {
+		return false
+	}
+	var supported bool
+	if err := kip13ABI.Unpack(&supported, "supportsInterface", result.Return()); err != nil {
+		return false
+	}
+	return supported
+}
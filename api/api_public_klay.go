@@ -25,6 +25,7 @@ import (
 	"encoding/json"
 	"errors"
 
+	"github.com/klaytn/klaytn"
 	"github.com/klaytn/klaytn/blockchain/types/accountkey"
 	"github.com/klaytn/klaytn/common/hexutil"
 	"github.com/klaytn/klaytn/networks/rpc"
@@ -101,6 +102,57 @@ func (s *PublicKlayAPI) FeeHistory(ctx context.Context, blockCount DecimalOrHex,
 	return results, nil
 }
 
+type FeeTierResult struct {
+	MaxFeePerGas         *hexutil.Big   `json:"maxFeePerGas"`
+	MaxPriorityFeePerGas *hexutil.Big   `json:"maxPriorityFeePerGas"`
+	ExpectedBlock        hexutil.Uint64 `json:"expectedBlock"`
+}
+
+type SuggestFeesResult struct {
+	HeadBlock   hexutil.Uint64 `json:"headBlock"`
+	NextBaseFee *hexutil.Big   `json:"nextBaseFee"`
+	BaseFees    []*hexutil.Big `json:"baseFees"`
+	MinBaseFee  *hexutil.Big   `json:"minBaseFee"`
+	MaxBaseFee  *hexutil.Big   `json:"maxBaseFee"`
+	PendingGas  hexutil.Uint64 `json:"pendingGas"`
+	Slow        *FeeTierResult `json:"slow"`
+	Normal      *FeeTierResult `json:"normal"`
+	Fast        *FeeTierResult `json:"fast"`
+}
+
+// SuggestFees returns the suggested fees of slow, normal and fast tiers with the expected block
+// numbers including the transactions. The fees are derived from the base fees of the next blocks
+// projected with the KIP-71 parameters and the pending transactions.
+func (s *PublicKlayAPI) SuggestFees(ctx context.Context) (*SuggestFeesResult, error) {
+	fees, err := s.b.SuggestFees(ctx)
+	if err != nil {
+		return nil, err
+	}
+	results := &SuggestFeesResult{
+		HeadBlock:   hexutil.Uint64(fees.HeadBlock),
+		NextBaseFee: (*hexutil.Big)(fees.NextBaseFee),
+		BaseFees:    make([]*hexutil.Big, len(fees.BaseFees)),
+		MinBaseFee:  (*hexutil.Big)(fees.MinBaseFee),
+		MaxBaseFee:  (*hexutil.Big)(fees.MaxBaseFee),
+		PendingGas:  hexutil.Uint64(fees.PendingGas),
+		Slow:        newFeeTierResult(fees.Slow),
+		Normal:      newFeeTierResult(fees.Normal),
+		Fast:        newFeeTierResult(fees.Fast),
+	}
+	for i, v := range fees.BaseFees {
+		results.BaseFees[i] = (*hexutil.Big)(v)
+	}
+	return results, nil
+}
+
+func newFeeTierResult(tier klaytn.FeeTier) *FeeTierResult {
+	return &FeeTierResult{
+		MaxFeePerGas:         (*hexutil.Big)(tier.MaxFeePerGas),
+		MaxPriorityFeePerGas: (*hexutil.Big)(tier.MaxPriorityFeePerGas),
+		ExpectedBlock:        hexutil.Uint64(tier.ExpectedBlock),
+	}
+}
+
 // Syncing returns false in case the node is currently not syncing with the network. It can be up to date or has not
 // yet received the latest block headers from its pears. In case it is synchronizing:
 // - startingBlock: block number this node started to synchronise from
@@ -652,6 +652,21 @@ func (mr *MockBackendMockRecorder) SubscribeNewTxsEvent(arg0 interface{}) *gomoc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SubscribeNewTxsEvent", reflect.TypeOf((*MockBackend)(nil).SubscribeNewTxsEvent), arg0)
 }
 
+// SuggestFees mocks base method.
+func (m *MockBackend) SuggestFees(arg0 context.Context) (*klaytn.FeeSuggestion, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SuggestFees", arg0)
+	ret0, _ := ret[0].(*klaytn.FeeSuggestion)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SuggestFees indicates an expected call of SuggestFees.
+func (mr *MockBackendMockRecorder) SuggestFees(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SuggestFees", reflect.TypeOf((*MockBackend)(nil).SuggestFees), arg0)
+}
+
 // SuggestPrice mocks base method.
 func (m *MockBackend) SuggestPrice(arg0 context.Context) (*big.Int, error) {
 	m.ctrl.T.Helper()
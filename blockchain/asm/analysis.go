@@ -0,0 +1,185 @@
+// Copyright 2024 The klaytn Authors
+// This file is part of the klaytn library.
+//
+// The klaytn library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The klaytn library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the klaytn library. If not, see <http://www.gnu.org/licenses/>.
+
+package asm
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/klaytn/klaytn/blockchain/vm"
+	"github.com/klaytn/klaytn/common"
+	"github.com/klaytn/klaytn/common/hexutil"
+	"github.com/klaytn/klaytn/crypto"
+)
+
+const (
+	ProxyTypeEIP1167 = "EIP-1167"
+	ProxyTypeEIP1967 = "EIP-1967"
+)
+
+var (
+	// EIP-1967 storage slots, defined as keccak256("eip1967.proxy.<name>") - 1.
+	EIP1967ImplementationSlot = common.HexToHash("0x360894a13ba1a3210667c828492db98dca3e2076cc3735a920a3ca505d382bbc")
+	EIP1967AdminSlot          = common.HexToHash("0xb53127684a568b3173ae13b9f8a6016e243e63b6e8ee1178d6a717850b5d6103")
+	EIP1967BeaconSlot         = common.HexToHash("0xa3f0ad74e5423aebfd80d3ef4346578335a9a72aeaee59ff6cb3582b35133d50")
+
+	eip1967Slots = map[common.Hash]string{
+		EIP1967ImplementationSlot: "implementation",
+		EIP1967AdminSlot:          "admin",
+		EIP1967BeaconSlot:         "beacon",
+	}
+
+	// The runtime code of an EIP-1167 minimal proxy is eip1167Prefix || implementation || eip1167Suffix.
+	eip1167Prefix = common.FromHex("0x363d3d373d3d3d363d73")
+	eip1167Suffix = common.FromHex("0x5af43d82803e903d91602b57fd5bf3")
+
+	// klaytnPrecompiles are the Klaytn specific precompiled contracts.
+	klaytnPrecompiles = map[common.Address]string{
+		common.BytesToAddress([]byte{3, 253}): "vmLog",
+		common.BytesToAddress([]byte{3, 254}): "feePayer",
+		common.BytesToAddress([]byte{3, 255}): "validateSender",
+	}
+)
+
+// CodeReport is the result of the static analysis of EVM bytecode.
+// Since the analysis only inspects the bytecode, every finding is a heuristic;
+// e.g., a function selector is reported only if it is compared by the dispatcher
+// and a precompile is reported if its address is pushed onto the stack.
+type CodeReport struct {
+	CodeSize          int               `json:"codeSize"`
+	CodeHash          common.Hash       `json:"codeHash"`
+	Instructions      []string          `json:"instructions"`
+	FunctionSelectors []hexutil.Bytes   `json:"functionSelectors"`
+	SelfDestruct      bool              `json:"selfDestruct"`
+	DelegateCall      bool              `json:"delegateCall"`
+	CallCode          bool              `json:"callCode"`
+	Create            bool              `json:"create"`
+	Precompiles       map[string]string `json:"klaytnPrecompiles"`
+	Proxy             *ProxyReport      `json:"proxy,omitempty"`
+	Interfaces        []string          `json:"interfaces,omitempty"`
+	Error             string            `json:"error,omitempty"`
+}
+
+// ProxyReport describes the proxy pattern detected in the bytecode.
+type ProxyReport struct {
+	Type           string          `json:"type"`
+	Slots          []string        `json:"slots,omitempty"`
+	Implementation *common.Address `json:"implementation,omitempty"`
+}
+
+// AnalyzeCode disassembles the given code and reports the security-relevant
+// properties of it. If the disassembly stops in the middle of the code, e.g., due
+// to the metadata appended by the compiler, the report covers the instructions
+// before the failure and the error is recorded in the report.
+func AnalyzeCode(code []byte) *CodeReport {
+	report := &CodeReport{
+		CodeSize:          len(code),
+		CodeHash:          crypto.Keccak256Hash(code),
+		Instructions:      make([]string, 0),
+		FunctionSelectors: make([]hexutil.Bytes, 0),
+		Precompiles:       make(map[string]string),
+	}
+	if impl, ok := eip1167Implementation(code); ok {
+		report.Proxy = &ProxyReport{Type: ProxyTypeEIP1167, Implementation: &impl}
+	}
+
+	var (
+		it       = NewInstructionIterator(code)
+		selector []byte // the latest 4-byte push which can be a function selector
+		prevOp   vm.OpCode
+		seen     = make(map[string]bool)
+	)
+	for it.Next() {
+		op, arg := it.Op(), it.Arg()
+		if len(arg) > 0 {
+			report.Instructions = append(report.Instructions, fmt.Sprintf("0x%06x: %v 0x%x", it.PC(), op, arg))
+		} else {
+			report.Instructions = append(report.Instructions, fmt.Sprintf("0x%06x: %v", it.PC(), op))
+		}
+
+		switch op {
+		case vm.SELFDESTRUCT:
+			report.SelfDestruct = true
+		case vm.DELEGATECALL:
+			report.DelegateCall = true
+		case vm.CALLCODE:
+			report.CallCode = true
+		case vm.CREATE, vm.CREATE2:
+			report.Create = true
+		case vm.EQ:
+			// The dispatcher compares the selector of calldata with `PUSH4 selector`
+			// directly or after duplicating the calldata selector with DUP2.
+			if selector != nil && (prevOp == vm.PUSH4 || prevOp == vm.DUP2) {
+				if key := hexutil.Encode(selector); !seen[key] {
+					seen[key] = true
+					report.FunctionSelectors = append(report.FunctionSelectors, selector)
+				}
+			}
+		}
+
+		if op == vm.PUSH4 {
+			selector = common.CopyBytes(arg)
+		} else if op != vm.DUP2 {
+			selector = nil
+		}
+		if op.IsPush() {
+			analyzePushedValue(report, arg)
+		}
+		prevOp = op
+	}
+	if err := it.Error(); err != nil {
+		report.Error = err.Error()
+	}
+	return report
+}
+
+// analyzePushedValue inspects a pushed value to find the references to
+// Klaytn precompiled contracts and EIP-1967 storage slots.
+func analyzePushedValue(report *CodeReport, arg []byte) {
+	if len(arg) <= common.AddressLength {
+		addr := common.BytesToAddress(arg)
+		if name, ok := klaytnPrecompiles[addr]; ok {
+			report.Precompiles[addr.Hex()] = name
+		}
+	}
+	if len(arg) == common.HashLength {
+		if name, ok := eip1967Slots[common.BytesToHash(arg)]; ok {
+			if report.Proxy == nil {
+				report.Proxy = &ProxyReport{Type: ProxyTypeEIP1967}
+			}
+			if report.Proxy.Type == ProxyTypeEIP1967 {
+				for _, slot := range report.Proxy.Slots {
+					if slot == name {
+						return
+					}
+				}
+				report.Proxy.Slots = append(report.Proxy.Slots, name)
+			}
+		}
+	}
+}
+
+// eip1167Implementation returns the implementation address if the code is an EIP-1167 minimal proxy.
+func eip1167Implementation(code []byte) (common.Address, bool) {
+	if len(code) != len(eip1167Prefix)+common.AddressLength+len(eip1167Suffix) {
+		return common.Address{}, false
+	}
+	if !bytes.HasPrefix(code, eip1167Prefix) || !bytes.HasSuffix(code, eip1167Suffix) {
+		return common.Address{}, false
+	}
+	return common.BytesToAddress(code[len(eip1167Prefix) : len(eip1167Prefix)+common.AddressLength]), true
+}
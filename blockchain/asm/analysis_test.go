@@ -0,0 +1,77 @@
+// Copyright 2024 The klaytn Authors
+// This file is part of the klaytn library.
+//
+// The klaytn library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The klaytn library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the klaytn library. If not, see <http://www.gnu.org/licenses/>.
+
+package asm
+
+import (
+	"testing"
+
+	"github.com/klaytn/klaytn/blockchain/vm"
+	"github.com/klaytn/klaytn/common"
+	"github.com/klaytn/klaytn/common/hexutil"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAnalyzeCode(t *testing.T) {
+	code := []byte{
+		// dispatcher comparing selectors in both solc styles
+		byte(vm.PUSH1), 0x00, byte(vm.CALLDATALOAD), byte(vm.PUSH1), 0xe0, byte(vm.SHR),
+		byte(vm.DUP1), byte(vm.PUSH4), 0xa9, 0x05, 0x9c, 0xbb, byte(vm.EQ), byte(vm.PUSH1), 0x30, byte(vm.JUMPI),
+		byte(vm.PUSH4), 0x70, 0xa0, 0x82, 0x31, byte(vm.DUP2), byte(vm.EQ), byte(vm.PUSH1), 0x30, byte(vm.JUMPI),
+		// a range check of the binary search is not a selector
+		byte(vm.DUP1), byte(vm.PUSH4), 0x18, 0x16, 0x0d, 0xdd, byte(vm.GT), byte(vm.PUSH1), 0x30, byte(vm.JUMPI),
+		// read the EIP-1967 implementation slot and delegate the call
+		byte(vm.PUSH32),
+	}
+	code = append(code, EIP1967ImplementationSlot.Bytes()...)
+	code = append(code,
+		byte(vm.SLOAD), byte(vm.GAS), byte(vm.DELEGATECALL),
+		// call the validateSender precompile
+		byte(vm.PUSH2), 0x03, 0xff, byte(vm.GAS), byte(vm.STATICCALL),
+		byte(vm.CALLER), byte(vm.SELFDESTRUCT),
+	)
+
+	report := AnalyzeCode(code)
+	assert.Equal(t, len(code), report.CodeSize)
+	assert.Equal(t, "0x000000: PUSH1 0x00", report.Instructions[0])
+	assert.Equal(t, []hexutil.Bytes{{0xa9, 0x05, 0x9c, 0xbb}, {0x70, 0xa0, 0x82, 0x31}}, report.FunctionSelectors)
+	assert.True(t, report.DelegateCall)
+	assert.True(t, report.SelfDestruct)
+	assert.False(t, report.CallCode)
+	assert.False(t, report.Create)
+	assert.Equal(t, map[string]string{common.BytesToAddress([]byte{3, 255}).Hex(): "validateSender"}, report.Precompiles)
+	assert.Equal(t, &ProxyReport{Type: ProxyTypeEIP1967, Slots: []string{"implementation"}}, report.Proxy)
+	assert.Empty(t, report.Error)
+}
+
+func TestAnalyzeCode_EIP1167(t *testing.T) {
+	impl := common.HexToAddress("0xbebebebebebebebebebebebebebebebebebebebe")
+	code := common.FromHex("0x363d3d373d3d3d363d73bebebebebebebebebebebebebebebebebebebebe5af43d82803e903d91602b57fd5bf3")
+
+	report := AnalyzeCode(code)
+	assert.Equal(t, &ProxyReport{Type: ProxyTypeEIP1167, Implementation: &impl}, report.Proxy)
+	assert.True(t, report.DelegateCall)
+	assert.Empty(t, report.Error)
+}
+
+func TestAnalyzeCode_IncompletePush(t *testing.T) {
+	code := []byte{byte(vm.CALLER), byte(vm.SELFDESTRUCT), byte(vm.PUSH2), 0x03}
+
+	report := AnalyzeCode(code)
+	assert.Equal(t, []string{"0x000000: CALLER", "0x000001: SELFDESTRUCT"}, report.Instructions)
+	assert.True(t, report.SelfDestruct)
+	assert.Equal(t, "incomplete push instruction at 2", report.Error)
+}
@@ -24,6 +24,7 @@ Package asm provides support for dealing with EVM assembly instructions (e.g., d
 Source Files
 
 Each file provides the following features.
+  - analysis.go: provides a static analyzer which reports the security-relevant properties of EVM bytecode.
   - asm.go: provides instruction iterators for EVM assembly instructions.
   - compiler.go: provides a compiler which compiles input tokens and returns EVM binaries.
   - lexer.go: provides the basic construct for parsing source code and turning them into tokens.
@@ -170,6 +170,7 @@ type BlockChain struct {
 	chainFeed     event.Feed
 	chainSideFeed event.Feed
 	chainHeadFeed event.Feed
+	setHeadFeed   event.Feed
 	logsFeed      event.Feed
 	scope         event.SubscriptionScope
 	genesisBlock  *types.Block
@@ -632,7 +633,11 @@ func (bc *BlockChain) setHeadBeyondRoot(head uint64, root common.Hash, repair bo
 	bc.db.ClearBlockChainCache()
 	// TODO-Klaytn add governance DB deletion logic.
 
-	return rootNumber, bc.loadLastState()
+	if err := bc.loadLastState(); err != nil {
+		return rootNumber, err
+	}
+	bc.setHeadFeed.Send(SetHeadEvent{Block: bc.CurrentBlock()})
+	return rootNumber, nil
 }
 
 // FastSyncCommitHead sets the current head block to the one defined by the hash
@@ -2096,6 +2101,12 @@ func (bc *BlockChain) insertChain(chain types.Blocks) (int, []interface{}, []*ty
 			if err = istanbul.UpdateParam(block.NumberU64()); err != nil {
 				return i, events, coalescedLogs, err
 			}
+			// store the treasury rebalance result only when the block is written on the canonical chain
+			if writeResult.Status == CanonStatTy {
+				if err := istanbul.WriteTreasuryRebalanceResult(block); err != nil {
+					logger.Warn("Failed to store treasury rebalancing result", "number", block.Number(), "err", err)
+				}
+			}
 		}
 	}
 	// Append a single chain head event if we've progressed the chain
@@ -2644,6 +2655,11 @@ func (bc *BlockChain) SubscribeChainHeadEvent(ch chan<- ChainHeadEvent) event.Su
 	return bc.scope.Track(bc.chainHeadFeed.Subscribe(ch))
 }
 
+// SubscribeSetHeadEvent registers a subscription of SetHeadEvent.
+func (bc *BlockChain) SubscribeSetHeadEvent(ch chan<- SetHeadEvent) event.Subscription {
+	return bc.scope.Track(bc.setHeadFeed.Subscribe(ch))
+}
+
 // SubscribeChainSideEvent registers a subscription of ChainSideEvent.
 func (bc *BlockChain) SubscribeChainSideEvent(ch chan<- ChainSideEvent) event.Subscription {
 	return bc.scope.Track(bc.chainSideFeed.Subscribe(ch))
@@ -2701,12 +2717,14 @@ func (bc *BlockChain) ApplyTransaction(chainConfig *params.ChainConfig, author *
 		return nil, nil, err
 	}
 
-	msg, err := tx.AsMessageWithAccountKeyPicker(types.MakeSigner(chainConfig, header.Number), statedb, blockNumber)
+	// Create a new context to be used in the EVM environment
+	blockContext := NewEVMBlockContext(header, bc, author)
+
+	picker := NewContractKeyPicker(statedb, blockContext, chainConfig)
+	msg, err := tx.AsMessageWithAccountKeyPicker(types.MakeSigner(chainConfig, header.Number), picker, blockNumber)
 	if err != nil {
 		return nil, nil, err
 	}
-	// Create a new context to be used in the EVM environment
-	blockContext := NewEVMBlockContext(header, bc, author)
 	txContext := NewEVMTxContext(msg, header)
 	// Create a new environment which holds all relevant information
 	// about the transaction and calling mechanisms.
@@ -0,0 +1,89 @@
+// Copyright 2024 The klaytn Authors
+// This file is part of the klaytn library.
+//
+// The klaytn library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The klaytn library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the klaytn library. If not, see <http://www.gnu.org/licenses/>.
+
+package blockchain
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/klaytn/klaytn/accounts/abi"
+	"github.com/klaytn/klaytn/blockchain/state"
+	"github.com/klaytn/klaytn/blockchain/vm"
+	"github.com/klaytn/klaytn/common"
+	"github.com/klaytn/klaytn/params"
+)
+
+// isValidSignatureABI is the ABI of the EIP-1271 signature validation method
+// which should be implemented by the validator contract of AccountKeyContract.
+const isValidSignatureABI = `[{"inputs":[{"name":"hash","type":"bytes32"},{"name":"signature","type":"bytes"}],"name":"isValidSignature","outputs":[{"name":"magicValue","type":"bytes4"}],"stateMutability":"view","type":"function"}]`
+
+var (
+	// isValidSignatureMagicValue is returned by the validator contract if the signature is valid.
+	isValidSignatureMagicValue = []byte{0x16, 0x26, 0xba, 0x7e}
+
+	contractKeyValidatorABI, _ = abi.JSON(strings.NewReader(isValidSignatureABI))
+
+	ErrContractKeyRejected = errors.New("the signature is rejected by the validator contract")
+)
+
+// ContractKeyPicker is an AccountKeyPicker which executes the validation logic of
+// AccountKeyContract on top of the wrapped state. It implements accountkey.ContractKeyValidator.
+type ContractKeyPicker struct {
+	*state.StateDB
+
+	blockContext vm.BlockContext
+	chainConfig  *params.ChainConfig
+}
+
+// NewContractKeyPicker returns a ContractKeyPicker running the validator contracts
+// with the given block context on top of statedb.
+func NewContractKeyPicker(statedb *state.StateDB, blockContext vm.BlockContext, chainConfig *params.ChainConfig) *ContractKeyPicker {
+	return &ContractKeyPicker{
+		StateDB:      statedb,
+		blockContext: blockContext,
+		chainConfig:  chainConfig,
+	}
+}
+
+// ValidateContractKey calls `isValidSignature(sigHash, sig)` of the validator contract with a static call.
+// Since it is a static call, the state is not modified by the validator contract.
+func (p *ContractKeyPicker) ValidateContractKey(validator common.Address, from common.Address, sigHash common.Hash, sig []byte, gasCap uint64) error {
+	if !p.IsContractAvailable(validator) {
+		return fmt.Errorf("%w: validator %s is not a contract", ErrContractKeyRejected, validator.String())
+	}
+
+	input, err := contractKeyValidatorABI.Pack("isValidSignature", sigHash, sig)
+	if err != nil {
+		return err
+	}
+
+	txContext := vm.TxContext{Origin: from, GasPrice: new(big.Int)}
+	evm := vm.NewEVM(p.blockContext, txContext, p.StateDB, p.chainConfig, &vm.Config{})
+
+	ret, _, err := evm.StaticCall(vm.AccountRef(from), validator, input, gasCap)
+	if err != nil {
+		return fmt.Errorf("%w: %v", ErrContractKeyRejected, err)
+	}
+	// The result is an ABI-encoded bytes4, which is left-aligned in a 32-byte word.
+	if len(ret) < common.HashLength || !bytes.Equal(ret[:len(isValidSignatureMagicValue)], isValidSignatureMagicValue) {
+		return ErrContractKeyRejected
+	}
+	return nil
+}
@@ -0,0 +1,131 @@
+// Copyright 2024 The klaytn Authors
+// This file is part of the klaytn library.
+//
+// The klaytn library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The klaytn library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the klaytn library. If not, see <http://www.gnu.org/licenses/>.
+
+package blockchain
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/klaytn/klaytn/blockchain/state"
+	"github.com/klaytn/klaytn/blockchain/types"
+	"github.com/klaytn/klaytn/blockchain/types/accountkey"
+	"github.com/klaytn/klaytn/common"
+	"github.com/klaytn/klaytn/common/hexutil"
+	"github.com/klaytn/klaytn/crypto"
+	"github.com/klaytn/klaytn/params"
+	"github.com/klaytn/klaytn/storage/database"
+	"github.com/stretchr/testify/assert"
+)
+
+var (
+	// acceptingValidatorCode returns the EIP-1271 magic value for any input.
+	// PUSH4 0x1626ba7e PUSH1 0xe0 SHL PUSH1 0 MSTORE PUSH1 0x20 PUSH1 0 RETURN
+	acceptingValidatorCode = hexutil.MustDecode("0x631626ba7e60e01b60005260206000f3")
+	// rejectingValidatorCode returns a zero word for any input.
+	// PUSH1 0x20 PUSH1 0 RETURN
+	rejectingValidatorCode = hexutil.MustDecode("0x60206000f3")
+	// exhaustingValidatorCode loops forever until it runs out of gas.
+	// JUMPDEST PUSH1 0 JUMP
+	exhaustingValidatorCode = hexutil.MustDecode("0x5b600056")
+)
+
+func newContractKeyTestPicker(t *testing.T) (*ContractKeyPicker, map[string]common.Address) {
+	statedb, err := state.New(common.Hash{}, state.NewDatabase(database.NewMemoryDBManager()), nil, nil)
+	assert.NoError(t, err)
+
+	validators := map[string]common.Address{
+		"accepting":  common.HexToAddress("0x0000000000000000000000000000000000000a01"),
+		"rejecting":  common.HexToAddress("0x0000000000000000000000000000000000000a02"),
+		"exhausting": common.HexToAddress("0x0000000000000000000000000000000000000a03"),
+	}
+	codes := map[string][]byte{
+		"accepting":  acceptingValidatorCode,
+		"rejecting":  rejectingValidatorCode,
+		"exhausting": exhaustingValidatorCode,
+	}
+	for name, addr := range validators {
+		statedb.CreateSmartContractAccount(addr, params.CodeFormatEVM, params.Rules{IsIstanbul: true})
+		statedb.SetCode(addr, codes[name])
+	}
+
+	header := &types.Header{Number: big.NewInt(1), Time: big.NewInt(1), BlockScore: common.Big1}
+	config := &params.ChainConfig{ChainID: big.NewInt(1), IstanbulCompatibleBlock: common.Big0}
+	return NewContractKeyPicker(statedb, NewEVMBlockContext(header, nil, &common.Address{}), config), validators
+}
+
+func TestContractKeyPicker_ValidateContractKey(t *testing.T) {
+	picker, validators := newContractKeyTestPicker(t)
+	from := common.HexToAddress("0x0000000000000000000000000000000000001234")
+	hash := crypto.Keccak256Hash([]byte("hello"))
+
+	assert.NoError(t, picker.ValidateContractKey(validators["accepting"], from, hash, make([]byte, 65), params.TxValidationGasContractKey))
+	assert.ErrorIs(t, picker.ValidateContractKey(validators["rejecting"], from, hash, make([]byte, 65), params.TxValidationGasContractKey), ErrContractKeyRejected)
+	assert.ErrorIs(t, picker.ValidateContractKey(validators["exhausting"], from, hash, make([]byte, 65), params.TxValidationGasContractKey), ErrContractKeyRejected)
+	// An account without code cannot be a validator.
+	assert.ErrorIs(t, picker.ValidateContractKey(from, from, hash, make([]byte, 65), params.TxValidationGasContractKey), ErrContractKeyRejected)
+}
+
+func TestContractKeyPicker_ValidateSender(t *testing.T) {
+	picker, validators := newContractKeyTestPicker(t)
+	signer := types.LatestSignerForChainID(big.NewInt(1))
+
+	key, _ := crypto.GenerateKey()
+	from := crypto.PubkeyToAddress(key.PublicKey)
+	to := common.HexToAddress("0x0000000000000000000000000000000000005678")
+
+	testcases := []struct {
+		name    string
+		accKey  accountkey.AccountKey
+		success bool
+	}{
+		{"accepting", accountkey.NewAccountKeyContractWithValue(validators["accepting"]), true},
+		{"rejecting", accountkey.NewAccountKeyContractWithValue(validators["rejecting"]), false},
+		{"roleBasedAccepting", accountkey.NewAccountKeyRoleBasedWithValues(accountkey.AccountKeyRoleBased{
+			accountkey.NewAccountKeyContractWithValue(validators["accepting"]),
+		}), true},
+		{"roleBasedRejecting", accountkey.NewAccountKeyRoleBasedWithValues(accountkey.AccountKeyRoleBased{
+			accountkey.NewAccountKeyContractWithValue(validators["rejecting"]),
+		}), false},
+	}
+
+	for i, tc := range testcases {
+		picker.CreateEOA(from, false, tc.accKey)
+
+		tx, err := types.NewTransactionWithMap(types.TxTypeValueTransfer, map[types.TxValueKeyType]interface{}{
+			types.TxValueKeyNonce:    uint64(i),
+			types.TxValueKeyTo:       to,
+			types.TxValueKeyAmount:   common.Big1,
+			types.TxValueKeyGasLimit: uint64(100000),
+			types.TxValueKeyGasPrice: common.Big1,
+			types.TxValueKeyFrom:     from,
+		})
+		assert.NoError(t, err)
+		assert.NoError(t, tx.Sign(signer, key))
+
+		gas, err := tx.ValidateSender(signer, picker, 1)
+		if tc.success {
+			assert.NoError(t, err, tc.name)
+			assert.Equal(t, params.TxValidationGasContractKey, gas, tc.name)
+		} else {
+			assert.ErrorIs(t, err, types.ErrInvalidAccountKey, tc.name)
+		}
+
+		// Without a ContractKeyValidator, AccountKeyContract cannot be validated.
+		_, err = tx.ValidateSender(signer, picker.StateDB, 1)
+		assert.ErrorIs(t, err, types.ErrInvalidAccountKey, tc.name)
+	}
+}
@@ -66,3 +66,6 @@ type ChainSideEvent struct {
 }
 
 type ChainHeadEvent struct{ Block *types.Block }
+
+// SetHeadEvent is posted when the head is rewound by SetHead.
+type SetHeadEvent struct{ Block *types.Block }
@@ -95,6 +95,9 @@ type StateDB struct {
 	// Per-transaction access list
 	accessList *accessList
 
+	// Transient storage of EIP-1153, which is discarded at the end of every transaction
+	transientStorage transientStorage
+
 	// Journal of state modifications. This is the backbone of
 	// Snapshot and RevertToSnapshot.
 	journal        *journal
@@ -134,6 +137,7 @@ func New(root common.Hash, db Database, snaps *snapshot.Tree, opts *statedb.Trie
 		logs:                     make(map[common.Hash][]*types.Log),
 		preimages:                make(map[common.Hash][]byte),
 		accessList:               newAccessList(),
+		transientStorage:         newTransientStorage(),
 		journal:                  newJournal(),
 	}
 	if sdb.snaps != nil {
@@ -188,6 +192,7 @@ func (self *StateDB) Reset(root common.Hash) error {
 	self.preimages = make(map[common.Hash][]byte)
 	self.clearJournalAndRefund()
 	self.accessList = newAccessList()
+	self.transientStorage = newTransientStorage()
 	return nil
 }
 
@@ -474,6 +479,33 @@ func (self *StateDB) SetState(addr common.Address, key, value common.Hash) {
 	}
 }
 
+// SetTransientState sets transient storage for a given account. It
+// adds the change to the journal so that it can be rolled back
+// to its previous value if there is a revert.
+func (self *StateDB) SetTransientState(addr common.Address, key, value common.Hash) {
+	prev := self.GetTransientState(addr, key)
+	if prev == value {
+		return
+	}
+	self.journal.append(transientStorageChange{
+		account:  &addr,
+		key:      key,
+		prevalue: prev,
+	})
+	self.setTransientState(addr, key, value)
+}
+
+// setTransientState is a lower level setter for transient storage. It
+// is called during a revert to prevent modifications to the journal.
+func (self *StateDB) setTransientState(addr common.Address, key, value common.Hash) {
+	self.transientStorage.Set(addr, key, value)
+}
+
+// GetTransientState gets transient storage for a given account.
+func (self *StateDB) GetTransientState(addr common.Address, key common.Hash) common.Hash {
+	return self.transientStorage.Get(addr, key)
+}
+
 // SetStorage replaces the entire storage for the specified account with given
 // storage. This function should only be used for debugging.
 func (self *StateDB) SetStorage(addr common.Address, storage map[common.Hash]common.Hash) {
@@ -819,6 +851,7 @@ func (self *StateDB) Copy() *StateDB {
 	// However, it doesn't cost us much to copy an empty list, so we do it anyway
 	// to not blow up if we ever decide copy it in the middle of a transaction
 	state.accessList = self.accessList.Copy()
+	state.transientStorage = self.transientStorage.Copy()
 
 	if self.snaps != nil {
 		// In order for the miner to be able to use and make additions
@@ -1087,7 +1120,13 @@ func (s *StateDB) GetContractStorageRoot(contractAddr common.Address) (common.Ex
 //
 // regards to EIP-3651:
 // - Add coinbase to access list (EIP-3651)
+//
+// regards to EIP-1153:
+// - Reset transient storage (EIP-1153)
 func (s *StateDB) PrepareAccessList(rules params.Rules, sender, feepayer, coinbase common.Address, dst *common.Address, precompiles []common.Address, list types.AccessList) {
+	// Reset transient storage at the beginning of transaction execution
+	s.transientStorage = newTransientStorage()
+
 	if rules.IsKore {
 		// Clear out any leftover from previous executions
 		s.accessList = newAccessList()
@@ -788,3 +788,36 @@ func TestStateDBAccessList(t *testing.T) {
 		t.Fatalf("expected empty, got %d", got)
 	}
 }
+
+func TestStateDBTransientStorage(t *testing.T) {
+	memDb := database.NewMemoryDBManager()
+	state, _ := New(common.Hash{}, NewDatabase(memDb), nil, nil)
+
+	var (
+		addr  = common.HexToAddress("0xaa")
+		key   = common.HexToHash("0x01")
+		value = common.HexToHash("0x02")
+	)
+	root := state.IntermediateRoot(false)
+
+	state.SetTransientState(addr, key, value)
+	if exp, got := 1, state.journal.length(); exp != got {
+		t.Fatalf("journal length mismatch: have %d, want %d", got, exp)
+	}
+
+	// The copied statedb should have the same transient storage.
+	cpy := state.Copy()
+	assert.Equal(t, value, cpy.GetTransientState(addr, key))
+
+	// Reverting the journal restores the previous value.
+	state.journal.revert(state, 0)
+	assert.Equal(t, common.Hash{}, state.GetTransientState(addr, key))
+	assert.Equal(t, value, cpy.GetTransientState(addr, key))
+
+	// Transient storage is not a part of the state trie.
+	assert.Equal(t, root, cpy.IntermediateRoot(false))
+
+	// Transient storage is discarded at the beginning of every transaction.
+	cpy.PrepareAccessList(params.Rules{IsCancun: true}, common.Address{}, common.Address{}, common.Address{}, nil, nil, nil)
+	assert.Equal(t, common.Hash{}, cpy.GetTransientState(addr, key))
+}
@@ -95,12 +95,13 @@ func (p *statePrefetcher) PrefetchTx(block *types.Block, ti int, stateDB *state.
 // the transaction successfully, rather to warm up touched data slots.
 func precacheTransaction(config *params.ChainConfig, bc ChainContext, author *common.Address, statedb *state.StateDB, header *types.Header, tx *types.Transaction, cfg vm.Config) error {
 	// Convert the transaction into an executable message and pre-cache its sender
-	msg, err := tx.AsMessageWithAccountKeyPicker(types.MakeSigner(config, header.Number), statedb, header.Number.Uint64())
+	blockContext := NewEVMBlockContext(header, bc, author)
+	picker := NewContractKeyPicker(statedb, blockContext, config)
+	msg, err := tx.AsMessageWithAccountKeyPicker(types.MakeSigner(config, header.Number), picker, header.Number.Uint64())
 	if err != nil {
 		return err
 	}
 	// Create the EVM and execute the transaction
-	blockContext := NewEVMBlockContext(header, bc, author)
 	txContext := NewEVMTxContext(msg, header)
 	vm := vm.NewEVM(blockContext, txContext, statedb, config, &cfg)
 
@@ -350,6 +350,7 @@ func (l *txList) Forward(threshold uint64) types.Transactions {
 // is lower than the costcap, the caps will be reset to a new high after removing
 // the newly invalidated transactions.
 func (l *txList) Filter(sender common.Address, pool *TxPool) (types.Transactions, types.Transactions) {
+	picker := pool.contractKeyPicker()
 	// Filter out all the transactions above the account's funds
 	removed := l.txs.Filter(func(tx *types.Transaction) bool {
 		senderBalance := pool.getBalance(sender)
@@ -358,7 +359,7 @@ func (l *txList) Filter(sender common.Address, pool *TxPool) (types.Transactions
 			return true
 		}
 		// Since there are mutable values such as accountKey in the state, a tx can be invalidated with the state change.
-		if tx.ValidateMutableValue(pool.currentState, pool.signer, pool.currentBlockNumber) != nil {
+		if tx.ValidateMutableValue(picker, pool.signer, pool.currentBlockNumber) != nil {
 			return true
 		}
 		// In case of fee-delegated transactions, the comparison value should consider tx fee and fee ratio.
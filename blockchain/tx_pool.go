@@ -33,6 +33,7 @@ import (
 	"github.com/klaytn/klaytn/blockchain/types"
 	"github.com/klaytn/klaytn/common"
 	"github.com/klaytn/klaytn/common/prque"
+	"github.com/klaytn/klaytn/consensus"
 	"github.com/klaytn/klaytn/consensus/misc"
 	"github.com/klaytn/klaytn/event"
 	"github.com/klaytn/klaytn/kerrors"
@@ -660,6 +661,31 @@ func (pool *TxPool) local() map[common.Address]types.Transactions {
 	return txs
 }
 
+// contractKeyPicker returns an AccountKeyPicker of the current state which is also
+// able to execute the validator contracts of AccountKeyContract.
+func (pool *TxPool) contractKeyPicker() *ContractKeyPicker {
+	head := pool.chain.CurrentBlock().Header()
+	blockContext := NewEVMBlockContext(head, txPoolChainContext{pool.chain}, &head.Rewardbase)
+	return NewContractKeyPicker(pool.currentState, blockContext, pool.chainconfig)
+}
+
+// txPoolChainContext implements ChainContext with the blockChain of TxPool.
+// The consensus engine is not available, hence the author should be given explicitly.
+type txPoolChainContext struct {
+	chain blockChain
+}
+
+func (c txPoolChainContext) Engine() consensus.Engine {
+	return nil
+}
+
+func (c txPoolChainContext) GetHeader(hash common.Hash, number uint64) *types.Header {
+	if block := c.chain.GetBlock(hash, number); block != nil {
+		return block.Header()
+	}
+	return nil
+}
+
 // validateTx checks whether a transaction is valid according to the consensus
 // rules and adheres to some heuristic limits of the local node (price and size).
 func (pool *TxPool) validateTx(tx *types.Transaction) error {
@@ -746,7 +772,8 @@ func (pool *TxPool) validateTx(tx *types.Transaction) error {
 	}
 
 	// Make sure the transaction is signed properly
-	gasFrom, err := tx.ValidateSender(pool.signer, pool.currentState, pool.currentBlockNumber)
+	picker := pool.contractKeyPicker()
+	gasFrom, err := tx.ValidateSender(pool.signer, picker, pool.currentBlockNumber)
 	if err != nil {
 		return types.ErrSender(err)
 	}
@@ -765,7 +792,7 @@ func (pool *TxPool) validateTx(tx *types.Transaction) error {
 	// cost == V + GP * GL
 	if tx.IsFeeDelegatedTransaction() {
 		// balance check for fee-delegated tx
-		gasFeePayer, err = tx.ValidateFeePayer(pool.signer, pool.currentState, pool.currentBlockNumber)
+		gasFeePayer, err = tx.ValidateFeePayer(pool.signer, picker, pool.currentBlockNumber)
 		if err != nil {
 			return types.ErrFeePayer(err)
 		}
@@ -33,6 +33,8 @@ const (
 	AccountKeyTypeFail
 	AccountKeyTypeWeightedMultiSig
 	AccountKeyTypeRoleBased
+	AccountKeyTypeContract
+	AccountKeyTypeP256
 	AccountKeyTypeLast
 )
 
@@ -52,6 +54,8 @@ func (a AccountKeyType) IsLegacyAccountKey() bool {
 // Currently, we have the following implementations of AccountKey:
 // - AccountKeyLegacy
 // - AccountKeyPublic
+// - AccountKeyContract
+// - AccountKeyP256
 type AccountKey interface {
 	// Type returns the type of account key.
 	Type() AccountKeyType
@@ -106,6 +110,10 @@ func NewAccountKey(t AccountKeyType) (AccountKey, error) {
 		return NewAccountKeyWeightedMultiSig(), nil
 	case AccountKeyTypeRoleBased:
 		return NewAccountKeyRoleBased(), nil
+	case AccountKeyTypeContract:
+		return NewAccountKeyContract(), nil
+	case AccountKeyTypeP256:
+		return NewAccountKeyP256(), nil
 	}
 
 	return nil, errUndefinedAccountKeyType
@@ -118,6 +126,19 @@ func ValidateAccountKey(currentBlockNumber uint64, from common.Address, accKey A
 	return nil
 }
 
+// KeyForRole returns the key used to validate a signature of the given role.
+// If accKey is not a role-based key, accKey itself is returned.
+func KeyForRole(accKey AccountKey, r RoleType) AccountKey {
+	roleBasedKey, ok := accKey.(*AccountKeyRoleBased)
+	if !ok {
+		return accKey
+	}
+	if len(*roleBasedKey) > int(r) {
+		return (*roleBasedKey)[r]
+	}
+	return roleBasedKey.getDefaultKey()
+}
+
 // CheckReplacable returns nil if newKey can replace oldKey. The function checks updatability of newKey regardless of the newKey type.
 func CheckReplacable(oldKey AccountKey, newKey AccountKey, currentBlockNumber uint64) error {
 	if oldKey.Type() == newKey.Type() {
@@ -0,0 +1,134 @@
+// Copyright 2024 The klaytn Authors
+// This file is part of the klaytn library.
+//
+// The klaytn library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The klaytn library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the klaytn library. If not, see <http://www.gnu.org/licenses/>.
+
+package accountkey
+
+import (
+	"crypto/ecdsa"
+	"fmt"
+	"math/big"
+
+	"github.com/klaytn/klaytn/common"
+	"github.com/klaytn/klaytn/fork"
+	"github.com/klaytn/klaytn/kerrors"
+	"github.com/klaytn/klaytn/params"
+)
+
+// ContractKeyValidator executes the signature validation logic of AccountKeyContract.
+// It is implemented by AccountKeyPickers which can run the EVM on top of the state.
+type ContractKeyValidator interface {
+	// ValidateContractKey calls `isValidSignature(sigHash, sig)` of the validator contract
+	// on behalf of `from` with the given gas cap.
+	// It returns nil only if the validator contract approves the signature.
+	ValidateContractKey(validator common.Address, from common.Address, sigHash common.Hash, sig []byte, gasCap uint64) error
+}
+
+// AccountKeyContract delegates the signature validation of an account to a validator contract.
+// In this case, verifying the signature of a transaction is performed as following:
+// 1. The signatures of the tx are recovered to make sure that they are well-formed.
+// 2. `isValidSignature(bytes32 hash, bytes signature)` of the validator contract is called with the tx signature hash.
+// 3. The signature is valid only if the call returns the EIP-1271 magic value 0x1626ba7e.
+//
+// The signatures of the tx are passed to the validator contract in the [R || S || V] format where V is 27 or 28.
+// If Validator is the zero address, the account itself is used as the validator contract.
+// The validation is executed with the gas cap of params.TxValidationGasContractKey, which is
+// also charged as the validation gas of the transaction.
+type AccountKeyContract struct {
+	Validator common.Address `json:"validator"`
+}
+
+func NewAccountKeyContractWithValue(validator common.Address) *AccountKeyContract {
+	return &AccountKeyContract{Validator: validator}
+}
+
+func NewAccountKeyContract() *AccountKeyContract {
+	return &AccountKeyContract{}
+}
+
+// ValidatorOf returns the address of the contract validating the signatures of `from`.
+func (a *AccountKeyContract) ValidatorOf(from common.Address) common.Address {
+	if common.EmptyAddress(a.Validator) {
+		return from
+	}
+	return a.Validator
+}
+
+func (a *AccountKeyContract) Type() AccountKeyType {
+	return AccountKeyTypeContract
+}
+
+func (a *AccountKeyContract) IsCompositeType() bool {
+	return false
+}
+
+// AccountKeyContract does not have any public key, hence it always returns false.
+func (a *AccountKeyContract) ValidateMember(recoveredKey *ecdsa.PublicKey, from common.Address) bool {
+	return false
+}
+
+func (a *AccountKeyContract) DeepCopy() AccountKey {
+	return &AccountKeyContract{Validator: a.Validator}
+}
+
+func (a *AccountKeyContract) Equal(b AccountKey) bool {
+	tb, ok := b.(*AccountKeyContract)
+	if !ok {
+		return false
+	}
+	return a.Validator == tb.Validator
+}
+
+// Validate always returns false since the validation requires the execution of the validator contract.
+// Use ContractKeyValidator to validate a signature with AccountKeyContract.
+func (a *AccountKeyContract) Validate(currentBlockNumber uint64, r RoleType, recoveredKeys []*ecdsa.PublicKey, from common.Address) bool {
+	return false
+}
+
+func (a *AccountKeyContract) String() string {
+	return fmt.Sprintf("AccountKeyContract: %s", a.Validator.String())
+}
+
+func (a *AccountKeyContract) AccountCreationGas(currentBlockNumber uint64) (uint64, error) {
+	return params.TxAccountCreationGasPerKey, nil
+}
+
+func (a *AccountKeyContract) SigValidationGas(currentBlockNumber uint64, r RoleType, numSigs int) (uint64, error) {
+	return params.TxValidationGasContractKey, nil
+}
+
+func (a *AccountKeyContract) CheckInstallable(currentBlockNumber uint64) error {
+	if !fork.Rules(new(big.Int).SetUint64(currentBlockNumber)).IsPrague {
+		return kerrors.ErrAccountKeyContractNotSupported
+	}
+	return nil
+}
+
+func (a *AccountKeyContract) CheckUpdatable(newKey AccountKey, currentBlockNumber uint64) error {
+	if newKey, ok := newKey.(*AccountKeyContract); ok {
+		return newKey.CheckInstallable(currentBlockNumber)
+	}
+	// Update is not possible if the type is different.
+	return kerrors.ErrDifferentAccountKeyType
+}
+
+func (a *AccountKeyContract) Update(newKey AccountKey, currentBlockNumber uint64) error {
+	if err := a.CheckUpdatable(newKey, currentBlockNumber); err != nil {
+		return err
+	}
+	newContractKey, _ := newKey.(*AccountKeyContract)
+	a.Validator = newContractKey.Validator
+	return nil
+}
@@ -0,0 +1,243 @@
+// Copyright 2024 The klaytn Authors
+// This file is part of the klaytn library.
+//
+// The klaytn library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The klaytn library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the klaytn library. If not, see <http://www.gnu.org/licenses/>.
+
+package accountkey
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+
+	"github.com/klaytn/klaytn/common"
+	"github.com/klaytn/klaytn/common/hexutil"
+	"github.com/klaytn/klaytn/crypto/secp256r1"
+	"github.com/klaytn/klaytn/fork"
+	"github.com/klaytn/klaytn/kerrors"
+	"github.com/klaytn/klaytn/params"
+	"github.com/klaytn/klaytn/rlp"
+)
+
+var (
+	errNotP256Curve      = errors.New("key is not on the P256 curve")
+	errInvalidP256Pubkey = errors.New("invalid compressed P256 public key")
+	p256HalfN            = new(big.Int).Rsh(elliptic.P256().Params().N, 1)
+)
+
+// P256PublicKeySerializable provides RLP/JSON serialization of a public key on the secp256r1 (P-256) curve.
+// It is used for AccountKeyP256 as an internal structure.
+type P256PublicKeySerializable ecdsa.PublicKey
+
+// newP256PublicKeySerializable creates a P256PublicKeySerializable object.
+// The object is initialized with default values.
+// Curve = P256 curve
+// X = 0
+// Y = 0
+func newP256PublicKeySerializable() *P256PublicKeySerializable {
+	return &P256PublicKeySerializable{
+		Curve: elliptic.P256(),
+		X:     new(big.Int),
+		Y:     new(big.Int),
+	}
+}
+
+// EncodeRLP encodes the public key in the compressed form using RLP.
+func (p *P256PublicKeySerializable) EncodeRLP(w io.Writer) error {
+	// Do not serialize if it is not on P256 curve.
+	if !elliptic.P256().IsOnCurve(p.X, p.Y) {
+		return errNotP256Curve
+	}
+	return rlp.Encode(w, elliptic.MarshalCompressed(elliptic.P256(), p.X, p.Y))
+}
+
+// DecodeRLP decodes the compressed public key using RLP.
+func (p *P256PublicKeySerializable) DecodeRLP(s *rlp.Stream) error {
+	b := []byte{}
+	if err := s.Decode(&b); err != nil {
+		return err
+	}
+	x, y := elliptic.UnmarshalCompressed(elliptic.P256(), b)
+	if x == nil {
+		return errInvalidP256Pubkey
+	}
+	*p = P256PublicKeySerializable{Curve: elliptic.P256(), X: x, Y: y}
+
+	return nil
+}
+
+// MarshalJSON encodes P256PublicKeySerializable using JSON.
+// It serializes only X and Y.
+func (p *P256PublicKeySerializable) MarshalJSON() ([]byte, error) {
+	// Do not serialize if it is not on P256 curve.
+	if !elliptic.P256().IsOnCurve(p.X, p.Y) {
+		return nil, errNotP256Curve
+	}
+	return json.Marshal(&publicKeySerializableInternalJSON{
+		(*hexutil.Big)(p.X), (*hexutil.Big)(p.Y),
+	})
+}
+
+// UnmarshalJSON decodes P256PublicKeySerializable using JSON.
+// It deserializes only X and Y. Refer to MarshalJSON() above.
+func (p *P256PublicKeySerializable) UnmarshalJSON(b []byte) error {
+	var dec publicKeySerializableInternalJSON
+	if err := json.Unmarshal(b, &dec); err != nil {
+		return err
+	}
+	if dec.X == nil || dec.Y == nil {
+		return errNoXYValue
+	}
+	p.X = (*big.Int)(dec.X)
+	p.Y = (*big.Int)(dec.Y)
+
+	return nil
+}
+
+// DeepCopy creates a new P256PublicKeySerializable object and newly allocates memory for all its attributes.
+func (p *P256PublicKeySerializable) DeepCopy() *P256PublicKeySerializable {
+	pk := newP256PublicKeySerializable()
+	pk.X = new(big.Int).Set(p.X)
+	pk.Y = new(big.Int).Set(p.Y)
+
+	return pk
+}
+
+// Equal returns true if all attributes between p and pk are the same.
+func (p *P256PublicKeySerializable) Equal(pk *P256PublicKeySerializable) bool {
+	return p.X.Cmp(pk.X) == 0 &&
+		p.Y.Cmp(pk.Y) == 0
+}
+
+// String returns a string containing information of all attributes.
+func (p *P256PublicKeySerializable) String() string {
+	b, _ := json.Marshal(p)
+
+	return fmt.Sprintf("P256Pubkey:%s", string(b))
+}
+
+// AccountKeyP256 is used for accounts having one public key on the secp256r1 (P-256) curve,
+// such as hardware passkeys.
+// Since a public key cannot be recovered from a P-256 signature in the same way as secp256k1,
+// verifying the signature of a transaction is performed as following:
+// 1. The tx has exactly one signature whose V is chainId * 2 + 35 or chainId * 2 + 36.
+// 2. The signature (R, S) of the tx signature hash is verified with the account's public key.
+//
+// To prevent signature malleability, S should be in the lower half of the curve order.
+type AccountKeyP256 struct {
+	*P256PublicKeySerializable
+}
+
+func NewAccountKeyP256WithValue(pk *ecdsa.PublicKey) *AccountKeyP256 {
+	return &AccountKeyP256{(*P256PublicKeySerializable)(pk)}
+}
+
+func NewAccountKeyP256() *AccountKeyP256 {
+	return &AccountKeyP256{newP256PublicKeySerializable()}
+}
+
+// PublicKey returns the P-256 public key of the account.
+func (a *AccountKeyP256) PublicKey() *ecdsa.PublicKey {
+	return &ecdsa.PublicKey{Curve: elliptic.P256(), X: a.X, Y: a.Y}
+}
+
+// VerifySignature returns true if (r, s) is a valid signature of hash signed by the account's key.
+func (a *AccountKeyP256) VerifySignature(hash []byte, r, s *big.Int) bool {
+	if s.Cmp(p256HalfN) > 0 {
+		return false
+	}
+	return secp256r1.Verify(hash, r, s, a.X, a.Y)
+}
+
+func (a *AccountKeyP256) Type() AccountKeyType {
+	return AccountKeyTypeP256
+}
+
+func (a *AccountKeyP256) IsCompositeType() bool {
+	return false
+}
+
+func (a *AccountKeyP256) ValidateMember(verifiedKey *ecdsa.PublicKey, from common.Address) bool {
+	return verifiedKey.Curve == elliptic.P256() &&
+		a.P256PublicKeySerializable.Equal((*P256PublicKeySerializable)(verifiedKey))
+}
+
+func (a *AccountKeyP256) DeepCopy() AccountKey {
+	return &AccountKeyP256{
+		a.P256PublicKeySerializable.DeepCopy(),
+	}
+}
+
+func (a *AccountKeyP256) Equal(b AccountKey) bool {
+	tb, ok := b.(*AccountKeyP256)
+	if !ok {
+		return false
+	}
+	return a.P256PublicKeySerializable.Equal(tb.P256PublicKeySerializable)
+}
+
+// Validate returns true if verifiedKeys has only the account's public key.
+// The keys should be the ones whose signatures are verified by VerifySignature.
+func (a *AccountKeyP256) Validate(currentBlockNumber uint64, r RoleType, verifiedKeys []*ecdsa.PublicKey, from common.Address) bool {
+	// AccountKeyP256 has only one public key.
+	if len(verifiedKeys) != 1 {
+		return false
+	}
+	return a.ValidateMember(verifiedKeys[0], from)
+}
+
+func (a *AccountKeyP256) String() string {
+	return fmt.Sprintf("AccountKeyP256: %s", a.P256PublicKeySerializable.String())
+}
+
+func (a *AccountKeyP256) AccountCreationGas(currentBlockNumber uint64) (uint64, error) {
+	return params.TxAccountCreationGasPerKey, nil
+}
+
+func (a *AccountKeyP256) SigValidationGas(currentBlockNumber uint64, r RoleType, numSigs int) (uint64, error) {
+	return params.TxValidationGasP256Key, nil
+}
+
+func (a *AccountKeyP256) CheckInstallable(currentBlockNumber uint64) error {
+	if !fork.Rules(new(big.Int).SetUint64(currentBlockNumber)).IsPrague {
+		return kerrors.ErrAccountKeyP256NotSupported
+	}
+	// If the point is not on the curve, return an error.
+	if !elliptic.P256().IsOnCurve(a.X, a.Y) {
+		return kerrors.ErrNotOnCurve
+	}
+	return nil
+}
+
+func (a *AccountKeyP256) CheckUpdatable(newKey AccountKey, currentBlockNumber uint64) error {
+	if newKey, ok := newKey.(*AccountKeyP256); ok {
+		return newKey.CheckInstallable(currentBlockNumber)
+	}
+	// Update is not possible if the type is different.
+	return kerrors.ErrDifferentAccountKeyType
+}
+
+func (a *AccountKeyP256) Update(newKey AccountKey, currentBlockNumber uint64) error {
+	if err := a.CheckUpdatable(newKey, currentBlockNumber); err != nil {
+		return err
+	}
+	newPubKey, _ := newKey.(*AccountKeyP256)
+	a.X = newPubKey.X
+	a.Y = newPubKey.Y
+	return nil
+}
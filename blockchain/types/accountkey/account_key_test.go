@@ -18,6 +18,8 @@ package accountkey
 
 import (
 	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
 	"encoding/json"
 	"math/big"
 	"testing"
@@ -25,6 +27,7 @@ import (
 	"github.com/klaytn/klaytn/common"
 	"github.com/klaytn/klaytn/crypto"
 	"github.com/klaytn/klaytn/fork"
+	"github.com/klaytn/klaytn/kerrors"
 	"github.com/klaytn/klaytn/params"
 	"github.com/klaytn/klaytn/rlp"
 	"github.com/stretchr/testify/assert"
@@ -39,6 +42,8 @@ func TestValidateMember(t *testing.T) {
 		"Fail":             genAccountKeyFail(),
 		"WeightedMultisig": genAccountKeyWeightedMultisig(),
 		"RoleBased":        genAccountKeyRoleBased(),
+		"Contract":         genAccountKeyContract(),
+		"P256":             genAccountKeyP256(),
 	}
 
 	// keys of the "RoleBased"
@@ -63,6 +68,9 @@ func TestValidateMember(t *testing.T) {
 		{"RoleBased", tk.PublicKey, common.Address{}, true}, // even test IsContainedPubkey of the multisig
 		{"RoleBased", ak.PublicKey, common.Address{}, true},
 		{"RoleBased", fk.PublicKey, common.Address{}, true},
+		{"Contract", testPubkey.PublicKey, crypto.PubkeyToAddress(testPubkey.PublicKey), false},
+		{"P256", testPubkey.PublicKey, common.Address{}, false},
+		{"P256", *genAccountKeyP256().(*AccountKeyP256).PublicKey(), common.Address{}, true},
 	}
 
 	for i, testcase := range testcases {
@@ -81,6 +89,8 @@ func TestAccountKeySerialization(t *testing.T) {
 		{"Fail", genAccountKeyFail()},
 		{"WeightedMultisig", genAccountKeyWeightedMultisig()},
 		{"RoleBased", genAccountKeyRoleBased()},
+		{"Contract", genAccountKeyContract()},
+		{"P256", genAccountKeyP256()},
 	}
 
 	testcases := []struct {
@@ -209,6 +219,108 @@ func genAccountKeyRoleBased() AccountKey {
 	return NewAccountKeyRoleBasedWithValues(AccountKeyRoleBased{txKey, updateKey, feeKey})
 }
 
+func genAccountKeyContract() AccountKey {
+	return NewAccountKeyContractWithValue(common.HexToAddress("0x000000000000000000000000000000000000c0de"))
+}
+
+func TestAccountKeyContract_CheckInstallable(t *testing.T) {
+	// declare special block numbers and set hardForkBlockNumberConfig
+	var (
+		blockBeforeHF = uint64(4)
+		blockHF       = big.NewInt(5)
+		blockAfterHF  = uint64(6)
+	)
+	fork.SetHardForkBlockNumberConfig(&params.ChainConfig{PragueCompatibleBlock: blockHF})
+	defer fork.ClearHardForkBlockNumberConfig()
+
+	k := genAccountKeyContract()
+	assert.Equal(t, kerrors.ErrAccountKeyContractNotSupported, k.CheckInstallable(blockBeforeHF))
+	assert.NoError(t, k.CheckInstallable(blockAfterHF))
+
+	// AccountKeyContract can be a role key of AccountKeyRoleBased.
+	roleBased := NewAccountKeyRoleBasedWithValues(AccountKeyRoleBased{genAccountKeyPublic(), genAccountKeyPublic(), k})
+	assert.Equal(t, kerrors.ErrAccountKeyContractNotSupported, roleBased.CheckInstallable(blockBeforeHF))
+	assert.NoError(t, roleBased.CheckInstallable(blockAfterHF))
+
+	// The key of the role is selected by KeyForRole.
+	assert.Equal(t, k, KeyForRole(roleBased, RoleFeePayer))
+	assert.Equal(t, (*roleBased)[RoleTransaction], KeyForRole(roleBased, RoleTransaction))
+	assert.Equal(t, k, KeyForRole(k, RoleTransaction))
+
+	// The validation of AccountKeyContract cannot be done with recovered public keys.
+	assert.False(t, k.Validate(blockAfterHF, RoleTransaction, getAnonymousPubKeys(1), common.Address{}))
+
+	// The validator is the account itself if the validator is not specified.
+	from := common.HexToAddress("0x0000000000000000000000000000000000001234")
+	assert.Equal(t, from, NewAccountKeyContract().ValidatorOf(from))
+	assert.Equal(t, k.(*AccountKeyContract).Validator, k.(*AccountKeyContract).ValidatorOf(from))
+
+	gas, err := k.SigValidationGas(blockAfterHF, RoleTransaction, 1)
+	assert.NoError(t, err)
+	assert.Equal(t, params.TxValidationGasContractKey, gas)
+}
+
+// genAccountKeyP256 returns an AccountKeyP256 with a fixed P-256 public key.
+func genAccountKeyP256() AccountKey {
+	x, _ := new(big.Int).SetString("60fed4ba255a9d31c961eb74c6356d68c049b8923b61fa6ce669622e60f29fb6", 16)
+	y, _ := new(big.Int).SetString("7903fe1008b8bc99a41ae9e95628bc64f2f1b20c2d7e9f5177a3c294d4462299", 16)
+	return NewAccountKeyP256WithValue(&ecdsa.PublicKey{Curve: elliptic.P256(), X: x, Y: y})
+}
+
+func TestAccountKeyP256_CheckInstallable(t *testing.T) {
+	// declare special block numbers and set hardForkBlockNumberConfig
+	var (
+		blockBeforeHF = uint64(4)
+		blockHF       = big.NewInt(5)
+		blockAfterHF  = uint64(6)
+	)
+	fork.SetHardForkBlockNumberConfig(&params.ChainConfig{PragueCompatibleBlock: blockHF})
+	defer fork.ClearHardForkBlockNumberConfig()
+
+	k := genAccountKeyP256()
+	assert.Equal(t, kerrors.ErrAccountKeyP256NotSupported, k.CheckInstallable(blockBeforeHF))
+	assert.NoError(t, k.CheckInstallable(blockAfterHF))
+
+	// AccountKeyP256 can be a role key of AccountKeyRoleBased.
+	roleBased := NewAccountKeyRoleBasedWithValues(AccountKeyRoleBased{k, genAccountKeyPublic()})
+	assert.Equal(t, kerrors.ErrAccountKeyP256NotSupported, roleBased.CheckInstallable(blockBeforeHF))
+	assert.NoError(t, roleBased.CheckInstallable(blockAfterHF))
+
+	// A point which is not on the P-256 curve cannot be installed.
+	notOnCurve := k.DeepCopy().(*AccountKeyP256)
+	notOnCurve.Y.Add(notOnCurve.Y, common.Big1)
+	assert.Equal(t, kerrors.ErrNotOnCurve, notOnCurve.CheckInstallable(blockAfterHF))
+
+	gas, err := k.SigValidationGas(blockAfterHF, RoleTransaction, 1)
+	assert.NoError(t, err)
+	assert.Equal(t, params.TxValidationGasP256Key, gas)
+}
+
+func TestAccountKeyP256_VerifySignature(t *testing.T) {
+	prv, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	assert.NoError(t, err)
+	k := NewAccountKeyP256WithValue(&prv.PublicKey)
+
+	hash := crypto.Keccak256([]byte("hello"))
+	r, s, err := ecdsa.Sign(rand.Reader, prv, hash)
+	assert.NoError(t, err)
+
+	// Normalize S into the lower half of the curve order.
+	n := elliptic.P256().Params().N
+	if s.Cmp(new(big.Int).Rsh(n, 1)) > 0 {
+		s.Sub(n, s)
+	}
+	assert.True(t, k.VerifySignature(hash, r, s))
+	assert.True(t, k.Validate(0, RoleTransaction, []*ecdsa.PublicKey{k.PublicKey()}, common.Address{}))
+
+	// The malleable signature with the high S is rejected.
+	assert.False(t, k.VerifySignature(hash, r, new(big.Int).Sub(n, s)))
+	// The signature of another hash is rejected.
+	assert.False(t, k.VerifySignature(crypto.Keccak256([]byte("world")), r, s))
+	// The signature of another key is rejected.
+	assert.False(t, genAccountKeyP256().(*AccountKeyP256).VerifySignature(hash, r, s))
+}
+
 func TestAccountKeyWeightedMultiSig_Validate(t *testing.T) {
 	// declare special block numbers and set hardForkBlockNumberConfig
 	var (
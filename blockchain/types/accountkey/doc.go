@@ -27,6 +27,8 @@ The AccountKey types used in Klaytn are as follows:
   - AccountKeyTypeFail
   - AccountKeyTypeWeightedMultiSig
   - AccountKeyTypeRoleBased
+  - AccountKeyTypeContract
+  - AccountKeyTypeP256
 
 Each AccountKey type implements the AccountKey interface.
 
@@ -34,9 +36,11 @@ Source Files
 
 AccountKey related functions and variables are defined in the files listed below.
   - account_key.go                    : Defines the AccountKey types, the AccountKey interface and the functions related to AccountKey.
+  - account_key_contract.go           : An AccountKey for AccountKeyContract type is defined. If an account has the contract key, the account's transaction validation process is delegated to `isValidSignature` of the validator contract.
   - account_key_fail.go               : An AccountKey for AccountKeyFail type is defined. If an account has the fail key, the account's transaction validation process always fails.
   - account_key_legacy.go             : An AccountKey for AccountKeyLegacy type is defined. If an account has the legacy key, the account's key pair should be coupled with its address.
   - account_key_nil.go                : An AccountKey for AccountKeyNil type is defined. The nil key is used only for TxTypeAccountUpdate transactions representing an empty key.
+  - account_key_p256.go               : An AccountKey for AccountKeyP256 type is defined. If an account contains a secp256r1 (P-256) public key such as a hardware passkey, the signature of the account's transaction is verified with the public key.
   - account_key_public.go             : An AccountKey for AccountKeyPublic type is defined. If an account contains a public key as an account key, the public key will be used in the account's transaction validation process.
   - account_key_role_based.go         : An AccountKey for AccountKeyRoleBased type is defined. AccountKeyRoleBased contains keys that have three roles: RoleTransaction, RoleAccountUpdate, and RoleFeePayer. If an account has a role-based key that consists of more than one key, the account's transaction validation process will use one key in the role-based key depends on the transaction type.
   - account_key_serializer.go         : AccountKeySerializer is defined for serialization of AccountKey.
@@ -31,6 +31,9 @@ const (
 	AnchoringJSONDataType uint8 = 128
 )
 
+// AnchoringDataMerkleKind is the kind of the JSON anchoring data which anchors a Merkle root.
+const AnchoringDataMerkleKind = "merkle"
+
 var errUnknownAnchoringTxType = errors.New("unknown anchoring tx type")
 
 type AnchoringDataInternal interface {
@@ -80,6 +83,26 @@ func (data *AnchoringDataInternalType0) GetBlockNumber() *big.Int {
 	return data.BlockNumber
 }
 
+// AnchoringDataMerkle is a JSON anchoring data which anchors the Merkle root over the hashes of
+// the consecutive blocks from FromBlockNumber to BlockNumber. BlockHash is the hash of the last block.
+type AnchoringDataMerkle struct {
+	Kind            string      `json:"kind"`
+	MerkleRoot      common.Hash `json:"merkleRoot"`
+	FromBlockNumber *big.Int    `json:"fromBlockNumber"`
+	BlockHash       common.Hash `json:"blockHash"`
+	BlockNumber     *big.Int    `json:"blockNumber"`
+	BlockCount      *big.Int    `json:"blockCount"`
+	TxCount         *big.Int    `json:"txCount"`
+}
+
+func (data *AnchoringDataMerkle) GetBlockHash() common.Hash {
+	return data.BlockHash
+}
+
+func (data *AnchoringDataMerkle) GetBlockNumber() *big.Int {
+	return data.BlockNumber
+}
+
 func NewAnchoringDataType0(block *Block, blockCount uint64, txCount uint64) (*AnchoringData, error) {
 	data := &AnchoringDataInternalType0{
 		block.Hash(),
@@ -98,6 +121,24 @@ func NewAnchoringDataType0(block *Block, blockCount uint64, txCount uint64) (*An
 	return &AnchoringData{AnchoringDataType0, encodedCCTxData}, nil
 }
 
+// NewAnchoringDataMerkle makes an anchoring data of the Merkle root over the given hashes
+// of the consecutive blocks starting from fromBlockNumber.
+func NewAnchoringDataMerkle(fromBlockNumber uint64, blockHashes []common.Hash, txCount uint64) (*AnchoringData, error) {
+	if len(blockHashes) == 0 {
+		return nil, errEmptyMerkleTree
+	}
+	count := uint64(len(blockHashes))
+	return NewAnchoringJSONDataType(&AnchoringDataMerkle{
+		Kind:            AnchoringDataMerkleKind,
+		MerkleRoot:      NewMerkleTree(blockHashes).Root(),
+		FromBlockNumber: new(big.Int).SetUint64(fromBlockNumber),
+		BlockHash:       blockHashes[count-1],
+		BlockNumber:     new(big.Int).SetUint64(fromBlockNumber + count - 1),
+		BlockCount:      new(big.Int).SetUint64(count),
+		TxCount:         new(big.Int).SetUint64(txCount),
+	})
+}
+
 func NewAnchoringJSONDataType(v interface{}) (*AnchoringData, error) {
 	encoded, err := json.Marshal(v)
 	if err != nil {
@@ -125,6 +166,17 @@ func DecodeAnchoringData(data []byte) (AnchoringDataInternal, error) {
 		logger.Trace("decoded type0 anchoring tx", "blockNum", anchoringDataInternal.BlockNumber.String(), "blockHash", anchoringDataInternal.BlockHash.String(), "txHash", anchoringDataInternal.TxHash.String(), "txCount", anchoringDataInternal.TxCount)
 		return anchoringDataInternal, nil
 	}
+	if anchoringData.Type == AnchoringJSONDataType {
+		anchoringDataMerkle := new(AnchoringDataMerkle)
+		if err := json.Unmarshal(anchoringData.Data, anchoringDataMerkle); err != nil {
+			return nil, err
+		}
+		if anchoringDataMerkle.Kind != AnchoringDataMerkleKind || anchoringDataMerkle.BlockNumber == nil {
+			return nil, fmt.Errorf("%w type=%v kind=%v", errUnknownAnchoringTxType, anchoringData.Type, anchoringDataMerkle.Kind)
+		}
+		logger.Trace("decoded merkle anchoring tx", "blockNum", anchoringDataMerkle.BlockNumber.String(), "blockHash", anchoringDataMerkle.BlockHash.String(), "merkleRoot", anchoringDataMerkle.MerkleRoot.String(), "blockCount", anchoringDataMerkle.BlockCount)
+		return anchoringDataMerkle, nil
+	}
 	return nil, errUnknownAnchoringTxType
 }
 
@@ -0,0 +1,118 @@
+// Copyright 2024 The klaytn Authors
+// This file is part of the klaytn library.
+//
+// The klaytn library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The klaytn library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the klaytn library. If not, see <http://www.gnu.org/licenses/>.
+
+package types
+
+import (
+	"errors"
+
+	"github.com/klaytn/klaytn/common"
+	"github.com/klaytn/klaytn/crypto"
+)
+
+var errEmptyMerkleTree = errors.New("merkle tree has no leaf")
+
+// MerkleTree is a binary Merkle tree over block hashes used for the Merkle anchoring.
+// A parent node is keccak256(left || right) and the last node of a level with an odd
+// number of nodes is paired with itself.
+type MerkleTree struct {
+	levels [][]common.Hash // levels[0] is the leaves and the last level is the root
+}
+
+// NewMerkleTree builds a Merkle tree over the given leaves.
+func NewMerkleTree(leaves []common.Hash) *MerkleTree {
+	level := make([]common.Hash, len(leaves))
+	copy(level, leaves)
+	tree := &MerkleTree{levels: [][]common.Hash{level}}
+	for len(level) > 1 {
+		next := make([]common.Hash, (len(level)+1)/2)
+		for i := range next {
+			left, right := level[2*i], level[2*i]
+			if 2*i+1 < len(level) {
+				right = level[2*i+1]
+			}
+			next[i] = hashMerkleNode(left, right)
+		}
+		tree.levels = append(tree.levels, next)
+		level = next
+	}
+	return tree
+}
+
+// Root returns the root of the tree. The root of an empty tree is the empty hash.
+func (t *MerkleTree) Root() common.Hash {
+	top := t.levels[len(t.levels)-1]
+	if len(top) == 0 {
+		return common.Hash{}
+	}
+	return top[0]
+}
+
+// Proof returns the sibling nodes from the leaf at the given index to the root.
+func (t *MerkleTree) Proof(index int) []common.Hash {
+	if index < 0 || index >= len(t.levels[0]) {
+		return nil
+	}
+	proof := make([]common.Hash, 0, len(t.levels)-1)
+	for _, level := range t.levels[:len(t.levels)-1] {
+		sibling := index ^ 1
+		if sibling >= len(level) {
+			sibling = index
+		}
+		proof = append(proof, level[sibling])
+		index /= 2
+	}
+	return proof
+}
+
+// VerifyMerkleProof returns true if the leaf at the given index is included in the tree of the root.
+func VerifyMerkleProof(leaf common.Hash, index uint64, proof []common.Hash, root common.Hash) bool {
+	node := leaf
+	for _, sibling := range proof {
+		if index%2 == 0 {
+			node = hashMerkleNode(node, sibling)
+		} else {
+			node = hashMerkleNode(sibling, node)
+		}
+		index /= 2
+	}
+	return index == 0 && node == root
+}
+
+func hashMerkleNode(left, right common.Hash) common.Hash {
+	return crypto.Keccak256Hash(left.Bytes(), right.Bytes())
+}
+
+// AnchoringProof is the inclusion proof of a child chain block in the Merkle root
+// anchored to the parent chain by the transaction of AnchoringTxHash.
+type AnchoringProof struct {
+	BlockNumber     uint64        `json:"blockNumber"`
+	BlockHash       common.Hash   `json:"blockHash"`
+	Siblings        []common.Hash `json:"siblings"`
+	MerkleRoot      common.Hash   `json:"merkleRoot"`
+	FromBlockNumber uint64        `json:"fromBlockNumber"`
+	ToBlockNumber   uint64        `json:"toBlockNumber"`
+	AnchoringTxHash common.Hash   `json:"anchoringTxHash"`
+}
+
+// Verify returns true if the block is included in the anchored Merkle root.
+// The index of the block in the tree is its offset from FromBlockNumber.
+func (p *AnchoringProof) Verify() bool {
+	if p.BlockNumber < p.FromBlockNumber || p.BlockNumber > p.ToBlockNumber {
+		return false
+	}
+	return VerifyMerkleProof(p.BlockHash, p.BlockNumber-p.FromBlockNumber, p.Siblings, p.MerkleRoot)
+}
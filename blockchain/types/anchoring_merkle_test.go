@@ -0,0 +1,125 @@
+// Copyright 2024 The klaytn Authors
+// This file is part of the klaytn library.
+//
+// The klaytn library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The klaytn library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the klaytn library. If not, see <http://www.gnu.org/licenses/>.
+
+package types
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/klaytn/klaytn/common"
+	"github.com/klaytn/klaytn/crypto"
+	"github.com/klaytn/klaytn/rlp"
+	"github.com/stretchr/testify/assert"
+)
+
+func genMerkleLeaves(n int) []common.Hash {
+	leaves := make([]common.Hash, n)
+	for i := range leaves {
+		leaves[i] = crypto.Keccak256Hash(big.NewInt(int64(i)).Bytes())
+	}
+	return leaves
+}
+
+func TestMerkleTree(t *testing.T) {
+	assert.Equal(t, common.Hash{}, NewMerkleTree(nil).Root())
+
+	leaves := genMerkleLeaves(1)
+	assert.Equal(t, leaves[0], NewMerkleTree(leaves).Root())
+	assert.Empty(t, NewMerkleTree(leaves).Proof(0))
+
+	leaves = genMerkleLeaves(3)
+	h01 := crypto.Keccak256Hash(leaves[0].Bytes(), leaves[1].Bytes())
+	h22 := crypto.Keccak256Hash(leaves[2].Bytes(), leaves[2].Bytes())
+	assert.Equal(t, crypto.Keccak256Hash(h01.Bytes(), h22.Bytes()), NewMerkleTree(leaves).Root())
+
+	for n := 1; n <= 17; n++ {
+		leaves := genMerkleLeaves(n)
+		tree := NewMerkleTree(leaves)
+		for i, leaf := range leaves {
+			proof := tree.Proof(i)
+			assert.True(t, VerifyMerkleProof(leaf, uint64(i), proof, tree.Root()), "n=%d i=%d", n, i)
+			assert.False(t, VerifyMerkleProof(common.Hash{}, uint64(i), proof, tree.Root()), "n=%d i=%d", n, i)
+			if i^1 < n {
+				assert.False(t, VerifyMerkleProof(leaf, uint64(i^1), proof, tree.Root()), "n=%d i=%d", n, i)
+			}
+		}
+		assert.Nil(t, tree.Proof(n))
+	}
+}
+
+func TestAnchoringProof_Verify(t *testing.T) {
+	leaves := genMerkleLeaves(3)
+	tree := NewMerkleTree(leaves)
+	proof := &AnchoringProof{
+		BlockNumber:     12,
+		BlockHash:       leaves[2],
+		Siblings:        tree.Proof(2),
+		MerkleRoot:      tree.Root(),
+		FromBlockNumber: 10,
+		ToBlockNumber:   12,
+	}
+	assert.True(t, proof.Verify())
+
+	// The proof is stored as RLP.
+	data, err := rlp.EncodeToBytes(proof)
+	assert.NoError(t, err)
+	decoded := new(AnchoringProof)
+	assert.NoError(t, rlp.DecodeBytes(data, decoded))
+	assert.Equal(t, proof, decoded)
+
+	// The last leaf paired with itself must not be proven beyond the anchored blocks.
+	proof.BlockNumber = 13
+	proof.Siblings = []common.Hash{leaves[2], crypto.Keccak256Hash(leaves[0].Bytes(), leaves[1].Bytes())}
+	assert.True(t, VerifyMerkleProof(leaves[2], 3, proof.Siblings, tree.Root()))
+	assert.False(t, proof.Verify())
+}
+
+func TestDecodingAnchoringTxMerkle(t *testing.T) {
+	leaves := genMerkleLeaves(4)
+	anchoringData, err := NewAnchoringDataMerkle(10, leaves, 7)
+	assert.NoError(t, err)
+	assert.Equal(t, AnchoringJSONDataType, anchoringData.Type)
+
+	data, err := rlp.EncodeToBytes(anchoringData)
+	assert.NoError(t, err)
+
+	decodedData, err := DecodeAnchoringData(data)
+	assert.NoError(t, err)
+	merkle, ok := decodedData.(*AnchoringDataMerkle)
+	assert.True(t, ok)
+	assert.Equal(t, NewMerkleTree(leaves).Root(), merkle.MerkleRoot)
+	assert.Equal(t, leaves[3], merkle.GetBlockHash())
+	assert.Equal(t, big.NewInt(13), merkle.GetBlockNumber())
+	assert.Equal(t, big.NewInt(10), merkle.FromBlockNumber)
+	assert.Equal(t, big.NewInt(4), merkle.BlockCount)
+	assert.Equal(t, big.NewInt(7), merkle.TxCount)
+
+	decodedDataJSON, err := DecodeAnchoringDataToJSON(data)
+	assert.NoError(t, err)
+	assert.Equal(t, AnchoringDataMerkleKind, decodedDataJSON.(map[string]interface{})["kind"])
+
+	_, err = NewAnchoringDataMerkle(10, nil, 0)
+	assert.Error(t, err)
+
+	// A JSON anchoring data of other kinds cannot be decoded as an anchored block.
+	anchoringData, err = NewAnchoringJSONDataType(map[string]string{"kind": "unknown"})
+	assert.NoError(t, err)
+	data, err = rlp.EncodeToBytes(anchoringData)
+	assert.NoError(t, err)
+	_, err = DecodeAnchoringData(data)
+	assert.ErrorIs(t, err, errUnknownAnchoringTxType)
+}
@@ -38,6 +38,7 @@ import (
 	"github.com/klaytn/klaytn/common/math"
 	"github.com/klaytn/klaytn/crypto"
 	"github.com/klaytn/klaytn/kerrors"
+	"github.com/klaytn/klaytn/params"
 	"github.com/klaytn/klaytn/rlp"
 )
 
@@ -53,6 +54,7 @@ var (
 	errNotFeeDelegationTransaction    = errors.New("not a fee delegation type transaction")
 	errInvalidValueMap                = errors.New("tx fields should be filled with valid values")
 	errNotImplementTxInternalEthTyped = errors.New("not implement TxInternalDataEthTyped")
+	errNoContractKeyValidator         = errors.New("no validator for AccountKeyContract")
 )
 
 // deriveSigner makes a *best* guess about which signer to use.
@@ -246,6 +248,20 @@ func (tx *Transaction) UnmarshalBinary(b []byte) error {
 	return nil
 }
 
+// DecodeTxWithoutSigValidation decodes the RLP-encoded transaction without validating
+// its signatures. It is used to decode a transaction which has not been signed yet,
+// e.g., a signing request forwarded to an external signer.
+func DecodeTxWithoutSigValidation(b []byte) (*Transaction, error) {
+	serializer := newTxInternalDataSerializer()
+	if err := rlp.DecodeBytes(b, serializer); err != nil {
+		return nil, err
+	}
+
+	tx := new(Transaction)
+	tx.setDecoded(serializer.tx, len(b))
+	return tx, nil
+}
+
 // MarshalJSON encodes the web3 RPC transaction format.
 func (tx *Transaction) MarshalJSON() ([]byte, error) {
 	hash := tx.Hash()
@@ -396,9 +412,9 @@ func (tx *Transaction) ValidateMutableValue(db StateDB, signer Signer, currentBl
 			return ErrNotLegacyAccount
 		}
 	} else {
-		if pubkey, err := SenderPubkey(signer, tx); err != nil {
+		if pubkey, err := tx.senderPubkeyForKey(signer, accKey, tx.GetRoleTypeForValidation()); err != nil {
 			return ErrInvalidSigSender
-		} else if accountkey.ValidateAccountKey(currentBlockNumber, tx.ValidatedSender(), accKey, pubkey, tx.GetRoleTypeForValidation()) != nil {
+		} else if tx.validateAccountKey(signer, db, currentBlockNumber, tx.ValidatedSender(), accKey, pubkey, tx.GetRoleTypeForValidation()) != nil {
 			return ErrInvalidAccountKey
 		}
 	}
@@ -406,9 +422,9 @@ func (tx *Transaction) ValidateMutableValue(db StateDB, signer Signer, currentBl
 	// validate the fee payer's account key
 	if tx.IsFeeDelegatedTransaction() {
 		feePayerAccKey := db.GetKey(tx.ValidatedFeePayer())
-		if feePayerPubkey, err := SenderFeePayerPubkey(signer, tx); err != nil {
+		if feePayerPubkey, err := tx.senderPubkeyForKey(signer, feePayerAccKey, accountkey.RoleFeePayer); err != nil {
 			return ErrInvalidSigFeePayer
-		} else if accountkey.ValidateAccountKey(currentBlockNumber, tx.ValidatedFeePayer(), feePayerAccKey, feePayerPubkey, accountkey.RoleFeePayer) != nil {
+		} else if tx.validateAccountKey(signer, db, currentBlockNumber, tx.ValidatedFeePayer(), feePayerAccKey, feePayerPubkey, accountkey.RoleFeePayer) != nil {
 			return ErrInvalidAccountKey
 		}
 	}
@@ -770,10 +786,6 @@ func (tx *Transaction) ValidateSender(signer Signer, p AccountKeyPicker, current
 		return 0, err
 	}
 
-	pubkey, err := SenderPubkey(signer, tx)
-	if err != nil {
-		return 0, err
-	}
 	txfrom, ok := tx.data.(TxInternalDataFrom)
 	if !ok {
 		return 0, errNotTxInternalDataFrom
@@ -781,12 +793,17 @@ func (tx *Transaction) ValidateSender(signer Signer, p AccountKeyPicker, current
 	from := txfrom.GetFrom()
 	accKey := p.GetKey(from)
 
+	pubkey, err := tx.senderPubkeyForKey(signer, accKey, tx.GetRoleTypeForValidation())
+	if err != nil {
+		return 0, err
+	}
+
 	gasKey, err := accKey.SigValidationGas(currentBlockNumber, tx.GetRoleTypeForValidation(), len(pubkey))
 	if err != nil {
 		return 0, err
 	}
 
-	if err := accountkey.ValidateAccountKey(currentBlockNumber, from, accKey, pubkey, tx.GetRoleTypeForValidation()); err != nil {
+	if err := tx.validateAccountKey(signer, p, currentBlockNumber, from, accKey, pubkey, tx.GetRoleTypeForValidation()); err != nil {
 		return 0, ErrInvalidAccountKey
 	}
 
@@ -808,20 +825,20 @@ func (tx *Transaction) ValidateFeePayer(signer Signer, p AccountKeyPicker, curre
 		return 0, errUndefinedTxType
 	}
 
-	pubkey, err := SenderFeePayerPubkey(signer, tx)
+	feePayer := tf.GetFeePayer()
+	accKey := p.GetKey(feePayer)
+
+	pubkey, err := tx.senderPubkeyForKey(signer, accKey, accountkey.RoleFeePayer)
 	if err != nil {
 		return 0, err
 	}
 
-	feePayer := tf.GetFeePayer()
-	accKey := p.GetKey(feePayer)
-
 	gasKey, err := accKey.SigValidationGas(currentBlockNumber, accountkey.RoleFeePayer, len(pubkey))
 	if err != nil {
 		return 0, err
 	}
 
-	if err := accountkey.ValidateAccountKey(currentBlockNumber, feePayer, accKey, pubkey, accountkey.RoleFeePayer); err != nil {
+	if err := tx.validateAccountKey(signer, p, currentBlockNumber, feePayer, accKey, pubkey, accountkey.RoleFeePayer); err != nil {
 		return 0, ErrInvalidAccountKey
 	}
 
@@ -834,6 +851,81 @@ func (tx *Transaction) ValidateFeePayer(signer Signer, p AccountKeyPicker, curre
 	return gasKey, nil
 }
 
+// validateAccountKey returns nil if the recovered public keys are valid for the account key of the given role.
+// If the key of the role is AccountKeyContract, the validation is delegated to the validator contract.
+// In that case, the AccountKeyPicker should implement accountkey.ContractKeyValidator.
+func (tx *Transaction) validateAccountKey(signer Signer, p AccountKeyPicker, currentBlockNumber uint64, from common.Address,
+	accKey accountkey.AccountKey, pubkey []*ecdsa.PublicKey, roleType accountkey.RoleType,
+) error {
+	contractKey, ok := accountkey.KeyForRole(accKey, roleType).(*accountkey.AccountKeyContract)
+	if !ok {
+		return accountkey.ValidateAccountKey(currentBlockNumber, from, accKey, pubkey, roleType)
+	}
+
+	validator, ok := p.(accountkey.ContractKeyValidator)
+	if !ok {
+		logger.Debug("AccountKeyContract cannot be validated without a ContractKeyValidator", "from", from)
+		return errNoContractKeyValidator
+	}
+
+	sigHash, sigs, err := tx.sigHashAndSignatures(signer, roleType)
+	if err != nil {
+		return err
+	}
+
+	return validator.ValidateContractKey(contractKey.ValidatorOf(from), from, sigHash, sigs.EncodeToRSV(signer.ChainID()), params.TxValidationGasContractKey)
+}
+
+// sigHashAndSignatures returns the signature hash and the signatures of the given role.
+// The fee payer signs a different hash from the sender.
+func (tx *Transaction) sigHashAndSignatures(signer Signer, roleType accountkey.RoleType) (common.Hash, TxSignatures, error) {
+	if roleType != accountkey.RoleFeePayer {
+		return signer.Hash(tx), tx.RawSignatureValues(), nil
+	}
+
+	sigHash, err := signer.HashFeePayer(tx)
+	if err != nil {
+		return common.Hash{}, nil, err
+	}
+	sigs, err := tx.GetFeePayerSignatures()
+	if err != nil {
+		return common.Hash{}, nil, err
+	}
+	return sigHash, sigs, nil
+}
+
+// senderPubkeyForKey returns the public keys of the signatures of the given role.
+// The public keys are recovered on the secp256k1 curve unless the key of the role is AccountKeyP256.
+// Since a public key cannot be recovered from a P-256 signature, the signature is verified with the
+// P-256 public key of AccountKeyP256 instead, and the verified public key is returned.
+func (tx *Transaction) senderPubkeyForKey(signer Signer, accKey accountkey.AccountKey, roleType accountkey.RoleType) ([]*ecdsa.PublicKey, error) {
+	p256Key, ok := accountkey.KeyForRole(accKey, roleType).(*accountkey.AccountKeyP256)
+	if !ok {
+		if roleType == accountkey.RoleFeePayer {
+			return SenderFeePayerPubkey(signer, tx)
+		}
+		return SenderPubkey(signer, tx)
+	}
+
+	sigHash, sigs, err := tx.sigHashAndSignatures(signer, roleType)
+	if err != nil {
+		return nil, err
+	}
+	if len(sigs) != 1 {
+		return nil, ErrInvalidSig
+	}
+	// V is not used for the verification, but it should still be bound to the chain ID.
+	recoveryID := new(big.Int).Sub(sigs[0].V, new(big.Int).Mul(signer.ChainID(), big.NewInt(2)))
+	recoveryID.Sub(recoveryID, big.NewInt(35))
+	if recoveryID.Sign() < 0 || recoveryID.Cmp(common.Big1) > 0 {
+		return nil, ErrInvalidChainId
+	}
+	if !p256Key.VerifySignature(sigHash[:], sigs[0].R, sigs[0].S) {
+		return nil, ErrInvalidSig
+	}
+	return []*ecdsa.PublicKey{p256Key.PublicKey()}, nil
+}
+
 // Transactions is a Transaction slice type for basic sorting.
 type Transactions []*Transaction
 
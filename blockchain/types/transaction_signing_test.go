@@ -22,6 +22,8 @@ package types
 
 import (
 	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
 	"math/big"
 	"reflect"
 	"runtime"
@@ -33,6 +35,7 @@ import (
 
 	"github.com/klaytn/klaytn/common"
 	"github.com/klaytn/klaytn/crypto"
+	"github.com/klaytn/klaytn/params"
 	"github.com/klaytn/klaytn/rlp"
 )
 
@@ -2005,6 +2008,120 @@ func testValidateFeeDelegatedChainDataAnchoringWithRatio(t *testing.T) {
 	assert.Equal(t, feePayer, tx.ValidatedFeePayer())
 }
 
+// TestTxSignaturesEncodeToRSV checks that the encoded signatures are recoverable with ecrecover.
+func TestTxSignaturesEncodeToRSV(t *testing.T) {
+	chainID := big.NewInt(8217)
+	signer := LatestSignerForChainID(chainID)
+	hash := crypto.Keccak256Hash([]byte("klaytn"))
+
+	prvs := make([]*ecdsa.PrivateKey, 3)
+	for i := range prvs {
+		prvs[i], _ = crypto.GenerateKey()
+	}
+	tx, err := NewTransactionWithMap(TxTypeValueTransfer, map[TxValueKeyType]interface{}{
+		TxValueKeyNonce:    uint64(0),
+		TxValueKeyTo:       common.HexToAddress("0x0000000000000000000000000000000000005678"),
+		TxValueKeyAmount:   big.NewInt(1),
+		TxValueKeyGasLimit: uint64(100000),
+		TxValueKeyGasPrice: big.NewInt(1),
+		TxValueKeyFrom:     crypto.PubkeyToAddress(prvs[0].PublicKey),
+	})
+	assert.Equal(t, nil, err)
+
+	sigs, err := NewTxSignaturesWithValues(signer, tx, hash, prvs)
+	assert.Equal(t, nil, err)
+
+	enc := sigs.EncodeToRSV(chainID)
+	assert.Equal(t, len(prvs)*crypto.SignatureLength, len(enc))
+
+	for i, prv := range prvs {
+		sig := common.CopyBytes(enc[i*crypto.SignatureLength : (i+1)*crypto.SignatureLength])
+		assert.True(t, sig[crypto.RecoveryIDOffset] == 27 || sig[crypto.RecoveryIDOffset] == 28)
+
+		sig[crypto.RecoveryIDOffset] -= 27
+		pub, err := crypto.SigToPub(hash[:], sig)
+		assert.Equal(t, nil, err)
+		assert.Equal(t, crypto.PubkeyToAddress(prv.PublicKey), crypto.PubkeyToAddress(*pub))
+	}
+}
+
+// signP256ForTest signs the hash with a P-256 private key and returns the signature
+// bound to the chain ID, whose S is in the lower half of the curve order.
+func signP256ForTest(t *testing.T, prv *ecdsa.PrivateKey, hash common.Hash, chainID *big.Int) TxSignatures {
+	r, s, err := ecdsa.Sign(rand.Reader, prv, hash[:])
+	assert.Equal(t, nil, err)
+
+	n := elliptic.P256().Params().N
+	if s.Cmp(new(big.Int).Rsh(n, 1)) > 0 {
+		s.Sub(n, s)
+	}
+	v := new(big.Int).Add(new(big.Int).Mul(chainID, big.NewInt(2)), big.NewInt(35))
+	return TxSignatures{&TxSignature{V: v, R: r, S: s}}
+}
+
+// TestValidateSenderP256 checks that the signatures of the sender and the fee payer
+// having AccountKeyP256 are verified with their P-256 public keys.
+func TestValidateSenderP256(t *testing.T) {
+	chainID := big.NewInt(1)
+	signer := LatestSignerForChainID(chainID)
+
+	senderPrv, _ := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	feePayerPrv, _ := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	from := common.HexToAddress("0x0000000000000000000000000000000000001234")
+	feePayer := common.HexToAddress("0x0000000000000000000000000000000000005678")
+
+	p := &AccountKeyPickerForTest{
+		AddrKeyMap: make(map[common.Address]accountkey.AccountKey),
+	}
+	p.SetKey(from, accountkey.NewAccountKeyP256WithValue(&senderPrv.PublicKey))
+	p.SetKey(feePayer, accountkey.NewAccountKeyRoleBasedWithValues(accountkey.AccountKeyRoleBased{
+		accountkey.NewAccountKeyLegacy(),
+		accountkey.NewAccountKeyLegacy(),
+		accountkey.NewAccountKeyP256WithValue(&feePayerPrv.PublicKey),
+	}))
+
+	tx, err := NewTransactionWithMap(TxTypeFeeDelegatedValueTransfer, map[TxValueKeyType]interface{}{
+		TxValueKeyNonce:    uint64(0),
+		TxValueKeyTo:       common.HexToAddress("0x000000000000000000000000000000000000abcd"),
+		TxValueKeyAmount:   big.NewInt(1),
+		TxValueKeyGasLimit: uint64(100000),
+		TxValueKeyGasPrice: big.NewInt(1),
+		TxValueKeyFrom:     from,
+		TxValueKeyFeePayer: feePayer,
+	})
+	assert.Equal(t, nil, err)
+
+	feePayerHash, err := signer.HashFeePayer(tx)
+	assert.Equal(t, nil, err)
+	tx.SetSignature(signP256ForTest(t, senderPrv, signer.Hash(tx), chainID))
+	assert.Equal(t, nil, tx.SetFeePayerSignatures(signP256ForTest(t, feePayerPrv, feePayerHash, chainID)))
+
+	gas, err := tx.ValidateSender(signer, p, 0)
+	assert.Equal(t, nil, err)
+	assert.Equal(t, params.TxValidationGasP256Key, gas)
+	assert.Equal(t, from, tx.ValidatedSender())
+
+	gas, err = tx.ValidateFeePayer(signer, p, 0)
+	assert.Equal(t, nil, err)
+	assert.Equal(t, params.TxValidationGasP256Key, gas)
+	assert.Equal(t, feePayer, tx.ValidatedFeePayer())
+
+	// The signature of the sender is not valid for the fee payer.
+	assert.Equal(t, nil, tx.SetFeePayerSignatures(tx.RawSignatureValues()))
+	_, err = tx.ValidateFeePayer(signer, p, 0)
+	assert.Equal(t, ErrInvalidSig, err)
+
+	// The signature should be bound to the chain ID.
+	_, err = tx.ValidateSender(LatestSignerForChainID(big.NewInt(2)), p, 0)
+	assert.Equal(t, ErrInvalidChainId, err)
+
+	// The malleable signature with the high S is rejected.
+	sig := tx.RawSignatureValues()[0]
+	tx.SetSignature(TxSignatures{&TxSignature{V: sig.V, R: sig.R, S: new(big.Int).Sub(elliptic.P256().Params().N, sig.S)}})
+	_, err = tx.ValidateSender(signer, p, 0)
+	assert.Equal(t, ErrInvalidSig, err)
+}
+
 func getFunctionName(i interface{}) string {
 	return runtime.FuncForPC(reflect.ValueOf(i).Pointer()).Name()
 }
@@ -25,6 +25,7 @@ import (
 	"github.com/klaytn/klaytn/blockchain/types/accountkey"
 	"github.com/klaytn/klaytn/common"
 	"github.com/klaytn/klaytn/common/hexutil"
+	"github.com/klaytn/klaytn/crypto"
 	"github.com/klaytn/klaytn/kerrors"
 )
 
@@ -170,3 +171,19 @@ func (t TxSignaturesJSON) ToTxSignatures() TxSignatures {
 
 	return sigs
 }
+
+// EncodeToRSV encodes the signatures in the [R || S || V] format where V is 27 or 28.
+// The signatures are concatenated in order, hence the length of the result is 65 * len(t).
+func (t TxSignatures) EncodeToRSV(chainID *big.Int) []byte {
+	chainIdMul := new(big.Int).Mul(chainID, big.NewInt(2))
+	enc := make([]byte, 0, len(t)*crypto.SignatureLength)
+	for _, sig := range t {
+		// V = chainId * 2 + 35 + recoveryID, and it is converted to 27 + recoveryID.
+		v := new(big.Int).Sub(sig.V, chainIdMul)
+		v.Sub(v, big8)
+		enc = append(enc, common.LeftPadBytes(sig.R.Bytes(), 32)...)
+		enc = append(enc, common.LeftPadBytes(sig.S.Bytes(), 32)...)
+		enc = append(enc, byte(v.Uint64()))
+	}
+	return enc
+}
@@ -69,7 +69,16 @@ var allPrecompiles = map[common.Address]PrecompiledContract{
 	common.BytesToAddress([]byte{7}):    &bn256ScalarMulIstanbul{},
 	common.BytesToAddress([]byte{8}):    &bn256PairingIstanbul{},
 	common.BytesToAddress([]byte{9}):    &blake2F{},
-	// TODO-klaytn import bls-signature precompiled contracts
+	common.BytesToAddress([]byte{1, 0}): &p256Verify{},
+	// EIP-2537 BLS12-381 precompiled contracts
+	common.BytesToAddress([]byte{0x0b}): &bls12381G1Add{},
+	common.BytesToAddress([]byte{0x0c}): &bls12381G1MultiExp{},
+	common.BytesToAddress([]byte{0x0d}): &bls12381G2Add{},
+	common.BytesToAddress([]byte{0x0e}): &bls12381G2MultiExp{},
+	common.BytesToAddress([]byte{0x0f}): &bls12381Pairing{},
+	common.BytesToAddress([]byte{0x10}): &bls12381MapG1{},
+	common.BytesToAddress([]byte{0x11}): &bls12381MapG2{},
+	// Klaytn specific precompiled contracts
 	common.BytesToAddress([]byte{3, 253}): &vmLog{},
 	common.BytesToAddress([]byte{3, 254}): &feePayer{},
 	common.BytesToAddress([]byte{3, 255}): &validateSender{},
@@ -253,6 +262,71 @@ func TestPrecompiledBlake2F(t *testing.T)              { testJson("blake2F", "09
 func BenchmarkPrecompiledBlake2F(b *testing.B)         { benchJson("blake2F", "09", b) }
 func TestPrecompileBlake2FMalformedInput(t *testing.T) { testJsonFail("blake2F", "09", t) }
 
+// Tests the sample inputs of the BLS12-381 precompiled contracts (EIP-2537)
+func TestPrecompiledBLS12381G1Add(t *testing.T)      { testJson("blsG1Add", "0b", t) }
+func TestPrecompiledBLS12381G1MultiExp(t *testing.T) { testJson("blsG1MultiExp", "0c", t) }
+func TestPrecompiledBLS12381G2Add(t *testing.T)      { testJson("blsG2Add", "0d", t) }
+func TestPrecompiledBLS12381G2MultiExp(t *testing.T) { testJson("blsG2MultiExp", "0e", t) }
+func TestPrecompiledBLS12381Pairing(t *testing.T)    { testJson("blsPairing", "0f", t) }
+func TestPrecompiledBLS12381MapG1(t *testing.T)      { testJson("blsMapG1", "10", t) }
+func TestPrecompiledBLS12381MapG2(t *testing.T)      { testJson("blsMapG2", "11", t) }
+
+func BenchmarkPrecompiledBLS12381G1Add(b *testing.B)      { benchJson("blsG1Add", "0b", b) }
+func BenchmarkPrecompiledBLS12381G1MultiExp(b *testing.B) { benchJson("blsG1MultiExp", "0c", b) }
+func BenchmarkPrecompiledBLS12381G2Add(b *testing.B)      { benchJson("blsG2Add", "0d", b) }
+func BenchmarkPrecompiledBLS12381G2MultiExp(b *testing.B) { benchJson("blsG2MultiExp", "0e", b) }
+func BenchmarkPrecompiledBLS12381Pairing(b *testing.B)    { benchJson("blsPairing", "0f", b) }
+func BenchmarkPrecompiledBLS12381MapG1(b *testing.B)      { benchJson("blsMapG1", "10", b) }
+func BenchmarkPrecompiledBLS12381MapG2(b *testing.B)      { benchJson("blsMapG2", "11", b) }
+
+// Failure tests
+func TestPrecompiledBLS12381G1AddFail(t *testing.T)      { testJsonFail("blsG1Add", "0b", t) }
+func TestPrecompiledBLS12381G1MultiExpFail(t *testing.T) { testJsonFail("blsG1MultiExp", "0c", t) }
+func TestPrecompiledBLS12381G2AddFail(t *testing.T)      { testJsonFail("blsG2Add", "0d", t) }
+func TestPrecompiledBLS12381G2MultiExpFail(t *testing.T) { testJsonFail("blsG2MultiExp", "0e", t) }
+func TestPrecompiledBLS12381PairingFail(t *testing.T)    { testJsonFail("blsPairing", "0f", t) }
+func TestPrecompiledBLS12381MapG1Fail(t *testing.T)      { testJsonFail("blsMapG1", "10", t) }
+func TestPrecompiledBLS12381MapG2Fail(t *testing.T)      { testJsonFail("blsMapG2", "11", t) }
+
+// TestBLS12381MSMGas checks the discounted gas of the multi-scalar multiplications.
+func TestBLS12381MSMGas(t *testing.T) {
+	g1, g2 := &bls12381G1MultiExp{}, &bls12381G2MultiExp{}
+	for _, tc := range []struct {
+		k            int
+		g1Gas, g2Gas uint64
+	}{
+		{0, 0, 0},
+		{1, 12000, 22500},
+		{2, 22776, 45000},
+		{128, 128 * 12000 * 519 / 1000, 128 * 22500 * 524 / 1000},
+		{200, 200 * 12000 * 519 / 1000, 200 * 22500 * 524 / 1000},
+	} {
+		gas, _ := g1.GetRequiredGasAndComputationCost(make([]byte, 160*tc.k))
+		assert.Equal(t, tc.g1Gas, gas, tc.k)
+		gas, _ = g2.GetRequiredGasAndComputationCost(make([]byte, 288*tc.k))
+		assert.Equal(t, tc.g2Gas, gas, tc.k)
+	}
+}
+
+// TestActivePrecompilesPrague checks that every precompiled address is listed only once after the prague fork
+// even though 0x0b is used by both validateSender of vmversion0 contracts and the BLS12-381 G1Add.
+func TestActivePrecompilesPrague(t *testing.T) {
+	addrs := ActivePrecompiles(params.Rules{IsIstanbul: true, IsKore: true, IsPrague: true})
+	seen := make(map[common.Address]bool)
+	for _, addr := range addrs {
+		assert.False(t, seen[addr], addr.String())
+		seen[addr] = true
+	}
+	for addr := range PrecompiledContractsPrague {
+		assert.True(t, seen[addr], addr.String())
+	}
+	assert.True(t, seen[common.BytesToAddress([]byte{10})])
+}
+
+// Tests the sample inputs of the p256Verify (RIP-7212)
+func TestPrecompiledP256Verify(t *testing.T)      { testJson("p256Verify", "100", t) }
+func BenchmarkPrecompiledP256Verify(b *testing.B) { benchJson("p256Verify", "100", b) }
+
 // Tests the sample inputs of the vmLog
 func TestPrecompiledVmLog(t *testing.T)      { testJson("vmLog", "3fd", t) }
 func BenchmarkPrecompiledVmLog(b *testing.B) { benchJson("vmLog", "3fd", b) }
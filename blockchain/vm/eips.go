@@ -20,6 +20,7 @@ import (
 	"fmt"
 	"math/big"
 
+	"github.com/klaytn/klaytn/common"
 	"github.com/klaytn/klaytn/params"
 )
 
@@ -28,6 +29,10 @@ import (
 // defined jump tables are not polluted.
 func EnableEIP(eipNum int, jt *JumpTable) error {
 	switch eipNum {
+	case 5656:
+		enable5656(jt)
+	case 1153:
+		enable1153(jt)
 	case 3860:
 		enable3860(jt)
 	case 3855:
@@ -217,3 +222,72 @@ func enable3860(jt *JumpTable) {
 	jt[CREATE].dynamicGas = gasCreateEip3860
 	jt[CREATE2].dynamicGas = gasCreate2Eip3860
 }
+
+// enable1153 applies EIP-1153 "Transient Storage"
+// - Adds TLOAD that reads from transient storage
+// - Adds TSTORE that writes to transient storage
+func enable1153(jt *JumpTable) {
+	jt[TLOAD] = &operation{
+		execute:         opTload,
+		constantGas:     params.WarmStorageReadCostEIP2929,
+		minStack:        minStack(1, 1),
+		maxStack:        maxStack(1, 1),
+		computationCost: params.TloadComputationCost,
+	}
+
+	jt[TSTORE] = &operation{
+		execute:         opTstore,
+		constantGas:     params.WarmStorageReadCostEIP2929,
+		minStack:        minStack(2, 0),
+		maxStack:        maxStack(2, 0),
+		writes:          true,
+		computationCost: params.TstoreComputationCost,
+	}
+}
+
+// opTload implements TLOAD opcode
+func opTload(pc *uint64, evm *EVM, contract *Contract, memory *Memory, stack *Stack) ([]byte, error) {
+	loc := stack.Peek()
+	val := evm.StateDB.GetTransientState(contract.Address(), common.BigToHash(loc))
+	loc.SetBytes(val.Bytes())
+	return nil, nil
+}
+
+// opTstore implements TSTORE opcode
+func opTstore(pc *uint64, evm *EVM, contract *Contract, memory *Memory, stack *Stack) ([]byte, error) {
+	loc := common.BigToHash(stack.pop())
+	val := stack.pop()
+	evm.StateDB.SetTransientState(contract.Address(), loc, common.BigToHash(val))
+
+	evm.interpreter.intPool.put(val)
+	return nil, nil
+}
+
+// enable5656 enables EIP-5656 (MCOPY opcode)
+// https://eips.ethereum.org/EIPS/eip-5656
+func enable5656(jt *JumpTable) {
+	jt[MCOPY] = &operation{
+		execute:         opMcopy,
+		constantGas:     GasFastestStep,
+		dynamicGas:      gasMcopy,
+		memorySize:      memoryMcopy,
+		minStack:        minStack(3, 0),
+		maxStack:        maxStack(3, 0),
+		computationCost: params.McopyComputationCost,
+	}
+}
+
+// opMcopy implements the MCOPY opcode (https://eips.ethereum.org/EIPS/eip-5656)
+func opMcopy(pc *uint64, evm *EVM, contract *Contract, memory *Memory, stack *Stack) ([]byte, error) {
+	var (
+		dst    = stack.pop()
+		src    = stack.pop()
+		length = stack.pop()
+	)
+	// These values are checked for overflow during memory expansion calculation
+	// (the memorySize function on the opcode).
+	memory.Copy(dst.Uint64(), src.Uint64(), length.Uint64())
+
+	evm.interpreter.intPool.put(dst, src, length)
+	return nil, nil
+}
@@ -592,6 +592,8 @@ func (evm *EVM) GetPrecompiledContractMap(addr common.Address) map[common.Addres
 	}
 
 	switch {
+	case evm.chainRules.IsPrague:
+		return PrecompiledContractsPrague
 	case evm.chainRules.IsKore:
 		return PrecompiledContractsKore
 	case evm.chainRules.IsIstanbul:
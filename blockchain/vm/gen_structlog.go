@@ -16,19 +16,20 @@ var _ = (*structLogMarshaling)(nil)
 // MarshalJSON marshals as JSON.
 func (s StructLog) MarshalJSON() ([]byte, error) {
 	type StructLog struct {
-		Pc            uint64                      `json:"pc"`
-		Op            OpCode                      `json:"op"`
-		Gas           math.HexOrDecimal64         `json:"gas"`
-		GasCost       math.HexOrDecimal64         `json:"gasCost"`
-		Memory        hexutil.Bytes               `json:"memory"`
-		MemorySize    int                         `json:"memSize"`
-		Stack         []*math.HexOrDecimal256     `json:"stack"`
-		Storage       map[common.Hash]common.Hash `json:"-"`
-		Depth         int                         `json:"depth"`
-		RefundCounter uint64                      `json:"refund"`
-		Err           error                       `json:"-"`
-		OpName        string                      `json:"opName"`
-		ErrorString   string                      `json:"error"`
+		Pc               uint64                      `json:"pc"`
+		Op               OpCode                      `json:"op"`
+		Gas              math.HexOrDecimal64         `json:"gas"`
+		GasCost          math.HexOrDecimal64         `json:"gasCost"`
+		Memory           hexutil.Bytes               `json:"memory"`
+		MemorySize       int                         `json:"memSize"`
+		Stack            []*math.HexOrDecimal256     `json:"stack"`
+		Storage          map[common.Hash]common.Hash `json:"-"`
+		TransientStorage map[common.Hash]common.Hash `json:"-"`
+		Depth            int                         `json:"depth"`
+		RefundCounter    uint64                      `json:"refund"`
+		Err              error                       `json:"-"`
+		OpName           string                      `json:"opName"`
+		ErrorString      string                      `json:"error"`
 	}
 	var enc StructLog
 	enc.Pc = s.Pc
@@ -44,6 +45,7 @@ func (s StructLog) MarshalJSON() ([]byte, error) {
 		}
 	}
 	enc.Storage = s.Storage
+	enc.TransientStorage = s.TransientStorage
 	enc.Depth = s.Depth
 	enc.RefundCounter = s.RefundCounter
 	enc.Err = s.Err
@@ -55,17 +57,18 @@ func (s StructLog) MarshalJSON() ([]byte, error) {
 // UnmarshalJSON unmarshals from JSON.
 func (s *StructLog) UnmarshalJSON(input []byte) error {
 	type StructLog struct {
-		Pc            *uint64                     `json:"pc"`
-		Op            *OpCode                     `json:"op"`
-		Gas           *math.HexOrDecimal64        `json:"gas"`
-		GasCost       *math.HexOrDecimal64        `json:"gasCost"`
-		Memory        *hexutil.Bytes              `json:"memory"`
-		MemorySize    *int                        `json:"memSize"`
-		Stack         []*math.HexOrDecimal256     `json:"stack"`
-		Storage       map[common.Hash]common.Hash `json:"-"`
-		Depth         *int                        `json:"depth"`
-		RefundCounter *uint64                     `json:"refund"`
-		Err           error                       `json:"-"`
+		Pc               *uint64                     `json:"pc"`
+		Op               *OpCode                     `json:"op"`
+		Gas              *math.HexOrDecimal64        `json:"gas"`
+		GasCost          *math.HexOrDecimal64        `json:"gasCost"`
+		Memory           *hexutil.Bytes              `json:"memory"`
+		MemorySize       *int                        `json:"memSize"`
+		Stack            []*math.HexOrDecimal256     `json:"stack"`
+		Storage          map[common.Hash]common.Hash `json:"-"`
+		TransientStorage map[common.Hash]common.Hash `json:"-"`
+		Depth            *int                        `json:"depth"`
+		RefundCounter    *uint64                     `json:"refund"`
+		Err              error                       `json:"-"`
 	}
 	var dec StructLog
 	if err := json.Unmarshal(input, &dec); err != nil {
@@ -98,6 +101,9 @@ func (s *StructLog) UnmarshalJSON(input []byte) error {
 	if dec.Storage != nil {
 		s.Storage = dec.Storage
 	}
+	if dec.TransientStorage != nil {
+		s.TransientStorage = dec.TransientStorage
+	}
 	if dec.Depth != nil {
 		s.Depth = *dec.Depth
 	}
@@ -66,11 +66,20 @@ var (
 	LondonInstructionSet         = newLondonInstructionSet()
 	KoreInstructionSet           = newKoreInstructionSet()
 	ShanghaiInstructionSet       = newShanghaiInstructionSet()
+	CancunInstructionSet         = newCancunInstructionSet()
 )
 
 // JumpTable contains the EVM opcodes supported at a given fork.
 type JumpTable [256]*operation
 
+func newCancunInstructionSet() JumpTable {
+	instructionSet := newShanghaiInstructionSet()
+
+	enable1153(&instructionSet) // EIP-1153: transient storage opcodes
+	enable5656(&instructionSet) // EIP-5656: MCOPY opcode
+	return instructionSet
+}
+
 func newShanghaiInstructionSet() JumpTable {
 	instructionSet := newKoreInstructionSet()
 
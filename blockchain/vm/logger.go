@@ -59,17 +59,18 @@ type LogConfig struct {
 // StructLog is emitted to the EVM each cycle and lists information about the current internal state
 // prior to the execution of the statement.
 type StructLog struct {
-	Pc            uint64                      `json:"pc"`
-	Op            OpCode                      `json:"op"`
-	Gas           uint64                      `json:"gas"`
-	GasCost       uint64                      `json:"gasCost"`
-	Memory        []byte                      `json:"memory"`
-	MemorySize    int                         `json:"memSize"`
-	Stack         []*big.Int                  `json:"stack"`
-	Storage       map[common.Hash]common.Hash `json:"-"`
-	Depth         int                         `json:"depth"`
-	RefundCounter uint64                      `json:"refund"`
-	Err           error                       `json:"-"`
+	Pc               uint64                      `json:"pc"`
+	Op               OpCode                      `json:"op"`
+	Gas              uint64                      `json:"gas"`
+	GasCost          uint64                      `json:"gasCost"`
+	Memory           []byte                      `json:"memory"`
+	MemorySize       int                         `json:"memSize"`
+	Stack            []*big.Int                  `json:"stack"`
+	Storage          map[common.Hash]common.Hash `json:"-"`
+	TransientStorage map[common.Hash]common.Hash `json:"-"`
+	Depth            int                         `json:"depth"`
+	RefundCounter    uint64                      `json:"refund"`
+	Err              error                       `json:"-"`
 }
 
 // overrides for gencodec
@@ -117,16 +118,18 @@ type Tracer interface {
 type StructLogger struct {
 	cfg LogConfig
 
-	logs          []StructLog
-	changedValues map[common.Address]Storage
-	output        []byte
-	err           error
+	logs                   []StructLog
+	changedValues          map[common.Address]Storage
+	changedTransientValues map[common.Address]Storage
+	output                 []byte
+	err                    error
 }
 
 // NewStructLogger returns a new logger
 func NewStructLogger(cfg *LogConfig) *StructLogger {
 	logger := &StructLogger{
-		changedValues: make(map[common.Address]Storage),
+		changedValues:          make(map[common.Address]Storage),
+		changedTransientValues: make(map[common.Address]Storage),
 	}
 	if cfg != nil {
 		logger.cfg = *cfg
@@ -140,7 +143,7 @@ func (l *StructLogger) CaptureStart(from common.Address, to common.Address, crea
 
 // CaptureState logs a new structured log message and pushes it out to the environment
 //
-// CaptureState also tracks SSTORE ops to track dirty values.
+// CaptureState also tracks SSTORE and TSTORE ops to track dirty values.
 func (l *StructLogger) CaptureState(env *EVM, pc uint64, op OpCode, gas, cost uint64, scope *ScopeContext, depth int, err error) {
 	memory := scope.Memory
 	stack := scope.Stack
@@ -155,6 +158,9 @@ func (l *StructLogger) CaptureState(env *EVM, pc uint64, op OpCode, gas, cost ui
 	if l.changedValues[contract.Address()] == nil {
 		l.changedValues[contract.Address()] = make(Storage)
 	}
+	if l.changedTransientValues[contract.Address()] == nil {
+		l.changedTransientValues[contract.Address()] = make(Storage)
+	}
 
 	// capture SSTORE opcodes and determine the changed value and store
 	// it in the local storage container.
@@ -165,6 +171,14 @@ func (l *StructLogger) CaptureState(env *EVM, pc uint64, op OpCode, gas, cost ui
 		)
 		l.changedValues[contract.Address()][address] = value
 	}
+	// capture TSTORE opcodes in the same way as SSTORE.
+	if op == TSTORE && stack.len() >= 2 {
+		var (
+			value   = common.BigToHash(stack.data[stack.len()-2])
+			address = common.BigToHash(stack.data[stack.len()-1])
+		)
+		l.changedTransientValues[contract.Address()][address] = value
+	}
 	// Copy a snapshot of the current memory state to a new buffer
 	var mem []byte
 	if !l.cfg.DisableMemory {
@@ -180,12 +194,13 @@ func (l *StructLogger) CaptureState(env *EVM, pc uint64, op OpCode, gas, cost ui
 		}
 	}
 	// Copy a snapshot of the current storage to a new container
-	var storage Storage
+	var storage, transientStorage Storage
 	if !l.cfg.DisableStorage {
 		storage = l.changedValues[contract.Address()].Copy()
+		transientStorage = l.changedTransientValues[contract.Address()].Copy()
 	}
 	// create a new snapshot of the EVM.
-	log := StructLog{pc, op, gas, cost, mem, memory.Len(), stck, storage, depth, env.StateDB.GetRefund(), err}
+	log := StructLog{pc, op, gas, cost, mem, memory.Len(), stck, storage, transientStorage, depth, env.StateDB.GetRefund(), err}
 
 	l.logs = append(l.logs, log)
 }
@@ -245,6 +260,12 @@ func WriteTrace(writer io.Writer, logs []StructLog) {
 				fmt.Fprintf(writer, "%x: %x\n", h, item)
 			}
 		}
+		if len(log.TransientStorage) > 0 {
+			fmt.Fprintln(writer, "Transient Storage:")
+			for h, item := range log.TransientStorage {
+				fmt.Fprintf(writer, "%x: %x\n", h, item)
+			}
+		}
 		fmt.Fprintln(writer)
 	}
 }
@@ -75,3 +75,26 @@ func TestStoreCapture(t *testing.T) {
 		t.Errorf("expected %x, got %x", exp, logger.changedValues[contract.Address()][index])
 	}
 }
+
+func TestTransientStoreCapture(t *testing.T) {
+	var (
+		env      = NewEVM(BlockContext{}, TxContext{}, &dummyStatedb{}, params.TestChainConfig, &Config{})
+		logger   = NewStructLogger(nil)
+		mem      = NewMemory()
+		stack    = newstack()
+		contract = NewContract(&dummyContractRef{}, &dummyContractRef{}, new(big.Int), 0)
+	)
+	stack.push(big.NewInt(1))
+	stack.push(big.NewInt(0))
+
+	var index common.Hash
+
+	logger.CaptureState(env, 0, TSTORE, 0, 0, &ScopeContext{Memory: mem, Stack: stack, Contract: contract}, 0, nil)
+	if len(logger.changedValues[contract.Address()]) != 0 {
+		t.Fatalf("expected no changed storage value on address %x, got %d", contract.Address(), len(logger.changedValues[contract.Address()]))
+	}
+	exp := common.BigToHash(big.NewInt(1))
+	if got := logger.StructLogs()[0].TransientStorage[index]; got != exp {
+		t.Errorf("expected %x, got %x", exp, got)
+	}
+}
@@ -65,6 +65,7 @@ func setDefaults(cfg *Config) {
 			EthTxTypeCompatibleBlock: new(big.Int),
 			KoreCompatibleBlock:      new(big.Int),
 			ShanghaiCompatibleBlock:  new(big.Int),
+			CancunCompatibleBlock:    new(big.Int),
 		}
 	}
 
@@ -491,3 +491,59 @@ func TestColdAccountAccessCost(t *testing.T) {
 		}
 	}
 }
+
+// TestTransientStorageAndMcopy tests TLOAD, TSTORE and MCOPY introduced at the Cancun fork.
+func TestTransientStorageAndMcopy(t *testing.T) {
+	code := []byte{
+		byte(vm.PUSH1), 0x2a, byte(vm.PUSH1), 0x01, byte(vm.TSTORE), // TSTORE(1, 0x2a)
+		byte(vm.PUSH1), 0x01, byte(vm.TLOAD), // TLOAD(1)
+		byte(vm.PUSH1), 0x00, byte(vm.MSTORE), // MSTORE(0, 0x2a)
+		byte(vm.PUSH1), 0x20, byte(vm.PUSH1), 0x00, byte(vm.PUSH1), 0x20, byte(vm.MCOPY), // MCOPY(32, 0, 32)
+		byte(vm.PUSH1), 0x20, byte(vm.PUSH1), 0x20, byte(vm.RETURN), // RETURN(32, 32)
+	}
+	tracer := vm.NewStructLogger(nil)
+	ret, _, err := Execute(code, nil, &Config{
+		EVMConfig: vm.Config{
+			Debug:  true,
+			Tracer: tracer,
+		},
+	})
+	if err != nil {
+		t.Fatal("didn't expect error", err)
+	}
+	if num := new(big.Int).SetBytes(ret); num.Cmp(big.NewInt(0x2a)) != 0 {
+		t.Error("Expected 42, got", num)
+	}
+
+	logs := tracer.StructLogs()
+	for step, want := range map[int]uint64{
+		2:  100, // TSTORE
+		4:  100, // TLOAD
+		10: 9,   // MCOPY: 3 + 3 (one word) + 3 (memory expansion)
+	} {
+		if have := logs[step].GasCost; have != want {
+			t.Errorf("gas report wrong, step %d (%v), have %d want %d", step, logs[step].OpName(), have, want)
+		}
+	}
+	transientStorage := logs[len(logs)-1].TransientStorage
+	if have := transientStorage[common.BigToHash(big.NewInt(1))]; len(transientStorage) != 1 || have != common.BigToHash(big.NewInt(0x2a)) {
+		t.Errorf("transient storage wrong, have %v", transientStorage)
+	}
+}
+
+// TestTransientStorageNotSupportedBeforeCancun tests that TSTORE is an invalid opcode before the Cancun fork.
+func TestTransientStorageNotSupportedBeforeCancun(t *testing.T) {
+	code := []byte{byte(vm.PUSH1), 0x2a, byte(vm.PUSH1), 0x01, byte(vm.TSTORE)}
+	_, _, err := Execute(code, nil, &Config{
+		ChainConfig: &params.ChainConfig{
+			ChainID:                 big.NewInt(1),
+			IstanbulCompatibleBlock: new(big.Int),
+			LondonCompatibleBlock:   new(big.Int),
+			KoreCompatibleBlock:     new(big.Int),
+			ShanghaiCompatibleBlock: new(big.Int),
+		},
+	})
+	if err == nil {
+		t.Fatal("expected an invalid opcode error")
+	}
+}
@@ -0,0 +1,364 @@
+// Copyright 2024 The klaytn Authors
+// This file is part of the klaytn library.
+//
+// The klaytn library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The klaytn library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the klaytn library. If not, see <http://www.gnu.org/licenses/>.
+
+package client
+
+import (
+	"context"
+	"math/big"
+
+	"github.com/klaytn/klaytn"
+	"github.com/klaytn/klaytn/blockchain/types"
+	"github.com/klaytn/klaytn/common"
+	"github.com/klaytn/klaytn/event"
+	kgrpc "github.com/klaytn/klaytn/networks/grpc"
+	"github.com/klaytn/klaytn/rlp"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+)
+
+// GRPCClient defines typed wrappers for the typed gRPC API of a Klaytn node.
+type GRPCClient struct {
+	conn *grpc.ClientConn
+	c    kgrpc.KlaytnAPIClient
+}
+
+// DialGRPC connects a client to the gRPC endpoint at the given address. If no dial option
+// is given, the connection is not secured.
+func DialGRPC(ctx context.Context, addr string, opts ...grpc.DialOption) (*GRPCClient, error) {
+	if len(opts) == 0 {
+		opts = []grpc.DialOption{grpc.WithTransportCredentials(insecure.NewCredentials())}
+	}
+	conn, err := grpc.DialContext(ctx, addr, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return NewGRPCClient(conn), nil
+}
+
+// NewGRPCClient creates a client that uses the given gRPC connection.
+func NewGRPCClient(conn *grpc.ClientConn) *GRPCClient {
+	return &GRPCClient{conn: conn, c: kgrpc.NewKlaytnAPIClient(conn)}
+}
+
+func (gc *GRPCClient) Close() error {
+	return gc.conn.Close()
+}
+
+// BlockNumber returns the number of the latest block.
+func (gc *GRPCClient) BlockNumber(ctx context.Context) (uint64, error) {
+	res, err := gc.c.GetBlockNumber(ctx, &kgrpc.BlockNumberRequest{})
+	if err != nil {
+		return 0, err
+	}
+	return res.Number, nil
+}
+
+// BlockByHash returns the given full block.
+func (gc *GRPCClient) BlockByHash(ctx context.Context, hash common.Hash) (*types.Block, error) {
+	return gc.getBlock(ctx, &kgrpc.BlockRequest{Hash: hash.Bytes()})
+}
+
+// BlockByNumber returns a block from the current canonical chain. If number is nil, the
+// latest known block is returned.
+func (gc *GRPCClient) BlockByNumber(ctx context.Context, number *big.Int) (*types.Block, error) {
+	return gc.getBlock(ctx, &kgrpc.BlockRequest{Number: toBlockNumber(number)})
+}
+
+func (gc *GRPCClient) getBlock(ctx context.Context, req *kgrpc.BlockRequest) (*types.Block, error) {
+	res, err := gc.c.GetBlock(ctx, req)
+	if err != nil {
+		return nil, toNotFound(err)
+	}
+	txs := make([]*types.Transaction, len(res.Transactions))
+	for i, tx := range res.Transactions {
+		if txs[i], err = decodeTransaction(tx); err != nil {
+			return nil, err
+		}
+	}
+	return types.NewBlockWithHeader(fromHeader(res.Header)).WithBody(txs), nil
+}
+
+// HeaderByHash returns the block header with the given hash.
+func (gc *GRPCClient) HeaderByHash(ctx context.Context, hash common.Hash) (*types.Header, error) {
+	res, err := gc.c.GetHeader(ctx, &kgrpc.BlockRequest{Hash: hash.Bytes()})
+	if err != nil {
+		return nil, toNotFound(err)
+	}
+	return fromHeader(res), nil
+}
+
+// HeaderByNumber returns a block header from the current canonical chain. If number is
+// nil, the latest known header is returned.
+func (gc *GRPCClient) HeaderByNumber(ctx context.Context, number *big.Int) (*types.Header, error) {
+	res, err := gc.c.GetHeader(ctx, &kgrpc.BlockRequest{Number: toBlockNumber(number)})
+	if err != nil {
+		return nil, toNotFound(err)
+	}
+	return fromHeader(res), nil
+}
+
+// TransactionByHash returns the transaction with the given hash.
+func (gc *GRPCClient) TransactionByHash(ctx context.Context, hash common.Hash) (tx *types.Transaction, isPending bool, err error) {
+	res, err := gc.c.GetTransaction(ctx, &kgrpc.TransactionRequest{Hash: hash.Bytes()})
+	if err != nil {
+		return nil, false, toNotFound(err)
+	}
+	tx, err = decodeTransaction(res)
+	return tx, len(res.BlockHash) == 0, err
+}
+
+// TransactionReceipt returns the receipt of a transaction by transaction hash.
+// Note that the receipt is not available for pending transactions.
+func (gc *GRPCClient) TransactionReceipt(ctx context.Context, txHash common.Hash) (*types.Receipt, error) {
+	res, err := gc.c.GetReceipt(ctx, &kgrpc.TransactionRequest{Hash: txHash.Bytes()})
+	if err != nil {
+		return nil, toNotFound(err)
+	}
+	logs := make([]*types.Log, len(res.Logs))
+	for i, log := range res.Logs {
+		logs[i] = fromLog(log)
+	}
+	return &types.Receipt{
+		Status:          uint(res.Status),
+		Bloom:           types.BytesToBloom(res.LogsBloom),
+		Logs:            logs,
+		TxHash:          common.BytesToHash(res.TransactionHash),
+		ContractAddress: common.BytesToAddress(res.ContractAddress),
+		GasUsed:         res.GasUsed,
+	}, nil
+}
+
+// FilterLogs executes a filter query.
+func (gc *GRPCClient) FilterLogs(ctx context.Context, q klaytn.FilterQuery) ([]types.Log, error) {
+	res, err := gc.c.GetLogs(ctx, toLogFilter(q))
+	if err != nil {
+		return nil, err
+	}
+	logs := make([]types.Log, len(res.Logs))
+	for i, log := range res.Logs {
+		logs[i] = *fromLog(log)
+	}
+	return logs, nil
+}
+
+// BalanceAt returns the peb balance of the given account.
+// The block number can be nil, in which case the balance is taken from the latest known block.
+func (gc *GRPCClient) BalanceAt(ctx context.Context, account common.Address, blockNumber *big.Int) (*big.Int, error) {
+	res, err := gc.c.GetBalance(ctx, &kgrpc.AccountRequest{Address: account.Bytes(), BlockNumber: toBlockNumber(blockNumber)})
+	if err != nil {
+		return nil, err
+	}
+	return new(big.Int).SetBytes(res.Balance), nil
+}
+
+// NonceAt returns the account nonce of the given account.
+// The block number can be nil, in which case the nonce is taken from the latest known block.
+func (gc *GRPCClient) NonceAt(ctx context.Context, account common.Address, blockNumber *big.Int) (uint64, error) {
+	res, err := gc.c.GetAccount(ctx, &kgrpc.AccountRequest{Address: account.Bytes(), BlockNumber: toBlockNumber(blockNumber)})
+	if err != nil {
+		return 0, err
+	}
+	return res.Nonce, nil
+}
+
+// SendRawTransaction injects a signed transaction into the pending pool for execution.
+func (gc *GRPCClient) SendRawTransaction(ctx context.Context, tx *types.Transaction) (common.Hash, error) {
+	data, err := rlp.EncodeToBytes(tx)
+	if err != nil {
+		return common.Hash{}, err
+	}
+	res, err := gc.c.SendRawTransaction(ctx, &kgrpc.RawTransaction{Data: data})
+	if err != nil {
+		return common.Hash{}, err
+	}
+	return common.BytesToHash(res.Hash), nil
+}
+
+// SubscribeNewHead subscribes to notifications about the current blockchain head.
+func (gc *GRPCClient) SubscribeNewHead(ctx context.Context, ch chan<- *types.Header) (klaytn.Subscription, error) {
+	ctx, cancel := context.WithCancel(ctx)
+	stream, err := gc.c.SubscribeNewHeads(ctx, &kgrpc.SubscribeRequest{})
+	if err != nil {
+		cancel()
+		return nil, err
+	}
+	return newStreamSubscription(cancel, func(quit <-chan struct{}) error {
+		header, err := stream.Recv()
+		if err != nil {
+			return err
+		}
+		select {
+		case ch <- fromHeader(header):
+		case <-quit:
+		}
+		return nil
+	}), nil
+}
+
+// SubscribeFilterLogs subscribes to the results of a streaming filter query.
+func (gc *GRPCClient) SubscribeFilterLogs(ctx context.Context, q klaytn.FilterQuery, ch chan<- types.Log) (klaytn.Subscription, error) {
+	ctx, cancel := context.WithCancel(ctx)
+	stream, err := gc.c.SubscribeLogs(ctx, toLogFilter(q))
+	if err != nil {
+		cancel()
+		return nil, err
+	}
+	return newStreamSubscription(cancel, func(quit <-chan struct{}) error {
+		log, err := stream.Recv()
+		if err != nil {
+			return err
+		}
+		select {
+		case ch <- *fromLog(log):
+		case <-quit:
+		}
+		return nil
+	}), nil
+}
+
+// SubscribePendingTransactions subscribes to the hashes of the transactions entering the
+// transaction pool.
+func (gc *GRPCClient) SubscribePendingTransactions(ctx context.Context, ch chan<- common.Hash) (klaytn.Subscription, error) {
+	ctx, cancel := context.WithCancel(ctx)
+	stream, err := gc.c.SubscribePendingTransactions(ctx, &kgrpc.SubscribeRequest{})
+	if err != nil {
+		cancel()
+		return nil, err
+	}
+	return newStreamSubscription(cancel, func(quit <-chan struct{}) error {
+		hash, err := stream.Recv()
+		if err != nil {
+			return err
+		}
+		select {
+		case ch <- common.BytesToHash(hash.Hash):
+		case <-quit:
+		}
+		return nil
+	}), nil
+}
+
+// newStreamSubscription returns a subscription receiving a stream by recv until it is
+// unsubscribed, which cancels the stream.
+func newStreamSubscription(cancel context.CancelFunc, recv func(quit <-chan struct{}) error) klaytn.Subscription {
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer cancel()
+
+		errc := make(chan error, 1)
+		go func() {
+			for {
+				if err := recv(quit); err != nil {
+					errc <- err
+					return
+				}
+			}
+		}()
+		select {
+		case <-quit:
+			return nil
+		case err := <-errc:
+			return err
+		}
+	})
+}
+
+// toBlockNumber returns the block number of the request, where -1 is the latest block.
+func toBlockNumber(number *big.Int) int64 {
+	if number == nil {
+		return -1
+	}
+	return number.Int64()
+}
+
+func toNotFound(err error) error {
+	if status.Code(err) == codes.NotFound {
+		return klaytn.NotFound
+	}
+	return err
+}
+
+func toLogFilter(q klaytn.FilterQuery) *kgrpc.LogFilter {
+	filter := &kgrpc.LogFilter{ToBlock: toBlockNumber(q.ToBlock)}
+	if q.BlockHash != nil {
+		filter.BlockHash = q.BlockHash.Bytes()
+	}
+	if q.FromBlock != nil {
+		filter.FromBlock = q.FromBlock.Int64()
+	}
+	for _, address := range q.Addresses {
+		filter.Addresses = append(filter.Addresses, address.Bytes())
+	}
+	for _, alternatives := range q.Topics {
+		topics := &kgrpc.Topics{}
+		for _, topic := range alternatives {
+			topics.Hashes = append(topics.Hashes, topic.Bytes())
+		}
+		filter.Topics = append(filter.Topics, topics)
+	}
+	return filter
+}
+
+func fromHeader(h *kgrpc.Header) *types.Header {
+	header := &types.Header{
+		ParentHash:  common.BytesToHash(h.ParentHash),
+		Rewardbase:  common.BytesToAddress(h.Rewardbase),
+		Root:        common.BytesToHash(h.StateRoot),
+		TxHash:      common.BytesToHash(h.TransactionsRoot),
+		ReceiptHash: common.BytesToHash(h.ReceiptsRoot),
+		Bloom:       types.BytesToBloom(h.LogsBloom),
+		BlockScore:  new(big.Int).SetBytes(h.BlockScore),
+		Number:      new(big.Int).SetUint64(h.Number),
+		GasUsed:     h.GasUsed,
+		Time:        new(big.Int).SetUint64(h.Time),
+		TimeFoS:     uint8(h.TimeFos),
+		Extra:       h.ExtraData,
+		Governance:  h.GovernanceData,
+		Vote:        h.VoteData,
+	}
+	if len(h.BaseFee) > 0 {
+		header.BaseFee = new(big.Int).SetBytes(h.BaseFee)
+	}
+	return header
+}
+
+func decodeTransaction(tx *kgrpc.Transaction) (*types.Transaction, error) {
+	decoded := new(types.Transaction)
+	if err := rlp.DecodeBytes(tx.Raw, decoded); err != nil {
+		return nil, err
+	}
+	return decoded, nil
+}
+
+func fromLog(l *kgrpc.Log) *types.Log {
+	topics := make([]common.Hash, len(l.Topics))
+	for i, topic := range l.Topics {
+		topics[i] = common.BytesToHash(topic)
+	}
+	return &types.Log{
+		Address:     common.BytesToAddress(l.Address),
+		Topics:      topics,
+		Data:        l.Data,
+		BlockNumber: l.BlockNumber,
+		TxHash:      common.BytesToHash(l.TransactionHash),
+		TxIndex:     uint(l.TransactionIndex),
+		BlockHash:   common.BytesToHash(l.BlockHash),
+		Index:       uint(l.Index),
+		Removed:     l.Removed,
+	}
+}
@@ -99,7 +99,7 @@ var (
 	}
 	langFlag = &cli.StringFlag{
 		Name:  "lang",
-		Usage: "Destination language for the bindings (go, java, objc)",
+		Usage: "Destination language for the bindings (go, java, objc, ts)",
 		Value: "go",
 	}
 	aliasFlag = &cli.StringFlag{
@@ -130,9 +130,6 @@ func init() {
 
 func abigen(c *cli.Context) error {
 	utils.CheckExclusive(c, abiFlag, jsonFlag, solFlag) // Only one source can be selected.
-	if c.String(pkgFlag.Name) == "" {
-		log.Fatalf("No destination package specified (--pkg)")
-	}
 	var lang bind.Lang
 	switch c.String(langFlag.Name) {
 	case "go":
@@ -142,9 +139,15 @@ func abigen(c *cli.Context) error {
 	case "objc":
 		lang = bind.LangObjC
 		log.Fatalf("Objc binding generation is uncompleted")
+	case "ts":
+		lang = bind.LangTS
 	default:
 		log.Fatalf("Unsupported destination language \"%s\" (--lang)", c.String(langFlag.Name))
 	}
+	// TypeScript bindings are modules without a package declaration
+	if c.String(pkgFlag.Name) == "" && lang != bind.LangTS {
+		log.Fatalf("No destination package specified (--pkg)")
+	}
 	// If the entire solidity code was specified, build and bind based on that
 	var (
 		abis        []string
@@ -197,6 +200,9 @@ func abigen(c *cli.Context) error {
 		if kind == "" {
 			kind = c.String(pkgFlag.Name)
 		}
+		if kind == "" {
+			log.Fatalf("No contract type name specified (--type)")
+		}
 		types = append(types, kind)
 	} else {
 		// Generate the list of types to exclude from binding
@@ -492,6 +492,13 @@ var (
 		Aliases: []string{"genesis.hardfork.cancun-compatible-blocknumber"},
 	}
 
+	pragueCompatibleBlockNumberFlag = &cli.Int64Flag{
+		Name:    "prague-compatible-blocknumber",
+		Usage:   "pragueCompatible blockNumber",
+		Value:   0,
+		Aliases: []string{"genesis.hardfork.prague-compatible-blocknumber"},
+	}
+
 	// KIP103 hardfork is optional
 	kip103CompatibleBlockNumberFlag = &cli.Int64Flag{
 		Name:    "kip103-compatible-blocknumber",
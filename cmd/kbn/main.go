@@ -196,6 +196,7 @@ func main() {
 	app.Commands = []*cli.Command{
 		nodecmd.VersionCommand,
 		nodecmd.AttachCommand,
+		nodecmd.DiscoverCommand,
 	}
 
 	app.Action = bootnode
@@ -56,6 +56,7 @@ func init() {
 		// See utils/nodecmd/consolecmd.go:
 		nodecmd.GetConsoleCommand(utils.KenNodeFlags(), utils.CommonRPCFlags),
 		nodecmd.AttachCommand,
+		nodecmd.DiscoverCommand,
 
 		// See utils/nodecmd/versioncmd.go:
 		nodecmd.VersionCommand,
@@ -71,6 +72,9 @@ func init() {
 
 		// See utils/nodecmd/snapshot.go:
 		nodecmd.SnapshotCommand,
+
+		// See utils/nodecmd/dbcmd.go:
+		nodecmd.DBCommand,
 	}
 	sort.Sort(cli.CommandsByName(app.Commands))
 
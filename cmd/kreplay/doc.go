@@ -0,0 +1,33 @@
+// Copyright 2024 The klaytn Authors
+// This file is part of the klaytn library.
+//
+// The klaytn library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The klaytn library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the klaytn library. If not, see <http://www.gnu.org/licenses/>.
+
+/*
+kreplay replays the JSON-RPC calls recorded by a node started with `--rpc.record.dir`
+against a target node, and reports the latency percentiles and the number of the
+responses different from the recorded ones for each method.
+
+The record files or the directories holding them are given as the arguments.
+
+# Options
+
+All available options are as follows.
+
+	--target value       Endpoint of the node to send the calls to (default: "http://localhost:8551")
+	--speed value        Speed of the replay relative to the recorded timing, 0 sends the calls as fast as possible (default: 1)
+	--concurrency value  Maximum number of the calls in flight (default: 64)
+	--help, -h           Show help
+*/
+package main
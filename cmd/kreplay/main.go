@@ -0,0 +1,144 @@
+// Copyright 2024 The klaytn Authors
+// This file is part of the klaytn library.
+//
+// The klaytn library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The klaytn library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the klaytn library. If not, see <http://www.gnu.org/licenses/>.
+
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"os/signal"
+	"syscall"
+	"text/tabwriter"
+
+	"github.com/klaytn/klaytn/cmd/utils"
+	"github.com/klaytn/klaytn/cmd/utils/nodecmd"
+	"github.com/klaytn/klaytn/networks/rpc"
+	"github.com/urfave/cli/v2"
+)
+
+var (
+	targetFlag = &cli.StringFlag{
+		Name:  "target",
+		Usage: "Endpoint of the node to send the calls to",
+		Value: "http://localhost:8551",
+	}
+	speedFlag = &cli.Float64Flag{
+		Name:  "speed",
+		Usage: "Speed of the replay relative to the recorded timing, 0 sends the calls as fast as possible",
+		Value: 1,
+	}
+	concurrencyFlag = &cli.IntFlag{
+		Name:  "concurrency",
+		Usage: "Maximum number of the calls in flight",
+		Value: 64,
+	}
+)
+
+var errNoRecordFile = errors.New("no record file is given")
+
+func init() {
+	cli.AppHelpTemplate = utils.KgenHelpTemplate
+	cli.HelpPrinter = utils.NewHelpPrinter(nil)
+}
+
+func main() {
+	app := cli.NewApp()
+	app.Name = "kreplay"
+	app.Usage = "The command line interface to replay the recorded JSON-RPC calls against a Klaytn node"
+	app.Copyright = "Copyright 2018-2024 The klaytn Authors"
+	app.ArgsUsage = "<record file or directory>..."
+	app.Action = replay
+	app.Flags = []cli.Flag{
+		targetFlag,
+		speedFlag,
+		concurrencyFlag,
+	}
+	app.Commands = []*cli.Command{
+		nodecmd.VersionCommand,
+	}
+	app.HideVersion = true
+	if err := app.Run(os.Args); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+}
+
+func replay(ctx *cli.Context) error {
+	calls, err := readCalls(ctx.Args().Slice())
+	if err != nil {
+		return err
+	}
+	client, err := rpc.Dial(ctx.String(targetFlag.Name))
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+
+	replayCtx, cancel := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+	defer cancel()
+
+	fmt.Printf("Replaying %d calls to %s\n", len(calls), ctx.String(targetFlag.Name))
+	report, err := rpc.Replay(replayCtx, client, calls, rpc.ReplayConfig{
+		Speed:       ctx.Float64(speedFlag.Name),
+		Concurrency: ctx.Int(concurrencyFlag.Name),
+	})
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("Replayed in %v\n\n", report.Duration)
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', tabwriter.AlignRight)
+	fmt.Fprintln(w, "METHOD\tCALLS\tERRORS\tMISMATCHES\tP50\tP90\tP99\tMAX\t")
+	for _, m := range report.Methods {
+		fmt.Fprintf(w, "%s\t%d\t%d\t%d\t%v\t%v\t%v\t%v\t\n", m.Method, m.Calls, m.Errors, m.Mismatches, m.P50, m.P90, m.P99, m.Max)
+	}
+	return w.Flush()
+}
+
+// readCalls reads the calls in the record files, or in the record files of the directories.
+func readCalls(paths []string) ([]*rpc.RecordedCall, error) {
+	var files []string
+	for _, path := range paths {
+		info, err := os.Stat(path)
+		if err != nil {
+			return nil, err
+		}
+		if !info.IsDir() {
+			files = append(files, path)
+			continue
+		}
+		dirFiles, err := rpc.RecordFiles(path)
+		if err != nil {
+			return nil, err
+		}
+		files = append(files, dirFiles...)
+	}
+	if len(files) == 0 {
+		return nil, errNoRecordFile
+	}
+
+	var calls []*rpc.RecordedCall
+	for _, file := range files {
+		fileCalls, err := rpc.ReadRecordFile(file)
+		if err != nil {
+			return nil, err
+		}
+		calls = append(calls, fileCalls...)
+	}
+	return calls, nil
+}
@@ -0,0 +1,38 @@
+// Copyright 2024 The klaytn Authors
+// This file is part of the klaytn library.
+//
+// The klaytn library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The klaytn library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the klaytn library. If not, see <http://www.gnu.org/licenses/>.
+
+/*
+ksigner is the reference external signer of Klaytn. It keeps the keys outside of
+the node process and signs the requests forwarded by a node started with `--signer`.
+
+The JSON-RPC API is served under the "account" namespace as described in the signer package.
+Every request is checked against the rule file and recorded in the audit log.
+
+# Options
+
+All available options are as follows.
+
+	--keystore value   Directory of the keystore files to sign with
+	--password value   Password file to decrypt the keystore files
+	--rules value      Rule file (JSON) restricting the signing requests
+	--audit value      File to append the audit log of the signing requests (default: "audit.log")
+	--ipcpath value    Filename of the IPC socket/pipe (default: "ksigner.ipc")
+	--http             Enable the HTTP endpoint in addition to the IPC endpoint
+	--http.addr value  HTTP endpoint listening interface (default: "localhost")
+	--http.port value  HTTP endpoint listening port (default: 8550)
+	--help, -h         Show help
+*/
+package main
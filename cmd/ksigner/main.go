@@ -0,0 +1,202 @@
+// Copyright 2024 The klaytn Authors
+// This file is part of the klaytn library.
+//
+// The klaytn library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The klaytn library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the klaytn library. If not, see <http://www.gnu.org/licenses/>.
+
+package main
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net"
+	"os"
+	"os/signal"
+	"strings"
+	"syscall"
+
+	"github.com/klaytn/klaytn/accounts/keystore"
+	"github.com/klaytn/klaytn/cmd/utils"
+	"github.com/klaytn/klaytn/cmd/utils/nodecmd"
+	"github.com/klaytn/klaytn/log"
+	"github.com/klaytn/klaytn/networks/rpc"
+	"github.com/klaytn/klaytn/signer"
+	"github.com/urfave/cli/v2"
+)
+
+var (
+	logger       = log.NewModuleLogger(log.CMDKSigner)
+	keystoreFlag = &cli.StringFlag{
+		Name:     "keystore",
+		Usage:    "Directory of the keystore files to sign with",
+		Required: true,
+	}
+	passwordFlag = &cli.StringFlag{
+		Name:     "password",
+		Usage:    "Password file to decrypt the keystore files. A single line is used for all accounts, otherwise one line per account in the order of the keystore files",
+		Required: true,
+	}
+	rulesFlag = &cli.StringFlag{
+		Name:  "rules",
+		Usage: "Rule file (JSON) restricting the signing requests",
+	}
+	auditFlag = &cli.StringFlag{
+		Name:  "audit",
+		Usage: "File to append the audit log of the signing requests",
+		Value: "audit.log",
+	}
+	ipcPathFlag = &cli.StringFlag{
+		Name:  "ipcpath",
+		Usage: "Filename of the IPC socket/pipe",
+		Value: "ksigner.ipc",
+	}
+	httpFlag = &cli.BoolFlag{
+		Name:  "http",
+		Usage: "Enable the HTTP endpoint in addition to the IPC endpoint",
+	}
+	httpAddrFlag = &cli.StringFlag{
+		Name:  "http.addr",
+		Usage: "HTTP endpoint listening interface",
+		Value: "localhost",
+	}
+	httpPortFlag = &cli.IntFlag{
+		Name:  "http.port",
+		Usage: "HTTP endpoint listening port",
+		Value: 8550,
+	}
+)
+
+func init() {
+	cli.AppHelpTemplate = utils.KgenHelpTemplate
+	cli.HelpPrinter = utils.NewHelpPrinter(nil)
+}
+
+func main() {
+	app := cli.NewApp()
+	app.Name = "ksigner"
+	app.Usage = "The reference external signer which signs Klaytn transactions on behalf of a node"
+	app.Copyright = "Copyright 2018-2024 The klaytn Authors"
+	app.Action = runSigner
+	app.Flags = []cli.Flag{
+		keystoreFlag,
+		passwordFlag,
+		rulesFlag,
+		auditFlag,
+		ipcPathFlag,
+		httpFlag,
+		httpAddrFlag,
+		httpPortFlag,
+	}
+	app.Commands = []*cli.Command{
+		nodecmd.VersionCommand,
+	}
+	app.HideVersion = true
+	if err := app.Run(os.Args); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+}
+
+func runSigner(ctx *cli.Context) error {
+	keys, err := loadKeys(ctx.String(keystoreFlag.Name), ctx.String(passwordFlag.Name))
+	if err != nil {
+		return err
+	}
+	rules := new(signer.Rules)
+	if path := ctx.String(rulesFlag.Name); path != "" {
+		if rules, err = signer.LoadRules(path); err != nil {
+			return err
+		}
+	}
+	auditFile, err := os.OpenFile(ctx.String(auditFlag.Name), os.O_WRONLY|os.O_CREATE|os.O_APPEND, 0o600)
+	if err != nil {
+		return fmt.Errorf("failed to open the audit log: %v", err)
+	}
+	defer auditFile.Close()
+
+	apis := []rpc.API{{
+		Namespace: "account",
+		Version:   signer.Version,
+		Service:   signer.NewSignerAPI(keys, rules, signer.NewAuditLogger(auditFile)),
+		Public:    true,
+	}}
+
+	ipcListener, _, err := rpc.StartIPCEndpoint(ctx.String(ipcPathFlag.Name), apis, rpc.Limits{})
+	if err != nil {
+		return fmt.Errorf("failed to start the IPC endpoint: %v", err)
+	}
+	defer ipcListener.Close()
+	logger.Info("IPC endpoint opened", "url", ctx.String(ipcPathFlag.Name), "accounts", len(keys))
+
+	if ctx.Bool(httpFlag.Name) {
+		endpoint := net.JoinHostPort(ctx.String(httpAddrFlag.Name), fmt.Sprint(ctx.Int(httpPortFlag.Name)))
+		httpListener, _, err := rpc.StartHTTPEndpoint(endpoint, apis, []string{"account"}, nil, []string{"localhost"}, rpc.DefaultHTTPTimeouts)
+		if err != nil {
+			return fmt.Errorf("failed to start the HTTP endpoint: %v", err)
+		}
+		defer httpListener.Close()
+		logger.Info("HTTP endpoint opened", "url", fmt.Sprintf("http://%s", endpoint))
+	}
+
+	sigc := make(chan os.Signal, 1)
+	signal.Notify(sigc, syscall.SIGINT, syscall.SIGTERM)
+	<-sigc
+	logger.Info("Got interrupt, shutting down...")
+	return nil
+}
+
+// loadKeys decrypts all keystore files in the given directory.
+func loadKeys(dir, passwordFile string) ([]keystore.Key, error) {
+	text, err := ioutil.ReadFile(passwordFile)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read the password file: %v", err)
+	}
+	passwords := strings.Split(string(text), "\n")
+	for i := range passwords {
+		passwords[i] = strings.TrimRight(passwords[i], "\r")
+	}
+	if len(passwords) > 0 && passwords[len(passwords)-1] == "" {
+		passwords = passwords[:len(passwords)-1]
+	}
+	if len(passwords) == 0 {
+		return nil, fmt.Errorf("no password in %s", passwordFile)
+	}
+
+	ks := keystore.NewKeyStore(dir, keystore.StandardScryptN, keystore.StandardScryptP)
+	accs := ks.Accounts()
+	if len(accs) == 0 {
+		return nil, fmt.Errorf("no keystore file in %s", dir)
+	}
+	if len(passwords) != 1 && len(passwords) != len(accs) {
+		return nil, fmt.Errorf("the number of passwords (%d) does not match the number of accounts (%d)", len(passwords), len(accs))
+	}
+
+	keys := make([]keystore.Key, 0, len(accs))
+	for i, acc := range accs {
+		password := passwords[0]
+		if len(passwords) > 1 {
+			password = passwords[i]
+		}
+		keyJSON, err := ioutil.ReadFile(acc.URL.Path)
+		if err != nil {
+			return nil, err
+		}
+		key, err := keystore.DecryptKey(keyJSON, password)
+		if err != nil {
+			return nil, fmt.Errorf("failed to decrypt %s: %v", acc.URL.Path, err)
+		}
+		keys = append(keys, key)
+		logger.Info("Loaded an account", "address", acc.Address)
+	}
+	return keys, nil
+}
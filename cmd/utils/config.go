@@ -58,7 +58,10 @@ import (
 	"github.com/klaytn/klaytn/node/cn"
 	"github.com/klaytn/klaytn/node/cn/filters"
 	"github.com/klaytn/klaytn/node/cn/tracers"
+	"github.com/klaytn/klaytn/node/feerelay"
+	"github.com/klaytn/klaytn/node/graphql"
 	"github.com/klaytn/klaytn/node/sc"
+	"github.com/klaytn/klaytn/node/verifier"
 	"github.com/klaytn/klaytn/params"
 	"github.com/klaytn/klaytn/storage/database"
 	"github.com/klaytn/klaytn/storage/statedb"
@@ -96,6 +99,9 @@ type KlayConfig struct {
 	DB               dbsyncer.DBConfig
 	ChainDataFetcher chaindatafetcher.ChainDataFetcherConfig
 	ServiceChain     sc.SCConfig
+	FeeRelay         feerelay.Config
+	Verifier         verifier.Config
+	GraphQL          graphql.Config
 }
 
 func LoadConfig(file string, cfg *KlayConfig) error {
@@ -131,6 +137,9 @@ func MakeConfigNode(ctx *cli.Context) (*node.Node, KlayConfig) {
 		DB:               *dbsyncer.DefaultDBConfig(),
 		ChainDataFetcher: *chaindatafetcher.DefaultChainDataFetcherConfig(),
 		ServiceChain:     *sc.DefaultServiceChainConfig(),
+		FeeRelay:         *feerelay.DefaultConfig(),
+		Verifier:         *verifier.DefaultConfig(),
+		GraphQL:          *graphql.DefaultConfig(),
 	}
 
 	// NOTE-Klaytn : klaytn loads the flags from yaml, not toml
@@ -152,6 +161,9 @@ func MakeConfigNode(ctx *cli.Context) (*node.Node, KlayConfig) {
 	cfg.SetDBSyncerConfig(ctx)
 	cfg.SetChainDataFetcherConfig(ctx)
 	cfg.SetServiceChainConfig(ctx)
+	cfg.SetFeeRelayConfig(ctx)
+	cfg.SetVerifierConfig(ctx)
+	cfg.SetGraphQLConfig(ctx)
 
 	// SetShhConfig(ctx, stack, &cfg.Shh)
 	// SetDashboardConfig(ctx, &cfg.Dashboard)
@@ -400,6 +412,9 @@ func (kCfg *KlayConfig) SetNodeConfig(ctx *cli.Context) {
 	if ctx.IsSet(LightKDFFlag.Name) {
 		cfg.UseLightweightKDF = ctx.Bool(LightKDFFlag.Name)
 	}
+	if ctx.IsSet(ExternalSignerFlag.Name) {
+		cfg.ExternalSigner = ctx.String(ExternalSignerFlag.Name)
+	}
 	if ctx.IsSet(RPCNonEthCompatibleFlag.Name) {
 		rpc.NonEthCompatible = ctx.Bool(RPCNonEthCompatibleFlag.Name)
 	}
@@ -431,6 +446,28 @@ func setHTTP(ctx *cli.Context, cfg *node.Config) {
 		rpc.ConcurrencyLimit = ctx.Int(RPCConcurrencyLimit.Name)
 		logger.Info("Set the concurrency limit of RPC-HTTP server", "limit", rpc.ConcurrencyLimit)
 	}
+	if ctx.IsSet(RPCCacheSizeFlag.Name) {
+		cfg.RPCCacheSize = ctx.Int(RPCCacheSizeFlag.Name)
+	}
+	if ctx.IsSet(RPCCacheTTLFlag.Name) {
+		cfg.RPCCacheTTL = time.Duration(ctx.Int(RPCCacheTTLFlag.Name)) * time.Second
+	}
+	if ctx.IsSet(RPCRecordDirFlag.Name) {
+		cfg.RPCRecordDir = ctx.String(RPCRecordDirFlag.Name)
+	}
+	if ctx.IsSet(RPCRecordSampleRateFlag.Name) {
+		rate := ctx.Float64(RPCRecordSampleRateFlag.Name)
+		if rate < 0 || rate > 1 {
+			log.Fatalf("%s must be between 0 and 1", RPCRecordSampleRateFlag.Name)
+		}
+		cfg.RPCRecordSampleRate = rate
+	}
+	if ctx.IsSet(RPCRecordFileSizeFlag.Name) {
+		cfg.RPCRecordFileSize = ctx.Int(RPCRecordFileSizeFlag.Name)
+	}
+	if ctx.IsSet(RPCRecordFilesFlag.Name) {
+		cfg.RPCRecordFiles = ctx.Int(RPCRecordFilesFlag.Name)
+	}
 	if ctx.IsSet(RPCReadTimeout.Name) {
 		cfg.HTTPTimeouts.ReadTimeout = time.Duration(ctx.Int(RPCReadTimeout.Name)) * time.Second
 	}
@@ -443,6 +480,7 @@ func setHTTP(ctx *cli.Context, cfg *node.Config) {
 	if ctx.IsSet(RPCExecutionTimeoutFlag.Name) {
 		cfg.HTTPTimeouts.ExecutionTimeout = time.Duration(ctx.Int(RPCExecutionTimeoutFlag.Name)) * time.Second
 	}
+	setRPCLimits(ctx, &cfg.HTTPLimits, RPCBatchLimitFlag, RPCResponseSizeLimitFlag, RPCMethodTimeoutsFlag, RPCMethodConcurrencyFlag)
 }
 
 // setWS creates the WebSocket RPC listener interface string from the set
@@ -468,6 +506,7 @@ func setWS(ctx *cli.Context, cfg *node.Config) {
 	rpc.WebsocketReadDeadline = ctx.Int64(WSReadDeadLine.Name)
 	rpc.WebsocketWriteDeadline = ctx.Int64(WSWriteDeadLine.Name)
 	rpc.MaxWebsocketConnections = int32(ctx.Int(WSMaxConnections.Name))
+	setRPCLimits(ctx, &cfg.WSLimits, WSBatchLimitFlag, WSResponseSizeLimitFlag, WSMethodTimeoutsFlag, WSMethodConcurrencyFlag)
 }
 
 // setIPC creates an IPC path configuration from the set command line flags,
@@ -480,6 +519,71 @@ func setIPC(ctx *cli.Context, cfg *node.Config) {
 	case ctx.IsSet(IPCPathFlag.Name):
 		cfg.IPCPath = ctx.String(IPCPathFlag.Name)
 	}
+	setRPCLimits(ctx, &cfg.IPCLimits, IPCBatchLimitFlag, IPCResponseSizeLimitFlag, IPCMethodTimeoutsFlag, IPCMethodConcurrencyFlag)
+}
+
+// setRPCLimits sets the limits of the calls served by an RPC endpoint from the given flags.
+func setRPCLimits(ctx *cli.Context, limits *rpc.Limits, batchFlag, sizeFlag *cli.IntFlag, timeoutsFlag, concurrencyFlag *cli.StringFlag) {
+	if ctx.IsSet(batchFlag.Name) {
+		limits.BatchItemLimit = ctx.Int(batchFlag.Name)
+	}
+	if ctx.IsSet(sizeFlag.Name) {
+		limits.ResponseSizeLimit = ctx.Int(sizeFlag.Name)
+	}
+	if ctx.IsSet(timeoutsFlag.Name) {
+		timeouts, err := parseMethodTimeouts(ctx.String(timeoutsFlag.Name))
+		if err != nil {
+			log.Fatalf("Option %q: %v", timeoutsFlag.Name, err)
+		}
+		limits.MethodTimeouts = timeouts
+	}
+	if ctx.IsSet(concurrencyFlag.Name) {
+		concurrency, err := parseMethodConcurrency(ctx.String(concurrencyFlag.Name))
+		if err != nil {
+			log.Fatalf("Option %q: %v", concurrencyFlag.Name, err)
+		}
+		limits.MethodConcurrency = concurrency
+	}
+}
+
+// parseMethodTimeouts parses the comma separated list of method=duration.
+func parseMethodTimeouts(s string) (map[string]time.Duration, error) {
+	timeouts := make(map[string]time.Duration)
+	for _, entry := range SplitAndTrim(s) {
+		if entry == "" {
+			continue
+		}
+		method, value, ok := strings.Cut(entry, "=")
+		if !ok {
+			return nil, fmt.Errorf("invalid method timeout %q, want method=duration", entry)
+		}
+		timeout, err := time.ParseDuration(value)
+		if err != nil || timeout <= 0 {
+			return nil, fmt.Errorf("invalid timeout of %s: %q", method, value)
+		}
+		timeouts[method] = timeout
+	}
+	return timeouts, nil
+}
+
+// parseMethodConcurrency parses the comma separated list of method=number.
+func parseMethodConcurrency(s string) (map[string]int, error) {
+	concurrency := make(map[string]int)
+	for _, entry := range SplitAndTrim(s) {
+		if entry == "" {
+			continue
+		}
+		method, value, ok := strings.Cut(entry, "=")
+		if !ok {
+			return nil, fmt.Errorf("invalid method concurrency %q, want method=number", entry)
+		}
+		n, err := strconv.Atoi(value)
+		if err != nil || n <= 0 {
+			return nil, fmt.Errorf("invalid concurrency of %s: %q", method, value)
+		}
+		concurrency[method] = n
+	}
+	return concurrency, nil
 }
 
 // setgRPC creates the gRPC listener interface string from the set
@@ -557,6 +661,8 @@ func (kCfg *KlayConfig) SetKlayConfig(ctx *cli.Context, stack *node.Node) {
 	if !database.IsPow2(cfg.NumStateTrieShards) {
 		log.Fatalf("%v should be power of 2 but %v is not!", NumStateTrieShardsFlag.Name, cfg.NumStateTrieShards)
 	}
+	cfg.ShardMirror = ctx.Bool(StateTrieShardMirrorFlag.Name)
+	cfg.ShardScrubInterval = ctx.Duration(StateTrieShardScrubIntervalFlag.Name)
 
 	cfg.OverwriteGenesis = ctx.Bool(OverwriteGenesisFlag.Name)
 	cfg.StartBlockNumber = ctx.Uint64(StartBlockNumberFlag.Name)
@@ -583,6 +689,10 @@ func (kCfg *KlayConfig) SetKlayConfig(ctx *cli.Context, stack *node.Node) {
 	cfg.RocksDBConfig.DisableMetrics = ctx.Bool(RocksDBDisableMetricsFlag.Name)
 	cfg.RocksDBConfig.CacheIndexAndFilter = ctx.Bool(RocksDBCacheIndexAndFilterFlag.Name)
 
+	cfg.PebbleDBConfig.CacheSize = ctx.Uint64(PebbleDBCacheSizeFlag.Name)
+	cfg.PebbleDBConfig.MaxOpenFiles = ctx.Int(PebbleDBMaxOpenFilesFlag.Name)
+	cfg.PebbleDBConfig.DisableMetrics = ctx.Bool(PebbleDBDisableMetricsFlag.Name)
+
 	cfg.DynamoDBConfig.TableName = ctx.String(DynamoDBTableNameFlag.Name)
 	cfg.DynamoDBConfig.Region = ctx.String(DynamoDBRegionFlag.Name)
 	cfg.DynamoDBConfig.IsProvisioned = ctx.Bool(DynamoDBIsProvisionedFlag.Name)
@@ -907,6 +1017,41 @@ func (kCfg *KlayConfig) SetChainDataFetcherConfig(ctx *cli.Context) {
 	}
 }
 
+func (kCfg *KlayConfig) SetFeeRelayConfig(ctx *cli.Context) {
+	cfg := &kCfg.FeeRelay
+	if ctx.Bool(FeeRelayFlag.Name) {
+		cfg.EnabledFeeRelay = true
+
+		feePayer := ctx.String(FeeRelayFeePayerFlag.Name)
+		if !common.IsHexAddress(feePayer) {
+			log.Fatalf("Invalid fee payer of the fee relay: %q", feePayer)
+		}
+		cfg.FeePayer = common.HexToAddress(feePayer)
+		cfg.PolicyFile = ctx.String(FeeRelayPolicyFlag.Name)
+		cfg.BudgetFile = ctx.String(FeeRelayBudgetFileFlag.Name)
+	}
+}
+
+func (kCfg *KlayConfig) SetVerifierConfig(ctx *cli.Context) {
+	cfg := &kCfg.Verifier
+	if ctx.Bool(VerifierFlag.Name) {
+		cfg.EnabledVerifier = true
+		cfg.Solc = ctx.String(VerifierSolcFlag.Name)
+	}
+}
+
+func (kCfg *KlayConfig) SetGraphQLConfig(ctx *cli.Context) {
+	cfg := &kCfg.GraphQL
+	if ctx.Bool(GraphQLFlag.Name) {
+		if kCfg.Node.HTTPHost == "" {
+			logger.Warn("GraphQL is enabled but the HTTP-RPC server is not. Enable it with --rpc to serve GraphQL queries")
+		}
+		cfg.EnabledGraphQL = true
+		cfg.MaxQueryCost = ctx.Int(GraphQLMaxCostFlag.Name)
+		cfg.MaxQueryDepth = ctx.Int(GraphQLMaxDepthFlag.Name)
+	}
+}
+
 // NOTE-klaytn
 // Deprecated: KASConfig is not used anymore.
 func checkKASDBConfigs(ctx *cli.Context) {
@@ -1067,15 +1212,31 @@ func (kCfg *KlayConfig) SetServiceChainConfig(ctx *cli.Context) {
 	}
 
 	cfg.Anchoring = ctx.Bool(ServiceChainAnchoringFlag.Name)
+	cfg.MerkleAnchoring = ctx.Bool(ServiceChainMerkleAnchoringFlag.Name)
 	cfg.ChildChainIndexing = ctx.Bool(ChildChainIndexingFlag.Name)
 	cfg.AnchoringPeriod = ctx.Uint64(AnchoringPeriodFlag.Name)
 	cfg.SentChainTxsLimit = ctx.Uint64(SentChainTxsLimit.Name)
 	cfg.ParentChainID = ctx.Uint64(ParentChainIDFlag.Name)
 	cfg.VTRecovery = ctx.Bool(VTRecoveryFlag.Name)
 	cfg.VTRecoveryInterval = ctx.Uint64(VTRecoveryIntervalFlag.Name)
+	cfg.VTThresholdSigning = ctx.Bool(VTThresholdSigningFlag.Name)
 	cfg.ServiceChainConsensus = ServiceChainConsensusFlag.Value
 	cfg.ServiceChainParentOperatorGasLimit = ctx.Uint64(ServiceChainParentOperatorTxGasLimitFlag.Name)
 	cfg.ServiceChainChildOperatorGasLimit = ctx.Uint64(ServiceChainChildOperatorTxGasLimitFlag.Name)
+	if ctx.IsSet(ServiceChainParentOperatorFlag.Name) {
+		operator := ctx.String(ServiceChainParentOperatorFlag.Name)
+		if !common.IsHexAddress(operator) {
+			log.Fatalf("Invalid parent operator: %q", operator)
+		}
+		cfg.ServiceChainParentOperator = common.HexToAddress(operator)
+	}
+	if ctx.IsSet(ServiceChainChildOperatorFlag.Name) {
+		operator := ctx.String(ServiceChainChildOperatorFlag.Name)
+		if !common.IsHexAddress(operator) {
+			log.Fatalf("Invalid child operator: %q", operator)
+		}
+		cfg.ServiceChainChildOperator = common.HexToAddress(operator)
+	}
 
 	cfg.KASAnchor = ctx.Bool(KASServiceChainAnchorFlag.Name)
 	if cfg.KASAnchor {
@@ -2,6 +2,7 @@ package utils
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/urfave/cli/v2"
@@ -57,6 +58,8 @@ func TestLoadYaml(t *testing.T) {
 		"lightkdf":                                  true,
 		"db.single":                                 true,
 		"db.num-statetrie-shards":                   true,
+		"db.statetrie-shard-mirror":                 true,
+		"db.statetrie-shard-scrub-interval":         true,
 		"db.leveldb.compression":                    true,
 		"db.leveldb.no-buffer-pool":                 true,
 		"db.no-perf-metrics":                        true,
@@ -75,6 +78,9 @@ func TestLoadYaml(t *testing.T) {
 		"db.rocksdb.bottommost-compression-type":    true,
 		"db.rocksdb.filter-policy":                  true,
 		"db.rocksdb.disable-metrics":                true,
+		"db.pebble.cache-size":                      true,
+		"db.pebble.max-open-files":                  true,
+		"db.pebble.disable-metrics":                 true,
 		"sendertxhashindexing":                      true,
 		"state.cache-size":                          true,
 		"state.block-interval":                      true,
@@ -143,6 +149,8 @@ func TestLoadYaml(t *testing.T) {
 		"grpcaddr":                                  true,
 		"grpcport":                                  true,
 		"rpc.concurrencylimit":                      true,
+		"rpc.cache.size":                            true,
+		"rpc.cache.ttl":                             true,
 		"wsapi":                                     true,
 		"wsorigins":                                 true,
 		"wsmaxsubscriptionperconn":                  true,
@@ -202,6 +210,7 @@ func TestLoadYaml(t *testing.T) {
 		"db.dst.leveldb.cache-size":                 false,
 		"db.dst.leveldb.compression":                false,
 		"db.dst.num-statetrie-shards":               false,
+		"db.dst.statetrie-shard-mirror":             false,
 		"db.dst.dynamo.tablename":                   false,
 		"db.dst.dynamo.region":                      false,
 		"db.dst.dynamo.is-provisioned":              false,
@@ -249,6 +258,7 @@ func TestLoadYaml(t *testing.T) {
 		"parentchainid":                             true,
 		"vtrecovery":                                true,
 		"vtrecoveryinterval":                        true,
+		"vtthresholdsigning":                        true,
 		"scnewaccount":                              true,
 		"anchoring":                                 true,
 		"sc.parentoperator.gaslimit":                true,
@@ -275,3 +285,23 @@ func TestLoadYaml(t *testing.T) {
 		t.Error(err)
 	}
 }
+
+func TestParseMethodLimits(t *testing.T) {
+	timeouts, err := parseMethodTimeouts("klay_call=10s, debug_traceBlockByNumber=1m")
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]time.Duration{"klay_call": 10 * time.Second, "debug_traceBlockByNumber": time.Minute}, timeouts)
+
+	for _, invalid := range []string{"klay_call", "klay_call=10", "klay_call=-1s"} {
+		_, err := parseMethodTimeouts(invalid)
+		assert.Error(t, err, invalid)
+	}
+
+	concurrency, err := parseMethodConcurrency("klay_getLogs=8,")
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]int{"klay_getLogs": 8}, concurrency)
+
+	for _, invalid := range []string{"klay_getLogs", "klay_getLogs=0", "klay_getLogs=x"} {
+		_, err := parseMethodConcurrency(invalid)
+		assert.Error(t, err, invalid)
+	}
+}
@@ -62,6 +62,7 @@ var FlagGroups = []FlagGroup{
 			PasswordFileFlag,
 			LightKDFFlag,
 			KeyStoreDirFlag,
+			ExternalSignerFlag,
 		},
 	},
 	{
@@ -91,6 +92,8 @@ var FlagGroups = []FlagGroup{
 			LevelDBCacheSizeFlag,
 			SingleDBFlag,
 			NumStateTrieShardsFlag,
+			StateTrieShardMirrorFlag,
+			StateTrieShardScrubIntervalFlag,
 			LevelDBCompressionTypeFlag,
 			LevelDBNoBufferPoolFlag,
 			RocksDBSecondaryFlag,
@@ -102,6 +105,9 @@ var FlagGroups = []FlagGroup{
 			RocksDBDisableMetricsFlag,
 			RocksDBMaxOpenFilesFlag,
 			RocksDBCacheIndexAndFilterFlag,
+			PebbleDBCacheSizeFlag,
+			PebbleDBMaxOpenFilesFlag,
+			PebbleDBDisableMetricsFlag,
 			DynamoDBTableNameFlag,
 			DynamoDBRegionFlag,
 			DynamoDBIsProvisionedFlag,
@@ -135,6 +141,30 @@ var FlagGroups = []FlagGroup{
 			MaxBlockDiffFlag,
 		},
 	},
+	{
+		Name: "FEERELAY",
+		Flags: []cli.Flag{
+			FeeRelayFlag,
+			FeeRelayFeePayerFlag,
+			FeeRelayPolicyFlag,
+			FeeRelayBudgetFileFlag,
+		},
+	},
+	{
+		Name: "VERIFIER",
+		Flags: []cli.Flag{
+			VerifierFlag,
+			VerifierSolcFlag,
+		},
+	},
+	{
+		Name: "GRAPHQL",
+		Flags: []cli.Flag{
+			GraphQLFlag,
+			GraphQLMaxCostFlag,
+			GraphQLMaxDepthFlag,
+		},
+	},
 	{
 		Name: "CHAINDATAFETCHER",
 		Flags: []cli.Flag{
@@ -175,6 +205,7 @@ var FlagGroups = []FlagGroup{
 			DstLevelDBCompressionTypeFlag,
 			DstLevelDBCacheSizeFlag,
 			DstNumStateTrieShardsFlag,
+			DstStateTrieShardMirrorFlag,
 			DstDynamoDBTableNameFlag,
 			DstDynamoDBRegionFlag,
 			DstDynamoDBIsProvisionedFlag,
@@ -189,6 +220,9 @@ var FlagGroups = []FlagGroup{
 			DstRocksDBDisableMetricsFlag,
 			DstRocksDBMaxOpenFilesFlag,
 			DstRocksDBCacheIndexAndFilterFlag,
+			DstPebbleDBCacheSizeFlag,
+			DstPebbleDBMaxOpenFilesFlag,
+			DstPebbleDBDisableMetricsFlag,
 		},
 	},
 	{
@@ -279,6 +313,16 @@ var FlagGroups = []FlagGroup{
 			RPCGlobalEVMTimeoutFlag,
 			RPCGlobalEthTxFeeCapFlag,
 			RPCConcurrencyLimit,
+			RPCCacheSizeFlag,
+			RPCCacheTTLFlag,
+			RPCRecordDirFlag,
+			RPCRecordSampleRateFlag,
+			RPCRecordFileSizeFlag,
+			RPCRecordFilesFlag,
+			RPCBatchLimitFlag,
+			RPCResponseSizeLimitFlag,
+			RPCMethodTimeoutsFlag,
+			RPCMethodConcurrencyFlag,
 			RPCNonEthCompatibleFlag,
 			RPCExecutionTimeoutFlag,
 			RPCIdleTimeoutFlag,
@@ -287,12 +331,20 @@ var FlagGroups = []FlagGroup{
 			UnsafeDebugDisableFlag,
 			IPCDisabledFlag,
 			IPCPathFlag,
+			IPCBatchLimitFlag,
+			IPCResponseSizeLimitFlag,
+			IPCMethodTimeoutsFlag,
+			IPCMethodConcurrencyFlag,
 			WSEnabledFlag,
 			WSListenAddrFlag,
 			WSPortFlag,
 			WSApiFlag,
 			WSAllowedOriginsFlag,
 			WSMaxConnections,
+			WSBatchLimitFlag,
+			WSResponseSizeLimitFlag,
+			WSMethodTimeoutsFlag,
+			WSMethodConcurrencyFlag,
 			WSMaxSubscriptionPerConn,
 			WSReadDeadLine,
 			WSWriteDeadLine,
@@ -302,6 +354,7 @@ var FlagGroups = []FlagGroup{
 			JSpathFlag,
 			ExecFlag,
 			PreloadJSFlag,
+			ABIDirFlag,
 			MaxRequestContentLengthFlag,
 			APIFilterGetLogsDeadlineFlag,
 			APIFilterGetLogsMaxItemsFlag,
@@ -324,10 +377,14 @@ var FlagGroups = []FlagGroup{
 			ParentChainIDFlag,
 			VTRecoveryFlag,
 			VTRecoveryIntervalFlag,
+			VTThresholdSigningFlag,
 			ServiceChainAnchoringFlag,
+			ServiceChainMerkleAnchoringFlag,
 			ServiceChainNewAccountFlag,
 			ServiceChainParentOperatorTxGasLimitFlag,
 			ServiceChainChildOperatorTxGasLimitFlag,
+			ServiceChainParentOperatorFlag,
+			ServiceChainChildOperatorFlag,
 			KASServiceChainAnchorFlag,
 			KASServiceChainAnchorPeriodFlag,
 			KASServiceChainAnchorUrlFlag,
@@ -28,6 +28,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/klaytn/klaytn/accounts"
 	"github.com/klaytn/klaytn/blockchain"
 	"github.com/klaytn/klaytn/common"
 	"github.com/klaytn/klaytn/datasync/chaindatafetcher"
@@ -39,7 +40,10 @@ import (
 	"github.com/klaytn/klaytn/node"
 	"github.com/klaytn/klaytn/node/cn"
 	"github.com/klaytn/klaytn/node/cn/filters"
+	"github.com/klaytn/klaytn/node/feerelay"
+	"github.com/klaytn/klaytn/node/graphql"
 	"github.com/klaytn/klaytn/node/sc"
+	"github.com/klaytn/klaytn/node/verifier"
 	"github.com/klaytn/klaytn/params"
 	"github.com/klaytn/klaytn/storage/database"
 	"github.com/klaytn/klaytn/storage/statedb"
@@ -95,7 +99,7 @@ var (
 	}
 	DbTypeFlag = &cli.StringFlag{
 		Name:     "dbtype",
-		Usage:    `Blockchain storage database type ("LevelDB", "BadgerDB", "MemoryDB", "DynamoDBS3")`,
+		Usage:    `Blockchain storage database type ("LevelDB", "BadgerDB", "PebbleDB", "MemoryDB", "DynamoDBS3")`,
 		Value:    "LevelDB",
 		Aliases:  []string{"db.type", "migration.src.dbtype"},
 		EnvVars:  []string{"KLAYTN_DBTYPE"},
@@ -181,6 +185,14 @@ var (
 		EnvVars:  []string{"KLAYTN_LIGHTKDF"},
 		Category: "ACCOUNT",
 	}
+	ExternalSignerFlag = &cli.StringFlag{
+		Name:     "signer",
+		Usage:    "External signer (url or path to ipc file) which signs transactions instead of the keystore",
+		Value:    "",
+		Aliases:  []string{"common.signer"},
+		EnvVars:  []string{"KLAYTN_SIGNER"},
+		Category: "ACCOUNT",
+	}
 	OverwriteGenesisFlag = &cli.BoolFlag{
 		Name:     "overwrite-genesis",
 		Usage:    "Overwrites genesis block with the given new genesis block for testing purpose",
@@ -327,6 +339,26 @@ var (
 		EnvVars:  []string{"KLAYTN_DB_NUM_STATETRIE_SHARDS"},
 		Category: "DATABASE",
 	}
+	StateTrieShardMirrorFlag = &cli.BoolFlag{
+		Name:     "db.statetrie-shard-mirror",
+		Usage:    "Keep a mirror of each state trie DB shard in the directory of the next shard to recover lost or corrupt items. Effective only if the state trie DB is sharded",
+		Aliases:  []string{"migration.src.db.statetrie-shard-mirror"},
+		EnvVars:  []string{"KLAYTN_DB_STATETRIE_SHARD_MIRROR"},
+		Category: "DATABASE",
+	}
+	StateTrieShardScrubIntervalFlag = &cli.DurationFlag{
+		Name:     "db.statetrie-shard-scrub-interval",
+		Usage:    "Interval of verifying and repairing state trie DB shards with their mirrors. Writes to the state trie DB are blocked while a chunk of items is verified (0 = disabled)",
+		Value:    0,
+		Aliases:  []string{},
+		EnvVars:  []string{"KLAYTN_DB_STATETRIE_SHARD_SCRUB_INTERVAL"},
+		Category: "DATABASE",
+	}
+	DBRepairFlag = &cli.BoolFlag{
+		Name:     "repair",
+		Usage:    "Repair missing or corrupt items instead of only reporting them",
+		Category: "DATABASE",
+	}
 	LevelDBCacheSizeFlag = &cli.IntFlag{
 		Name:     "db.leveldb.cache-size",
 		Usage:    "Size of in-memory cache in LevelDB (MiB)",
@@ -419,6 +451,29 @@ var (
 		EnvVars:  []string{"KLAYTN_DB_ROCKSDB_CACHE_INDEX_AND_FILTER"},
 		Category: "DATABASE",
 	}
+	PebbleDBCacheSizeFlag = &cli.Uint64Flag{
+		Name:     "db.pebble.cache-size",
+		Usage:    "Size of in-memory cache in PebbleDB (MiB)",
+		Value:    database.GetDefaultPebbleDBConfig().CacheSize,
+		Aliases:  []string{"migration.src.db.pebble.cache-size"},
+		EnvVars:  []string{"KLAYTN_DB_PEBBLE_CACHE_SIZE"},
+		Category: "DATABASE",
+	}
+	PebbleDBMaxOpenFilesFlag = &cli.IntFlag{
+		Name:     "db.pebble.max-open-files",
+		Usage:    "Set PebbleDB max open files. (the value should be greater than 16)",
+		Value:    database.GetDefaultPebbleDBConfig().MaxOpenFiles,
+		Aliases:  []string{"migration.src.db.pebble.max-open-files"},
+		EnvVars:  []string{"KLAYTN_DB_PEBBLE_MAX_OPEN_FILES"},
+		Category: "DATABASE",
+	}
+	PebbleDBDisableMetricsFlag = &cli.BoolFlag{
+		Name:     "db.pebble.disable-metrics",
+		Usage:    "Disable PebbleDB metrics",
+		Aliases:  []string{"migration.src.db.pebble.disable-metrics"},
+		EnvVars:  []string{"KLAYTN_DB_PEBBLE_DISABLE_METRICS"},
+		Category: "DATABASE",
+	}
 	DynamoDBTableNameFlag = &cli.StringFlag{
 		Name:     "db.dynamo.tablename",
 		Usage:    "Specifies DynamoDB table name. This is mandatory to use dynamoDB. (Set dbtype to use DynamoDBS3)",
@@ -726,6 +781,23 @@ var (
 		EnvVars:  []string{"KLAYTN_PASSWORD"},
 		Category: "ACCOUNT",
 	}
+	MnemonicFlag = &cli.BoolFlag{
+		Name:     "mnemonic",
+		Usage:    "Generate a BIP-39 mnemonic and derive the new account from it",
+		Category: "ACCOUNT",
+	}
+	DerivationPathFlag = &cli.StringFlag{
+		Name:     "path",
+		Usage:    "BIP-32 derivation path of the first account to derive from a mnemonic",
+		Value:    accounts.DefaultBaseDerivationPath.String(),
+		Category: "ACCOUNT",
+	}
+	DerivationCountFlag = &cli.IntFlag{
+		Name:     "count",
+		Usage:    "Number of accounts to derive from a mnemonic by incrementing the last component of the path",
+		Value:    1,
+		Category: "ACCOUNT",
+	}
 
 	VMEnableDebugFlag = &cli.BoolFlag{
 		Name:     "vmdebug",
@@ -851,6 +923,53 @@ var (
 		EnvVars:  []string{"KLAYTN_RPC_CONCURRENCYLIMIT"},
 		Category: "API AND CONSOLE",
 	}
+	RPCCacheSizeFlag = &cli.IntFlag{
+		Name:     "rpc.cache.size",
+		Usage:    "Megabytes of memory allocated to cache the immutable RPC responses such as old blocks and receipts (0 = disabled)",
+		Value:    node.DefaultConfig.RPCCacheSize,
+		Aliases:  []string{"http-rpc.cache-size"},
+		EnvVars:  []string{"KLAYTN_RPC_CACHE_SIZE"},
+		Category: "API AND CONSOLE",
+	}
+	RPCCacheTTLFlag = &cli.IntFlag{
+		Name:     "rpc.cache.ttl",
+		Usage:    "Time to live of the cached RPC responses (seconds, 0 = no expiry)",
+		Value:    int(node.DefaultConfig.RPCCacheTTL / time.Second),
+		Aliases:  []string{"http-rpc.cache-ttl"},
+		EnvVars:  []string{"KLAYTN_RPC_CACHE_TTL"},
+		Category: "API AND CONSOLE",
+	}
+	RPCRecordDirFlag = &cli.PathFlag{
+		Name:     "rpc.record.dir",
+		Usage:    "Directory to write the sampled RPC calls to, which can be replayed by kreplay. The calls of personal namespace and the methods signing with or unlocking accounts are not recorded (empty = disabled)",
+		Aliases:  []string{"http-rpc.record-dir"},
+		EnvVars:  []string{"KLAYTN_RPC_RECORD_DIR"},
+		Category: "API AND CONSOLE",
+	}
+	RPCRecordSampleRateFlag = &cli.Float64Flag{
+		Name:     "rpc.record.samplerate",
+		Usage:    "Fraction of the RPC calls recorded (0 to 1)",
+		Value:    node.DefaultConfig.RPCRecordSampleRate,
+		Aliases:  []string{"http-rpc.record-sample-rate"},
+		EnvVars:  []string{"KLAYTN_RPC_RECORD_SAMPLERATE"},
+		Category: "API AND CONSOLE",
+	}
+	RPCRecordFileSizeFlag = &cli.IntFlag{
+		Name:     "rpc.record.filesize",
+		Usage:    "Size of an RPC record file before it is rotated (MB)",
+		Value:    node.DefaultConfig.RPCRecordFileSize,
+		Aliases:  []string{"http-rpc.record-file-size"},
+		EnvVars:  []string{"KLAYTN_RPC_RECORD_FILESIZE"},
+		Category: "API AND CONSOLE",
+	}
+	RPCRecordFilesFlag = &cli.IntFlag{
+		Name:     "rpc.record.files",
+		Usage:    "Number of the RPC record files kept (0 = unlimited)",
+		Value:    node.DefaultConfig.RPCRecordFiles,
+		Aliases:  []string{"http-rpc.record-files"},
+		EnvVars:  []string{"KLAYTN_RPC_RECORD_FILES"},
+		Category: "API AND CONSOLE",
+	}
 	RPCNonEthCompatibleFlag = &cli.BoolFlag{
 		Name:     "rpc.eth.noncompatible",
 		Usage:    "Disables the eth namespace API return formatting for compatibility",
@@ -891,6 +1010,91 @@ var (
 		Category: "API AND CONSOLE",
 	}
 
+	RPCBatchLimitFlag = &cli.IntFlag{
+		Name:     "rpc.batchlimit",
+		Usage:    "Maximum number of the calls in a batch request to the HTTP-RPC server (0 = unlimited)",
+		Aliases:  []string{"http-rpc.batch-limit"},
+		EnvVars:  []string{"KLAYTN_RPC_BATCHLIMIT"},
+		Category: "API AND CONSOLE",
+	}
+	RPCResponseSizeLimitFlag = &cli.IntFlag{
+		Name:     "rpc.responsesizelimit",
+		Usage:    "Maximum size of a response of the HTTP-RPC server in bytes, the remaining calls of a batch are aborted once exceeded (0 = unlimited)",
+		Aliases:  []string{"http-rpc.response-size-limit"},
+		EnvVars:  []string{"KLAYTN_RPC_RESPONSESIZELIMIT"},
+		Category: "API AND CONSOLE",
+	}
+	RPCMethodTimeoutsFlag = &cli.StringFlag{
+		Name:     "rpc.methodtimeouts",
+		Usage:    "Comma separated execution timeouts of the methods served by the HTTP-RPC server, overriding rpc.evmtimeout (e.g. klay_call=10s,debug_traceBlockByNumber=1m)",
+		Aliases:  []string{"http-rpc.method-timeouts"},
+		EnvVars:  []string{"KLAYTN_RPC_METHODTIMEOUTS"},
+		Category: "API AND CONSOLE",
+	}
+	RPCMethodConcurrencyFlag = &cli.StringFlag{
+		Name:     "rpc.methodconcurrency",
+		Usage:    "Comma separated maximum numbers of the concurrent calls of the methods served by the HTTP-RPC server (e.g. klay_getLogs=8,debug_traceBlockByNumber=2)",
+		Aliases:  []string{"http-rpc.method-concurrency"},
+		EnvVars:  []string{"KLAYTN_RPC_METHODCONCURRENCY"},
+		Category: "API AND CONSOLE",
+	}
+	WSBatchLimitFlag = &cli.IntFlag{
+		Name:     "ws.batchlimit",
+		Usage:    "Maximum number of the calls in a batch request to the WS-RPC server (0 = unlimited)",
+		Aliases:  []string{"ws-rpc.batch-limit"},
+		EnvVars:  []string{"KLAYTN_WS_BATCHLIMIT"},
+		Category: "API AND CONSOLE",
+	}
+	WSResponseSizeLimitFlag = &cli.IntFlag{
+		Name:     "ws.responsesizelimit",
+		Usage:    "Maximum size of a response of the WS-RPC server in bytes, the remaining calls of a batch are aborted once exceeded (0 = unlimited)",
+		Aliases:  []string{"ws-rpc.response-size-limit"},
+		EnvVars:  []string{"KLAYTN_WS_RESPONSESIZELIMIT"},
+		Category: "API AND CONSOLE",
+	}
+	WSMethodTimeoutsFlag = &cli.StringFlag{
+		Name:     "ws.methodtimeouts",
+		Usage:    "Comma separated execution timeouts of the methods served by the WS-RPC server, overriding rpc.evmtimeout (e.g. klay_call=10s,debug_traceBlockByNumber=1m)",
+		Aliases:  []string{"ws-rpc.method-timeouts"},
+		EnvVars:  []string{"KLAYTN_WS_METHODTIMEOUTS"},
+		Category: "API AND CONSOLE",
+	}
+	WSMethodConcurrencyFlag = &cli.StringFlag{
+		Name:     "ws.methodconcurrency",
+		Usage:    "Comma separated maximum numbers of the concurrent calls of the methods served by the WS-RPC server (e.g. klay_getLogs=8,debug_traceBlockByNumber=2)",
+		Aliases:  []string{"ws-rpc.method-concurrency"},
+		EnvVars:  []string{"KLAYTN_WS_METHODCONCURRENCY"},
+		Category: "API AND CONSOLE",
+	}
+	IPCBatchLimitFlag = &cli.IntFlag{
+		Name:     "ipc.batchlimit",
+		Usage:    "Maximum number of the calls in a batch request to the IPC-RPC server (0 = unlimited)",
+		Aliases:  []string{"ipc.batch-limit"},
+		EnvVars:  []string{"KLAYTN_IPC_BATCHLIMIT"},
+		Category: "API AND CONSOLE",
+	}
+	IPCResponseSizeLimitFlag = &cli.IntFlag{
+		Name:     "ipc.responsesizelimit",
+		Usage:    "Maximum size of a response of the IPC-RPC server in bytes, the remaining calls of a batch are aborted once exceeded (0 = unlimited)",
+		Aliases:  []string{"ipc.response-size-limit"},
+		EnvVars:  []string{"KLAYTN_IPC_RESPONSESIZELIMIT"},
+		Category: "API AND CONSOLE",
+	}
+	IPCMethodTimeoutsFlag = &cli.StringFlag{
+		Name:     "ipc.methodtimeouts",
+		Usage:    "Comma separated execution timeouts of the methods served by the IPC-RPC server, overriding rpc.evmtimeout (e.g. klay_call=10s,debug_traceBlockByNumber=1m)",
+		Aliases:  []string{"ipc.method-timeouts"},
+		EnvVars:  []string{"KLAYTN_IPC_METHODTIMEOUTS"},
+		Category: "API AND CONSOLE",
+	}
+	IPCMethodConcurrencyFlag = &cli.StringFlag{
+		Name:     "ipc.methodconcurrency",
+		Usage:    "Comma separated maximum numbers of the concurrent calls of the methods served by the IPC-RPC server (e.g. klay_getLogs=8,debug_traceBlockByNumber=2)",
+		Aliases:  []string{"ipc.method-concurrency"},
+		EnvVars:  []string{"KLAYTN_IPC_METHODCONCURRENCY"},
+		Category: "API AND CONSOLE",
+	}
+
 	WSEnabledFlag = &cli.BoolFlag{
 		Name:     "ws",
 		Usage:    "Enable the WS-RPC server",
@@ -1023,6 +1227,23 @@ var (
 		EnvVars:  []string{"KLAYTN_PRELOAD"},
 		Category: "API AND CONSOLE",
 	}
+	ABIDirFlag = &cli.StringFlag{
+		Name:     "abidir",
+		Usage:    "Directory of contract ABI JSON files loadable by name in the console (default = inside the datadir)",
+		Aliases:  []string{"console.abi-dir"},
+		EnvVars:  []string{"KLAYTN_ABIDIR"},
+		Category: "API AND CONSOLE",
+	}
+	OpenRPCOutputFlag = &cli.PathFlag{
+		Name:     "output",
+		Usage:    "File to write the OpenRPC document to (default = stdout)",
+		Category: "API AND CONSOLE",
+	}
+	OpenRPCCheckFlag = &cli.PathFlag{
+		Name:     "check",
+		Usage:    "OpenRPC document to compare with the one served by the node, failing if the methods differ",
+		Category: "API AND CONSOLE",
+	}
 	APIFilterGetLogsDeadlineFlag = &cli.DurationFlag{
 		Name:     "api.filter.getLogs.deadline",
 		Usage:    "Execution deadline for log collecting filter APIs",
@@ -1316,6 +1537,13 @@ var (
 		EnvVars:  []string{"KLAYTN_VTRECOVERYINTERVAL"},
 		Category: "SERVICECHAIN",
 	}
+	VTThresholdSigningFlag = &cli.BoolFlag{
+		Name:     "vtthresholdsigning",
+		Usage:    "Handle value transfers with a threshold of operator signatures in a single transaction instead of operator votes (default: false)",
+		Aliases:  []string{"servicechain.vt-threshold-signing"},
+		EnvVars:  []string{"KLAYTN_VTTHRESHOLDSIGNING"},
+		Category: "SERVICECHAIN",
+	}
 	ServiceChainParentOperatorTxGasLimitFlag = &cli.Uint64Flag{
 		Name:     "sc.parentoperator.gaslimit",
 		Usage:    "Set the default value of gas limit for transactions made by bridge parent operator",
@@ -1332,6 +1560,20 @@ var (
 		EnvVars:  []string{"KLAYTN_SC_CHILDOPERATOR_GASLIMIT"},
 		Category: "SERVICECHAIN",
 	}
+	ServiceChainParentOperatorFlag = &cli.StringFlag{
+		Name:     "sc.parentoperator",
+		Usage:    "Address of the parent operator account in the external signer used instead of the bridge account key",
+		Aliases:  []string{"servicechain.parent-operator"},
+		EnvVars:  []string{"KLAYTN_SC_PARENTOPERATOR"},
+		Category: "SERVICECHAIN",
+	}
+	ServiceChainChildOperatorFlag = &cli.StringFlag{
+		Name:     "sc.childoperator",
+		Usage:    "Address of the child operator account in the external signer used instead of the bridge account key",
+		Aliases:  []string{"servicechain.child-operator"},
+		EnvVars:  []string{"KLAYTN_SC_CHILDOPERATOR"},
+		Category: "SERVICECHAIN",
+	}
 	ServiceChainNewAccountFlag = &cli.BoolFlag{
 		Name:     "scnewaccount",
 		Usage:    "Enable account creation for the service chain (default: false). If set true, generated account can't be synced with the parent chain.",
@@ -1346,6 +1588,13 @@ var (
 		EnvVars:  []string{"KLAYTN_ANCHORING"},
 		Category: "SERVICECHAIN",
 	}
+	ServiceChainMerkleAnchoringFlag = &cli.BoolFlag{
+		Name:     "anchoring.merkle",
+		Usage:    "Anchor the Merkle root of the block hashes in each anchoring period instead of the last block, and keep the inclusion proofs",
+		Aliases:  []string{"servicechain.anchoring-merkle"},
+		EnvVars:  []string{"KLAYTN_ANCHORING_MERKLE"},
+		Category: "SERVICECHAIN",
+	}
 	// TODO-klaytn: need to check if deprecated.
 	ServiceChainConsensusFlag = &cli.StringFlag{
 		Name:    "scconsensus",
@@ -1415,6 +1664,78 @@ var (
 		Category: "SERVICECHAIN",
 	}
 
+	// FeeRelay
+	FeeRelayFlag = &cli.BoolFlag{
+		Name:     "feerelay",
+		Usage:    "Enable the fee-delegation relay service",
+		Aliases:  []string{"fee-relay.enable"},
+		EnvVars:  []string{"KLAYTN_FEERELAY"},
+		Category: "FEERELAY",
+	}
+	FeeRelayFeePayerFlag = &cli.StringFlag{
+		Name:     "feerelay.feepayer",
+		Usage:    "Address of the unlocked account which pays the fee of the relayed transactions",
+		Aliases:  []string{"fee-relay.fee-payer"},
+		EnvVars:  []string{"KLAYTN_FEERELAY_FEEPAYER"},
+		Category: "FEERELAY",
+	}
+	FeeRelayPolicyFlag = &cli.StringFlag{
+		Name:     "feerelay.policy",
+		Usage:    "Policy file (JSON) of the fee-delegation relay, which should have allowed contracts or a daily budget",
+		Aliases:  []string{"fee-relay.policy"},
+		EnvVars:  []string{"KLAYTN_FEERELAY_POLICY"},
+		Category: "FEERELAY",
+	}
+	FeeRelayBudgetFileFlag = &cli.StringFlag{
+		Name:     "feerelay.budgetfile",
+		Usage:    "File persisting the spent daily budgets of the senders (relative to the data directory)",
+		Value:    feerelay.DefaultBudgetFile,
+		Aliases:  []string{"fee-relay.budget-file"},
+		EnvVars:  []string{"KLAYTN_FEERELAY_BUDGETFILE"},
+		Category: "FEERELAY",
+	}
+
+	// Contract verifier
+	VerifierFlag = &cli.BoolFlag{
+		Name:     "verifier",
+		Usage:    "Enable the contract verification service (verifier namespace)",
+		Aliases:  []string{"verifier.enable"},
+		EnvVars:  []string{"KLAYTN_VERIFIER"},
+		Category: "VERIFIER",
+	}
+	VerifierSolcFlag = &cli.StringFlag{
+		Name:     "verifier.solc",
+		Usage:    "Path of the solc binary used by the contract verifier (default: solc in PATH)",
+		Aliases:  []string{"verifier.solc-path"},
+		EnvVars:  []string{"KLAYTN_VERIFIER_SOLC"},
+		Category: "VERIFIER",
+	}
+
+	// GraphQL
+	GraphQLFlag = &cli.BoolFlag{
+		Name:     "graphql",
+		Usage:    "Enable the GraphQL endpoint at /graphql of the HTTP-RPC server",
+		Aliases:  []string{"graphql.enable"},
+		EnvVars:  []string{"KLAYTN_GRAPHQL"},
+		Category: "GRAPHQL",
+	}
+	GraphQLMaxCostFlag = &cli.IntFlag{
+		Name:     "graphql.maxcost",
+		Usage:    "Maximum cost of a GraphQL query, estimated with the number of the fields to be resolved",
+		Value:    graphql.DefaultConfig().MaxQueryCost,
+		Aliases:  []string{"graphql.max-cost"},
+		EnvVars:  []string{"KLAYTN_GRAPHQL_MAXCOST"},
+		Category: "GRAPHQL",
+	}
+	GraphQLMaxDepthFlag = &cli.IntFlag{
+		Name:     "graphql.maxdepth",
+		Usage:    "Maximum depth of the selections of a GraphQL query",
+		Value:    graphql.DefaultConfig().MaxQueryDepth,
+		Aliases:  []string{"graphql.max-depth"},
+		EnvVars:  []string{"KLAYTN_GRAPHQL_MAXDEPTH"},
+		Category: "GRAPHQL",
+	}
+
 	// ChainDataFetcher
 	EnableChainDataFetcherFlag = &cli.BoolFlag{
 		Name:     "chaindatafetcher",
@@ -1771,7 +2092,7 @@ var (
 	// db migration vars
 	DstDbTypeFlag = &cli.StringFlag{
 		Name:     "dst.dbtype",
-		Usage:    `Blockchain storage database type ("LevelDB", "BadgerDB", "DynamoDBS3")`,
+		Usage:    `Blockchain storage database type ("LevelDB", "BadgerDB", "PebbleDB", "DynamoDBS3")`,
 		Value:    "LevelDB",
 		Aliases:  []string{"migration.dst.dbtype"},
 		EnvVars:  []string{"KLAYTN_DST_DBTYPE"},
@@ -1815,6 +2136,13 @@ var (
 		EnvVars:  []string{"KLAYTN_DB_DST_NUM_STATETRIE_SHARDS"},
 		Category: "DATABASE MIGRATION",
 	}
+	DstStateTrieShardMirrorFlag = &cli.BoolFlag{
+		Name:     "db.dst.statetrie-shard-mirror",
+		Usage:    "Keep a mirror of each state trie DB shard in the directory of the next shard to recover lost or corrupt items. Effective only if the state trie DB is sharded",
+		Aliases:  []string{"migration.dst.db.statetrie-shard-mirror"},
+		EnvVars:  []string{"KLAYTN_DB_DST_STATETRIE_SHARD_MIRROR"},
+		Category: "DATABASE MIGRATION",
+	}
 	DstDynamoDBTableNameFlag = &cli.StringFlag{
 		Name:     "db.dst.dynamo.tablename",
 		Usage:    "Specifies DynamoDB table name. This is mandatory to use dynamoDB. (Set dbtype to use DynamoDBS3). If dstDB is singleDB, tableName should be in form of 'PREFIX-TABLENAME'.(e.g. 'klaytn-misc', 'klaytn-statetrie')",
@@ -1921,6 +2249,29 @@ var (
 		EnvVars:  []string{"KLAYTN_DB_DST_ROCKSDB_CACHE_INDEX_AND_FILTER"},
 		Category: "DATABASE MIGRATION",
 	}
+	DstPebbleDBCacheSizeFlag = &cli.Uint64Flag{
+		Name:     "db.dst.pebble.cache-size",
+		Usage:    "Size of in-memory cache in PebbleDB (MiB)",
+		Value:    database.GetDefaultPebbleDBConfig().CacheSize,
+		Aliases:  []string{"migration.dst.db.pebble.cache-size"},
+		EnvVars:  []string{"KLAYTN_DB_DST_PEBBLE_CACHE_SIZE"},
+		Category: "DATABASE MIGRATION",
+	}
+	DstPebbleDBMaxOpenFilesFlag = &cli.IntFlag{
+		Name:     "db.dst.pebble.max-open-files",
+		Usage:    "Set PebbleDB max open files. (the value should be greater than 16)",
+		Value:    database.GetDefaultPebbleDBConfig().MaxOpenFiles,
+		Aliases:  []string{"migration.dst.db.pebble.max-open-files"},
+		EnvVars:  []string{"KLAYTN_DB_DST_PEBBLE_MAX_OPEN_FILES"},
+		Category: "DATABASE MIGRATION",
+	}
+	DstPebbleDBDisableMetricsFlag = &cli.BoolFlag{
+		Name:     "db.dst.pebble.disable-metrics",
+		Usage:    "Disable PebbleDB metrics",
+		Aliases:  []string{"migration.dst.db.pebble.disable-metrics"},
+		EnvVars:  []string{"KLAYTN_DB_DST_PEBBLE_DISABLE_METRICS"},
+		Category: "DATABASE MIGRATION",
+	}
 
 	// Config
 	ConfigFileFlag = &cli.StringFlag{
@@ -2041,6 +2392,42 @@ func RegisterService(stack *node.Node, cfg *sc.SCConfig) {
 	}
 }
 
+// RegisterFeeRelayService adds a fee-delegation relay to the stack
+func RegisterFeeRelayService(stack *node.Node, cfg *feerelay.Config) {
+	if cfg.EnabledFeeRelay {
+		err := stack.RegisterSubService(func(ctx *node.ServiceContext) (node.Service, error) {
+			return feerelay.NewFeeRelay(ctx, cfg)
+		})
+		if err != nil {
+			log.Fatalf("Failed to register the fee relay service: %v", err)
+		}
+	}
+}
+
+// RegisterVerifierService adds a contract verifier to the stack
+func RegisterVerifierService(stack *node.Node, cfg *verifier.Config) {
+	if cfg.EnabledVerifier {
+		err := stack.RegisterSubService(func(ctx *node.ServiceContext) (node.Service, error) {
+			return verifier.NewVerifier(ctx, cfg)
+		})
+		if err != nil {
+			log.Fatalf("Failed to register the contract verifier service: %v", err)
+		}
+	}
+}
+
+// RegisterGraphQLService adds a GraphQL service to the stack
+func RegisterGraphQLService(stack *node.Node, cfg *graphql.Config) {
+	if cfg.EnabledGraphQL {
+		err := stack.RegisterSubService(func(ctx *node.ServiceContext) (node.Service, error) {
+			return graphql.NewGraphQL(ctx, cfg)
+		})
+		if err != nil {
+			log.Fatalf("Failed to register the GraphQL service: %v", err)
+		}
+	}
+}
+
 // RegisterChainDataFetcherService adds a ChainDataFetcher to the stack
 func RegisterChainDataFetcherService(stack *node.Node, cfg *chaindatafetcher.ChainDataFetcherConfig) {
 	if cfg.EnabledChainDataFetcher {
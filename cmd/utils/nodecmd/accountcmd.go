@@ -26,6 +26,7 @@ import (
 	"errors"
 	"fmt"
 	"os"
+	"strings"
 
 	"github.com/klaytn/klaytn/accounts"
 	"github.com/klaytn/klaytn/accounts/keystore"
@@ -82,6 +83,7 @@ Print a short summary of all accounts`,
 				utils.KeyStoreDirFlag,
 				utils.PasswordFileFlag,
 				utils.LightKDFFlag,
+				utils.MnemonicFlag,
 			},
 			Description: `
 Creates a new account and prints the address.
@@ -94,6 +96,11 @@ For non-interactive use the passphrase can be specified with the --password flag
 
 Note, this is meant to be used for testing only, it is a bad idea to save your
 password to file or expose in any other way.
+
+With the --mnemonic flag, a 24-word BIP-39 mnemonic is generated and printed,
+and the account is derived from it at m/44'/8217'/0'/0/0. The account can be
+recovered from the mnemonic with the import-mnemonic command, so keep the
+mnemonic in a safe place.
 `,
 		},
 		{
@@ -147,6 +154,40 @@ For non-interactive use the passphrase can be specified with the --password flag
 Note, as you can directly copy your encrypted accounts to another klay instance,
 this import mechanism is not needed when you transfer an account between
 nodes.
+`,
+		},
+		{
+			Name:   "import-mnemonic",
+			Usage:  "Import accounts derived from a BIP-39 mnemonic",
+			Action: accountImportMnemonic,
+			Flags: []cli.Flag{
+				utils.DataDirFlag,
+				utils.KeyStoreDirFlag,
+				utils.PasswordFileFlag,
+				utils.LightKDFFlag,
+				utils.DerivationPathFlag,
+				utils.DerivationCountFlag,
+			},
+			ArgsUsage: "[<mnemonicFile>]",
+			Description: `
+Derives accounts from a BIP-39 mnemonic and imports them into the keystore.
+Prints the addresses.
+
+The mnemonic is read from <mnemonicFile> if given, otherwise you are prompted for it.
+
+The first account is derived at --path and the following accounts are derived by
+incrementing the last component of the path, e.g., --path "m/44'/8217'/0'/0/0" --count 3
+derives m/44'/8217'/0'/0/0, m/44'/8217'/0'/0/1 and m/44'/8217'/0'/0/2.
+No account is imported if any of the derived accounts already exists.
+
+All accounts are saved in encrypted format with the same passphrase.
+
+For non-interactive use the passphrase can be specified with the --password flag.
+
+EXAMPLES
+
+# Recover 10 operator accounts from a backup mnemonic
+kcn account import-mnemonic --path "m/44'/8217'/0'/0/0" --count 10 mnemonic.txt
 `,
 		},
 		{
@@ -357,6 +398,23 @@ func accountCreate(ctx *cli.Context) error {
 
 	password := getPassPhrase("Your new account is locked with a password. Please give a password. Do not forget this password.", true, 0, utils.MakePasswordList(ctx))
 
+	if ctx.Bool(utils.MnemonicFlag.Name) {
+		mnemonic, err := accounts.NewMnemonic()
+		if err != nil {
+			log.Fatalf("Failed to generate mnemonic: %v", err)
+		}
+		ks := keystore.NewKeyStore(keydir, scryptN, scryptP)
+		accs, err := ks.ImportMnemonic(mnemonic, accounts.DefaultBaseDerivationPath, 1, password)
+		if err != nil {
+			log.Fatalf("Failed to create account: %v", err)
+		}
+		fmt.Printf("Address: {%x}\n", accs[0].Address)
+		fmt.Printf("Path: %s\n", accounts.DefaultBaseDerivationPath)
+		fmt.Printf("Mnemonic: %s\n", mnemonic)
+		fmt.Println("Write down the mnemonic and keep it in a safe place. Anyone who has it can recover the account.")
+		return nil
+	}
+
 	address, err := keystore.StoreKey(keydir, password, scryptN, scryptP)
 	if err != nil {
 		log.Fatalf("Failed to create account: %v", err)
@@ -414,6 +472,50 @@ func accountImport(ctx *cli.Context) error {
 	return nil
 }
 
+// accountImportMnemonic derives accounts from a mnemonic and imports them into the keystore.
+func accountImportMnemonic(ctx *cli.Context) error {
+	if glogger, err := debug.GetGlogger(); err == nil {
+		log.ChangeGlobalLogLevel(glogger, log.Lvl(log.LvlError))
+	}
+	path, err := accounts.ParseDerivationPath(ctx.String(utils.DerivationPathFlag.Name))
+	if err != nil {
+		log.Fatalf("Invalid derivation path: %v", err)
+	}
+	count := ctx.Int(utils.DerivationCountFlag.Name)
+	if count <= 0 {
+		log.Fatalf("--%s must be positive", utils.DerivationCountFlag.Name)
+	}
+
+	var mnemonic string
+	if file := ctx.Args().First(); len(file) > 0 {
+		content, err := os.ReadFile(file)
+		if err != nil {
+			log.Fatalf("Failed to read the mnemonic: %v", err)
+		}
+		mnemonic = string(content)
+	} else {
+		if mnemonic, err = console.Stdin.PromptPassword("Mnemonic: "); err != nil {
+			log.Fatalf("Failed to read the mnemonic: %v", err)
+		}
+	}
+	mnemonic = strings.Join(strings.Fields(mnemonic), " ")
+
+	stack, _ := utils.MakeConfigNode(ctx)
+	passphrase := getPassPhrase("Your new accounts are locked with a password. Please give a password. Do not forget this password.", true, 0, utils.MakePasswordList(ctx))
+
+	ks := stack.AccountManager().Backends(keystore.KeyStoreType)[0].(*keystore.KeyStore)
+	accs, err := ks.ImportMnemonic(mnemonic, path, count, passphrase)
+	if err != nil {
+		log.Fatalf("Could not import the accounts: %v", err)
+	}
+	for i, acc := range accs {
+		derived := append(accounts.DerivationPath{}, path...)
+		derived[len(derived)-1] += uint32(i)
+		fmt.Printf("Address: {%x} Path: %s\n", acc.Address, derived)
+	}
+	return nil
+}
+
 func loadBlsNodeKeystore(ctx *cli.Context) (bls.SecretKey, error) {
 	if !ctx.IsSet(utils.BlsNodeKeystoreFileFlag.Name) {
 		return nil, errors.New("No BLS key input specified")
@@ -49,6 +49,7 @@ var (
 			utils.DbTypeFlag,
 			utils.SingleDBFlag,
 			utils.NumStateTrieShardsFlag,
+			utils.StateTrieShardMirrorFlag,
 			utils.DynamoDBTableNameFlag,
 			utils.DynamoDBRegionFlag,
 			utils.DynamoDBIsProvisionedFlag,
@@ -67,6 +68,9 @@ var (
 			utils.RocksDBDisableMetricsFlag,
 			utils.RocksDBMaxOpenFilesFlag,
 			utils.RocksDBCacheIndexAndFilterFlag,
+			utils.PebbleDBCacheSizeFlag,
+			utils.PebbleDBMaxOpenFilesFlag,
+			utils.PebbleDBDisableMetricsFlag,
 			utils.OverwriteGenesisFlag,
 			utils.LivePruningFlag,
 		},
@@ -142,6 +146,7 @@ func initGenesis(ctx *cli.Context) error {
 	parallelDBWrite := !ctx.Bool(utils.NoParallelDBWriteFlag.Name)
 	singleDB := ctx.Bool(utils.SingleDBFlag.Name)
 	numStateTrieShards := ctx.Uint(utils.NumStateTrieShardsFlag.Name)
+	shardMirror := ctx.Bool(utils.StateTrieShardMirrorFlag.Name)
 	overwriteGenesis := ctx.Bool(utils.OverwriteGenesisFlag.Name)
 	livePruning := ctx.Bool(utils.LivePruningFlag.Name)
 
@@ -175,12 +180,21 @@ func initGenesis(ctx *cli.Context) error {
 			CacheIndexAndFilter:       ctx.Bool(utils.RocksDBCacheIndexAndFilterFlag.Name),
 		}
 	}
+	pebbleDBConfig := database.GetDefaultPebbleDBConfig()
+	if dbtype == database.PebbleDB {
+		pebbleDBConfig = &database.PebbleDBConfig{
+			CacheSize:      ctx.Uint64(utils.PebbleDBCacheSizeFlag.Name),
+			MaxOpenFiles:   ctx.Int(utils.PebbleDBMaxOpenFilesFlag.Name),
+			DisableMetrics: ctx.Bool(utils.PebbleDBDisableMetricsFlag.Name),
+		}
+	}
 
 	for _, name := range []string{"chaindata"} { // Removed "lightchaindata" since Klaytn doesn't use it
 		dbc := &database.DBConfig{
 			Dir: name, DBType: dbtype, ParallelDBWrite: parallelDBWrite,
-			SingleDB: singleDB, NumStateTrieShards: numStateTrieShards,
+			SingleDB: singleDB, NumStateTrieShards: numStateTrieShards, ShardMirror: shardMirror,
 			LevelDBCacheSize: 0, OpenFilesLimit: 0, DynamoDBConfig: dynamoDBConfig, RocksDBConfig: rocksDBConfig,
+			PebbleDBConfig: pebbleDBConfig,
 		}
 		chainDB := stack.OpenDatabase(dbc)
 
@@ -80,6 +80,7 @@ func localConsole(ctx *cli.Context) error {
 		DocRoot: ctx.String(utils.JSpathFlag.Name),
 		Client:  client,
 		Preload: utils.MakeConsolePreloads(ctx),
+		ABIDir:  ctx.String(utils.ABIDirFlag.Name),
 	}
 
 	console, err := console.New(config)
@@ -104,20 +105,7 @@ func localConsole(ctx *cli.Context) error {
 // console to it.
 func remoteConsole(ctx *cli.Context) error {
 	// Attach to a remotely running node instance and start the JavaScript console
-	endpoint := ctx.Args().First()
-	if endpoint == "" {
-		path := node.DefaultDataDir()
-		if ctx.IsSet(utils.DataDirFlag.Name) {
-			path = ctx.String(utils.DataDirFlag.Name)
-		}
-		if path != "" {
-			if ctx.Bool(utils.BaobabFlag.Name) {
-				path = filepath.Join(path, "baobab")
-			}
-		}
-		endpoint = fmt.Sprintf("%s/klay.ipc", path)
-	}
-	client, err := dialRPC(endpoint)
+	client, err := dialRPC(remoteEndpoint(ctx))
 	if err != nil {
 		log.Fatalf("Unable to attach to remote node: %v", err)
 	}
@@ -126,6 +114,7 @@ func remoteConsole(ctx *cli.Context) error {
 		DocRoot: ctx.String(utils.JSpathFlag.Name),
 		Client:  client,
 		Preload: utils.MakeConsolePreloads(ctx),
+		ABIDir:  ctx.String(utils.ABIDirFlag.Name),
 	}
 
 	console, err := console.New(config)
@@ -146,6 +135,25 @@ func remoteConsole(ctx *cli.Context) error {
 	return nil
 }
 
+// remoteEndpoint returns the endpoint given as the first argument, or the IPC endpoint
+// in the data directory if it is not given.
+func remoteEndpoint(ctx *cli.Context) string {
+	endpoint := ctx.Args().First()
+	if endpoint == "" {
+		path := node.DefaultDataDir()
+		if ctx.IsSet(utils.DataDirFlag.Name) {
+			path = ctx.String(utils.DataDirFlag.Name)
+		}
+		if path != "" {
+			if ctx.Bool(utils.BaobabFlag.Name) {
+				path = filepath.Join(path, "baobab")
+			}
+		}
+		endpoint = fmt.Sprintf("%s/klay.ipc", path)
+	}
+	return endpoint
+}
+
 // dialRPC returns a RPC client which connects to the given endpoint.
 // The check for empty endpoint implements the defaulting logic
 // for "ken attach" and "ken monitor" with no argument.
@@ -0,0 +1,366 @@
+// Copyright 2024 The klaytn Authors
+// This file is part of the klaytn library.
+//
+// The klaytn library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The klaytn library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the klaytn library. If not, see <http://www.gnu.org/licenses/>.
+
+package nodecmd
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/klaytn/klaytn/cmd/utils"
+	"github.com/klaytn/klaytn/common"
+	"github.com/klaytn/klaytn/common/hexutil"
+	"github.com/klaytn/klaytn/storage/database"
+	"github.com/urfave/cli/v2"
+)
+
+var (
+	errInvalidArgCount = errors.New("invalid number of arguments")
+	errKeyNotFound     = errors.New("key not found")
+	errChainIssues     = errors.New("the canonical chain index is inconsistent")
+	errShardIssues     = errors.New("the state trie shards are inconsistent with their mirrors")
+)
+
+var DBCommand = &cli.Command{
+	Name:     "db",
+	Usage:    "Low level database operations on a stopped node",
+	Category: "DB COMMANDS",
+	Description: `
+The db commands open the databases of a stopped node to inspect or repair them.
+Except put, delete and verify-shards with --repair, the commands open the
+databases read-only.
+The database names are the directory names of the database entries:
+misc, header, body, receipts, statetrie, statetrie_migrated, txlookup,
+bridgeservice and snapshot.`,
+	Subcommands: []*cli.Command{
+		{
+			Name:   "stats",
+			Usage:  "Print the key count and size of each database and key category",
+			Action: utils.MigrateFlags(dbStats),
+			Flags:  utils.SnapshotFlags,
+			Description: `
+This command iterates all the keys of the databases and groups them by the
+database and the key prefixes defined in the schema.`,
+		},
+		{
+			Name:   "inspect",
+			Usage:  "Check the canonical chain index for dangling hashes and missing bodies",
+			Action: utils.MigrateFlags(dbInspect),
+			Flags:  utils.SnapshotFlags,
+			Description: `
+This command walks the canonical chain index from the genesis to the head header
+and reports the canonical hashes without a header, the canonical blocks without
+a body and the canonical hashes beyond the head header.`,
+		},
+		{
+			Name:      "get",
+			Usage:     "Print the value of a raw key",
+			ArgsUsage: "<db> <hex key>",
+			Action:    utils.MigrateFlags(dbGet),
+			Flags:     utils.SnapshotFlags,
+		},
+		{
+			Name:      "put",
+			Usage:     "Write the value of a raw key",
+			ArgsUsage: "<db> <hex key> <hex value>",
+			Action:    utils.MigrateFlags(dbPut),
+			Flags:     utils.SnapshotFlags,
+		},
+		{
+			Name:      "delete",
+			Usage:     "Delete a raw key",
+			ArgsUsage: "<db> <hex key>",
+			Action:    utils.MigrateFlags(dbDelete),
+			Flags:     utils.SnapshotFlags,
+		},
+		{
+			Name:      "dump",
+			Usage:     "Print a decoded header, the receipts of a block or a governance entry",
+			ArgsUsage: "<header|receipts|governance> [block number]",
+			Action:    utils.MigrateFlags(dbDump),
+			Flags:     utils.SnapshotFlags,
+			Description: `
+This command prints the header or the receipts of the given canonical block,
+the head block if omitted, in JSON. For governance, it prints the governance
+entry stored at the given block number, or all the entries if omitted.`,
+		},
+		{
+			Name:   "verify-shards",
+			Usage:  "Compare the state trie DB shards with their mirrors",
+			Action: utils.MigrateFlags(dbVerifyShards),
+			Flags:  append([]cli.Flag{utils.DBRepairFlag}, utils.SnapshotFlags...),
+			Description: `
+This command compares every item of the state trie DB shards with their mirrors
+and reports the items missing or corrupt in either side. With --repair, the
+items are restored from the other side. The state trie DB should be sharded and
+opened with --db.statetrie-shard-mirror. A shard whose directory is lost can
+only be opened with --repair, since the databases are opened read-only otherwise.`,
+		},
+	},
+}
+
+// openDBManager opens the databases of the node configured by the given context.
+func openDBManager(ctx *cli.Context, readOnly bool) database.DBManager {
+	stack, _ := utils.MakeConfigNode(ctx)
+	dbc := getConfig(ctx)
+	dbc.ReadOnly = readOnly
+	return stack.OpenDatabase(dbc)
+}
+
+// parseHexArg decodes a hex string with or without the 0x prefix.
+func parseHexArg(s string) ([]byte, error) {
+	if !strings.HasPrefix(s, "0x") && !strings.HasPrefix(s, "0X") {
+		s = "0x" + s
+	}
+	return hexutil.Decode(s)
+}
+
+// rawDatabaseArgs resolves the database and the key given as the first two arguments.
+func rawDatabaseArgs(ctx *cli.Context, dbm database.DBManager) (database.Database, []byte, error) {
+	et, err := database.ParseDBEntryType(ctx.Args().Get(0))
+	if err != nil {
+		return nil, nil, err
+	}
+	db, err := database.GetRawDatabase(dbm, et)
+	if err != nil {
+		return nil, nil, err
+	}
+	key, err := parseHexArg(ctx.Args().Get(1))
+	if err != nil {
+		return nil, nil, fmt.Errorf("invalid key: %v", err)
+	}
+	return db, key, nil
+}
+
+func dbStats(ctx *cli.Context) error {
+	dbm := openDBManager(ctx, true)
+	defer dbm.Close()
+
+	stats, err := database.InspectDatabase(dbm)
+	if err != nil {
+		return err
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "DATABASE\tCATEGORY\tCOUNT\tSIZE")
+	var (
+		totalCount uint64
+		totalSize  common.StorageSize
+	)
+	for _, stat := range stats {
+		for _, category := range stat.Categories {
+			fmt.Fprintf(w, "%s\t%s\t%d\t%s\n", stat.Entry, category.Category, category.Count, category.Size)
+		}
+		fmt.Fprintf(w, "%s\t%s\t%d\t%s\n", stat.Entry, "Total", stat.Count, stat.Size)
+		totalCount += stat.Count
+		totalSize += stat.Size
+	}
+	fmt.Fprintf(w, "%s\t\t%d\t%s\n", "TOTAL", totalCount, totalSize)
+	return w.Flush()
+}
+
+func dbInspect(ctx *cli.Context) error {
+	dbm := openDBManager(ctx, true)
+	defer dbm.Close()
+
+	issues := database.CheckCanonicalChain(dbm)
+	for _, issue := range issues {
+		fmt.Printf("#%d %s: %s\n", issue.Number, issue.Hash.Hex(), issue.Reason)
+	}
+	if len(issues) > 0 {
+		return fmt.Errorf("%w: %d issues", errChainIssues, len(issues))
+	}
+	logger.Info("The canonical chain index is consistent")
+	return nil
+}
+
+func dbGet(ctx *cli.Context) error {
+	if ctx.NArg() != 2 {
+		return errInvalidArgCount
+	}
+	dbm := openDBManager(ctx, true)
+	defer dbm.Close()
+
+	db, key, err := rawDatabaseArgs(ctx, dbm)
+	if err != nil {
+		return err
+	}
+	value, err := db.Get(key)
+	if err != nil {
+		return fmt.Errorf("%w: %v", errKeyNotFound, err)
+	}
+	fmt.Println(hexutil.Encode(value))
+	return nil
+}
+
+func dbPut(ctx *cli.Context) error {
+	if ctx.NArg() != 3 {
+		return errInvalidArgCount
+	}
+	value, err := parseHexArg(ctx.Args().Get(2))
+	if err != nil {
+		return fmt.Errorf("invalid value: %v", err)
+	}
+	dbm := openDBManager(ctx, false)
+	defer dbm.Close()
+
+	db, key, err := rawDatabaseArgs(ctx, dbm)
+	if err != nil {
+		return err
+	}
+	if prev, err := db.Get(key); err == nil {
+		logger.Info("Overwriting the previous value", "key", hexutil.Encode(key), "value", hexutil.Encode(prev))
+	}
+	return db.Put(key, value)
+}
+
+func dbDelete(ctx *cli.Context) error {
+	if ctx.NArg() != 2 {
+		return errInvalidArgCount
+	}
+	dbm := openDBManager(ctx, false)
+	defer dbm.Close()
+
+	db, key, err := rawDatabaseArgs(ctx, dbm)
+	if err != nil {
+		return err
+	}
+	prev, err := db.Get(key)
+	if err != nil {
+		return fmt.Errorf("%w: %v", errKeyNotFound, err)
+	}
+	logger.Info("Deleting the key", "key", hexutil.Encode(key), "value", hexutil.Encode(prev))
+	return db.Delete(key)
+}
+
+func dbVerifyShards(ctx *cli.Context) error {
+	repair := ctx.Bool(utils.DBRepairFlag.Name)
+	dbm := openDBManager(ctx, !repair)
+	defer dbm.Close()
+
+	db, err := database.GetRawDatabase(dbm, database.StateTrieDB)
+	if err != nil {
+		return err
+	}
+	results, err := database.VerifyShards(db, repair)
+	if err != nil {
+		return err
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "SHARD\tSHARD ITEMS\tMIRROR ITEMS\tMISSING IN SHARD\tCORRUPT IN SHARD\tMISSING IN MIRROR\tCORRUPT IN MIRROR\tUNRECOVERABLE\tREPAIRED")
+	var issues, unrecoverable uint64
+	for _, r := range results {
+		fmt.Fprintf(w, "%d\t%d\t%d\t%d\t%d\t%d\t%d\t%d\t%d\n", r.Shard, r.PrimaryEntries, r.MirrorEntries,
+			r.MissingPrimary, r.CorruptPrimary, r.MissingMirror, r.CorruptMirror, r.Unrecoverable, r.Repaired)
+		issues += r.Issues()
+		unrecoverable += r.Unrecoverable
+	}
+	if err := w.Flush(); err != nil {
+		return err
+	}
+
+	if repair && unrecoverable > 0 {
+		return fmt.Errorf("%w: %d unrecoverable items", errShardIssues, unrecoverable)
+	} else if !repair && issues > 0 {
+		return fmt.Errorf("%w: %d items", errShardIssues, issues)
+	}
+	return nil
+}
+
+func dbDump(ctx *cli.Context) error {
+	if ctx.NArg() < 1 || ctx.NArg() > 2 {
+		return errInvalidArgCount
+	}
+	var number *uint64
+	if ctx.NArg() == 2 {
+		n, err := strconv.ParseUint(ctx.Args().Get(1), 0, 64)
+		if err != nil {
+			return fmt.Errorf("invalid block number: %v", err)
+		}
+		number = &n
+	}
+	dbm := openDBManager(ctx, true)
+	defer dbm.Close()
+
+	out, err := dumpEntry(dbm, ctx.Args().Get(0), number)
+	if err != nil {
+		return err
+	}
+	enc, err := json.MarshalIndent(out, "", "  ")
+	if err != nil {
+		return err
+	}
+	fmt.Println(string(enc))
+	return nil
+}
+
+// dumpEntry reads and decodes the given kind of entry at the given block
+// number, or at the head block if the number is nil.
+func dumpEntry(dbm database.DBManager, kind string, number *uint64) (interface{}, error) {
+	if kind == "governance" {
+		if number != nil {
+			return dbm.ReadGovernance(*number)
+		}
+		idxs, err := dbm.ReadRecentGovernanceIdx(0)
+		if err != nil {
+			return nil, err
+		}
+		entries := make(map[uint64]map[string]interface{}, len(idxs))
+		for _, idx := range idxs {
+			if entries[idx], err = dbm.ReadGovernance(idx); err != nil {
+				return nil, err
+			}
+		}
+		return entries, nil
+	}
+
+	var hash common.Hash
+	if number == nil {
+		hash = dbm.ReadHeadBlockHash()
+		number = dbm.ReadHeaderNumber(hash)
+		if number == nil {
+			return nil, fmt.Errorf("head block number missing: %s", hash.Hex())
+		}
+	} else {
+		hash = dbm.ReadCanonicalHash(*number)
+	}
+	if common.EmptyHash(hash) {
+		return nil, fmt.Errorf("canonical hash missing: #%d", *number)
+	}
+
+	switch kind {
+	case "header":
+		header := dbm.ReadHeader(hash, *number)
+		if header == nil {
+			return nil, fmt.Errorf("header missing: #%d %s", *number, hash.Hex())
+		}
+		return header, nil
+	case "receipts":
+		receipts := dbm.ReadReceipts(hash, *number)
+		if receipts == nil {
+			return nil, fmt.Errorf("receipts missing: #%d %s", *number, hash.Hex())
+		}
+		return receipts, nil
+	default:
+		return nil, fmt.Errorf("unknown entry to dump: %q", kind)
+	}
+}
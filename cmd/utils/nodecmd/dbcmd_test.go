@@ -0,0 +1,69 @@
+// Copyright 2024 The klaytn Authors
+// This file is part of the klaytn library.
+//
+// The klaytn library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The klaytn library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the klaytn library. If not, see <http://www.gnu.org/licenses/>.
+
+package nodecmd
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/klaytn/klaytn/blockchain/types"
+	"github.com/klaytn/klaytn/storage/database"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseHexArg(t *testing.T) {
+	for _, s := range []string{"0x0102ff", "0102ff", "0X0102FF"} {
+		b, err := parseHexArg(s)
+		assert.NoError(t, err, s)
+		assert.Equal(t, []byte{0x01, 0x02, 0xff}, b, s)
+	}
+	_, err := parseHexArg("0x123")
+	assert.Error(t, err)
+}
+
+func TestDumpEntry(t *testing.T) {
+	dbm := database.NewMemoryDBManager()
+	var head *types.Header
+	for i := int64(0); i < 3; i++ {
+		head = &types.Header{Number: big.NewInt(i)}
+		dbm.WriteHeader(head)
+		dbm.WriteCanonicalHash(head.Hash(), uint64(i))
+		dbm.WriteReceipts(head.Hash(), uint64(i), types.Receipts{{Status: types.ReceiptStatusSuccessful, GasUsed: uint64(i)}})
+	}
+	dbm.WriteHeadBlockHash(head.Hash())
+	require.NoError(t, dbm.WriteGovernance(map[string]interface{}{"governance.unitprice": float64(25)}, 0))
+
+	out, err := dumpEntry(dbm, "header", nil)
+	require.NoError(t, err)
+	assert.Equal(t, head.Hash(), out.(*types.Header).Hash())
+
+	one := uint64(1)
+	out, err = dumpEntry(dbm, "receipts", &one)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(1), out.(types.Receipts)[0].GasUsed)
+
+	out, err = dumpEntry(dbm, "governance", nil)
+	require.NoError(t, err)
+	assert.Equal(t, float64(25), out.(map[uint64]map[string]interface{})[0]["governance.unitprice"])
+
+	missing := uint64(10)
+	_, err = dumpEntry(dbm, "header", &missing)
+	assert.Error(t, err)
+	_, err = dumpEntry(dbm, "unknown", &one)
+	assert.Error(t, err)
+}
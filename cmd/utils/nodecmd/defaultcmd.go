@@ -71,6 +71,9 @@ func MakeFullNode(ctx *cli.Context) *node.Node {
 	utils.RegisterService(stack, &cfg.ServiceChain)
 	utils.RegisterDBSyncerService(stack, &cfg.DB)
 	utils.RegisterChainDataFetcherService(stack, &cfg.ChainDataFetcher)
+	utils.RegisterFeeRelayService(stack, &cfg.FeeRelay)
+	utils.RegisterVerifierService(stack, &cfg.Verifier)
+	utils.RegisterGraphQLService(stack, &cfg.GraphQL)
 	return stack
 }
 
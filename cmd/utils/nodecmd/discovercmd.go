@@ -0,0 +1,165 @@
+// Copyright 2024 The klaytn Authors
+// This file is part of the klaytn library.
+//
+// The klaytn library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The klaytn library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the klaytn library. If not, see <http://www.gnu.org/licenses/>.
+
+package nodecmd
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"sort"
+
+	"github.com/klaytn/klaytn/cmd/utils"
+	"github.com/urfave/cli/v2"
+)
+
+var errOpenRPCDrift = errors.New("the OpenRPC document differs from the methods served by the node")
+
+var DiscoverCommand = &cli.Command{
+	Action:    discover,
+	Name:      "discover",
+	Usage:     "Print the OpenRPC document of the RPC APIs served by a running node",
+	ArgsUsage: "[endpoint]",
+	Flags:     []cli.Flag{utils.DataDirFlag, utils.OpenRPCOutputFlag, utils.OpenRPCCheckFlag},
+	Category:  "CONSOLE COMMANDS",
+	Description: `
+The discover command calls rpc_discover of a running node and prints the returned
+OpenRPC document, which can be used to generate the client SDKs and the API docs.
+With --check, the document is compared with the given one and the command fails
+if a method is added, removed or changed.`,
+}
+
+func discover(ctx *cli.Context) error {
+	client, err := dialRPC(remoteEndpoint(ctx))
+	if err != nil {
+		return fmt.Errorf("unable to attach to remote node: %v", err)
+	}
+	defer client.Close()
+
+	var doc json.RawMessage
+	if err := client.CallContext(context.Background(), &doc, "rpc_discover"); err != nil {
+		return err
+	}
+
+	if path := ctx.String(utils.OpenRPCCheckFlag.Name); path != "" {
+		expected, err := ioutil.ReadFile(path)
+		if err != nil {
+			return err
+		}
+		diffs, err := diffOpenRPC(expected, doc)
+		if err != nil {
+			return err
+		}
+		for _, diff := range diffs {
+			fmt.Println(diff)
+		}
+		if len(diffs) > 0 {
+			return errOpenRPCDrift
+		}
+		return nil
+	}
+
+	var out bytes.Buffer
+	if err := json.Indent(&out, doc, "", "  "); err != nil {
+		return err
+	}
+	out.WriteByte('\n')
+	if path := ctx.String(utils.OpenRPCOutputFlag.Name); path != "" {
+		return ioutil.WriteFile(path, out.Bytes(), 0o644)
+	}
+	_, err = os.Stdout.Write(out.Bytes())
+	return err
+}
+
+// diffOpenRPC returns the methods added, removed or changed and the component schemas
+// changed in the served document, compared with the expected one.
+func diffOpenRPC(expected, served []byte) ([]string, error) {
+	expectedMethods, err := openRPCMethodsByName(expected)
+	if err != nil {
+		return nil, fmt.Errorf("invalid OpenRPC document: %v", err)
+	}
+	servedMethods, err := openRPCMethodsByName(served)
+	if err != nil {
+		return nil, fmt.Errorf("invalid OpenRPC document served: %v", err)
+	}
+	var expectedSchemas, servedSchemas struct {
+		Components struct {
+			Schemas map[string]json.RawMessage `json:"schemas"`
+		} `json:"components"`
+	}
+	if err := json.Unmarshal(expected, &expectedSchemas); err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(served, &servedSchemas); err != nil {
+		return nil, err
+	}
+
+	var diffs []string
+	for name, method := range servedMethods {
+		if old, ok := expectedMethods[name]; !ok {
+			diffs = append(diffs, "+ "+name)
+		} else if !jsonEqual(old, method) {
+			diffs = append(diffs, "~ "+name)
+		}
+	}
+	for name := range expectedMethods {
+		if _, ok := servedMethods[name]; !ok {
+			diffs = append(diffs, "- "+name)
+		}
+	}
+	for name, schema := range servedSchemas.Components.Schemas {
+		if old, ok := expectedSchemas.Components.Schemas[name]; ok && !jsonEqual(old, schema) {
+			diffs = append(diffs, "~ #/components/schemas/"+name)
+		}
+	}
+	sort.Strings(diffs)
+	return diffs, nil
+}
+
+func openRPCMethodsByName(doc []byte) (map[string]json.RawMessage, error) {
+	var raw struct {
+		Methods []json.RawMessage `json:"methods"`
+	}
+	if err := json.Unmarshal(doc, &raw); err != nil {
+		return nil, err
+	}
+	methods := make(map[string]json.RawMessage, len(raw.Methods))
+	for _, method := range raw.Methods {
+		var m struct {
+			Name string `json:"name"`
+		}
+		if err := json.Unmarshal(method, &m); err != nil {
+			return nil, err
+		}
+		methods[m.Name] = method
+	}
+	return methods, nil
+}
+
+// jsonEqual reports whether a and b are the same JSON regardless of the formatting.
+func jsonEqual(a, b json.RawMessage) bool {
+	var va, vb interface{}
+	if json.Unmarshal(a, &va) != nil || json.Unmarshal(b, &vb) != nil {
+		return false
+	}
+	ja, _ := json.Marshal(va)
+	jb, _ := json.Marshal(vb)
+	return bytes.Equal(ja, jb)
+}
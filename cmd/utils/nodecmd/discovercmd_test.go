@@ -0,0 +1,54 @@
+// Copyright 2024 The klaytn Authors
+// This file is part of the klaytn library.
+//
+// The klaytn library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The klaytn library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the klaytn library. If not, see <http://www.gnu.org/licenses/>.
+
+package nodecmd
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDiffOpenRPC(t *testing.T) {
+	expected := []byte(`{
+		"methods": [
+			{"name": "klay_blockNumber", "params": [], "result": {"name": "result", "schema": {"type": "string"}}},
+			{"name": "klay_chainID", "params": [], "result": {"name": "result", "schema": {"type": "string"}}},
+			{"name": "klay_getBlock", "params": [], "result": {"name": "result", "schema": {"$ref": "#/components/schemas/Block"}}}
+		],
+		"components": {"schemas": {"Block": {"type": "object", "properties": {"number": {"type": "string"}}}}}
+	}`)
+
+	diffs, err := diffOpenRPC(expected, expected)
+	require.NoError(t, err)
+	assert.Empty(t, diffs)
+
+	served := []byte(`{
+		"methods": [
+			{"name": "klay_blockNumber", "params": [], "result": {"name": "result", "schema": {"type": "integer"}}},
+			{"name": "klay_gasPrice", "params": [], "result": {"name": "result", "schema": {"type": "string"}}},
+			{"name": "klay_getBlock", "params": [], "result": {"name": "result", "schema": {"$ref": "#/components/schemas/Block"}}}
+		],
+		"components": {"schemas": {"Block": {"type": "object", "properties": {"number": {"type": "integer"}}}}}
+	}`)
+	diffs, err = diffOpenRPC(expected, served)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"+ klay_gasPrice", "- klay_chainID", "~ #/components/schemas/Block", "~ klay_blockNumber"}, diffs)
+
+	_, err = diffOpenRPC([]byte(`{"methods": {}}`), served)
+	assert.Error(t, err)
+}
@@ -37,7 +37,7 @@ var (
 		Description: `
 The migration command migrates a DB to another DB.
 The type of DBs can be different.
-(e.g. LevelDB -> LevelDB, LevelDB -> BadgerDB, LevelDB -> DynamoDB)
+(e.g. LevelDB -> LevelDB, LevelDB -> BadgerDB, LevelDB -> PebbleDB, LevelDB -> DynamoDB)
 Note: This feature is only provided when srcDB is single LevelDB.
 Note: Do not use db migration while a node is executing.
 `,
@@ -99,6 +99,7 @@ func createDBConfigForMigration(ctx *cli.Context) (*database.DBConfig, *database
 		DBType:             database.DBType(ctx.String(utils.DbTypeFlag.Name)).ToValid(),
 		SingleDB:           ctx.Bool(utils.SingleDBFlag.Name),
 		NumStateTrieShards: ctx.Uint(utils.NumStateTrieShardsFlag.Name),
+		ShardMirror:        ctx.Bool(utils.StateTrieShardMirrorFlag.Name),
 		OpenFilesLimit:     database.GetOpenFilesLimit(),
 
 		LevelDBCacheSize:    ctx.Int(utils.LevelDBCacheSizeFlag.Name),
@@ -125,6 +126,12 @@ func createDBConfigForMigration(ctx *cli.Context) (*database.DBConfig, *database
 			MaxOpenFiles:              ctx.Int(utils.RocksDBMaxOpenFilesFlag.Name),
 			CacheIndexAndFilter:       ctx.Bool(utils.RocksDBCacheIndexAndFilterFlag.Name),
 		},
+
+		PebbleDBConfig: &database.PebbleDBConfig{
+			CacheSize:      ctx.Uint64(utils.PebbleDBCacheSizeFlag.Name),
+			MaxOpenFiles:   ctx.Int(utils.PebbleDBMaxOpenFilesFlag.Name),
+			DisableMetrics: ctx.Bool(utils.PebbleDBDisableMetricsFlag.Name),
+		},
 	}
 	if len(srcDBC.DBType) == 0 { // changed to invalid type
 		return nil, nil, errors.New("srcDB is not specified or invalid : " + ctx.String(utils.DbTypeFlag.Name))
@@ -136,6 +143,7 @@ func createDBConfigForMigration(ctx *cli.Context) (*database.DBConfig, *database
 		DBType:             database.DBType(ctx.String(utils.DstDbTypeFlag.Name)).ToValid(),
 		SingleDB:           ctx.Bool(utils.DstSingleDBFlag.Name),
 		NumStateTrieShards: ctx.Uint(utils.DstNumStateTrieShardsFlag.Name),
+		ShardMirror:        ctx.Bool(utils.DstStateTrieShardMirrorFlag.Name),
 		OpenFilesLimit:     database.GetOpenFilesLimit(),
 
 		LevelDBCacheSize:    ctx.Int(utils.DstLevelDBCacheSizeFlag.Name),
@@ -162,6 +170,12 @@ func createDBConfigForMigration(ctx *cli.Context) (*database.DBConfig, *database
 			MaxOpenFiles:              ctx.Int(utils.DstRocksDBMaxOpenFilesFlag.Name),
 			CacheIndexAndFilter:       ctx.Bool(utils.DstRocksDBCacheIndexAndFilterFlag.Name),
 		},
+
+		PebbleDBConfig: &database.PebbleDBConfig{
+			CacheSize:      ctx.Uint64(utils.DstPebbleDBCacheSizeFlag.Name),
+			MaxOpenFiles:   ctx.Int(utils.DstPebbleDBMaxOpenFilesFlag.Name),
+			DisableMetrics: ctx.Bool(utils.DstPebbleDBDisableMetricsFlag.Name),
+		},
 	}
 	if len(dstDBC.DBType) == 0 { // changed to invalid type
 		return nil, nil, errors.New("dstDB is not specified or invalid : " + ctx.String(utils.DstDbTypeFlag.Name))
@@ -99,6 +99,7 @@ func getConfig(ctx *cli.Context) *database.DBConfig {
 		DBType:             database.DBType(ctx.String(utils.DbTypeFlag.Name)).ToValid(),
 		SingleDB:           ctx.Bool(utils.SingleDBFlag.Name),
 		NumStateTrieShards: ctx.Uint(utils.NumStateTrieShardsFlag.Name),
+		ShardMirror:        ctx.Bool(utils.StateTrieShardMirrorFlag.Name),
 		OpenFilesLimit:     database.GetOpenFilesLimit(),
 
 		LevelDBCacheSize:    ctx.Int(utils.LevelDBCacheSizeFlag.Name),
@@ -125,6 +126,12 @@ func getConfig(ctx *cli.Context) *database.DBConfig {
 			MaxOpenFiles:              ctx.Int(utils.RocksDBMaxOpenFilesFlag.Name),
 			CacheIndexAndFilter:       ctx.Bool(utils.RocksDBCacheIndexAndFilterFlag.Name),
 		},
+
+		PebbleDBConfig: &database.PebbleDBConfig{
+			CacheSize:      ctx.Uint64(utils.PebbleDBCacheSizeFlag.Name),
+			MaxOpenFiles:   ctx.Int(utils.PebbleDBMaxOpenFilesFlag.Name),
+			DisableMetrics: ctx.Bool(utils.PebbleDBDisableMetricsFlag.Name),
+		},
 	}
 }
 
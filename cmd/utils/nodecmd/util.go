@@ -24,8 +24,10 @@ import (
 	"errors"
 	"fmt"
 	"io/ioutil"
+	"strings"
 
 	"github.com/klaytn/klaytn/accounts/keystore"
+	"github.com/klaytn/klaytn/blockchain/asm"
 	"github.com/klaytn/klaytn/blockchain/types"
 	"github.com/klaytn/klaytn/common"
 	"github.com/klaytn/klaytn/common/hexutil"
@@ -43,6 +45,7 @@ const (
 	DECODE_VOTE  = "decode-vote"
 	DECODE_GOV   = "decode-gov"
 	DECRYPT_KEY  = "decrypt-keystore"
+	ANALYZE_CODE = "analyze-code"
 )
 
 var ErrInvalidCmd = errors.New("Invalid command. Check usage through --help command")
@@ -76,12 +79,18 @@ var UtilCommand = &cli.Command{
 			Action:      action,
 			Description: "Decrypt keystore",
 		},
+		{
+			Name:        ANALYZE_CODE,
+			Usage:       "<hex bytes or file containing hex bytes>",
+			Action:      action,
+			Description: "Disassemble EVM bytecode and report its function selectors, dangerous opcodes, Klaytn precompile usages and proxy patterns",
+		},
 	},
 }
 
 func action(ctx *cli.Context) error {
 	var (
-		m   map[string]interface{}
+		m   interface{}
 		err error
 	)
 	switch ctx.Command.Name {
@@ -106,6 +115,11 @@ func action(ctx *cli.Context) error {
 		}
 		keystorePath, passwd := ctx.Args().Get(0), ctx.Args().Get(1)
 		m, err = extractKeypair(keystorePath, passwd)
+	case ANALYZE_CODE:
+		if ctx.Args().Len() != 1 {
+			return ErrInvalidCmd
+		}
+		m, err = analyzeCode(ctx.Args().Get(0))
 	default:
 		return ErrInvalidCmd
 	}
@@ -123,7 +137,7 @@ func hex2Bytes(s string) []byte {
 	}
 }
 
-func prettyPrint(m map[string]interface{}) {
+func prettyPrint(m interface{}) {
 	if b, err := json.MarshalIndent(m, "", "  "); err == nil {
 		fmt.Println(string(b))
 	} else {
@@ -131,6 +145,19 @@ func prettyPrint(m map[string]interface{}) {
 	}
 }
 
+// analyzeCode reports the static analysis result of the given code.
+// The code is given as a hex string or a file containing it.
+func analyzeCode(codeOrFile string) (*asm.CodeReport, error) {
+	if content, err := ioutil.ReadFile(codeOrFile); err == nil {
+		codeOrFile = strings.TrimSpace(string(content))
+	}
+	code, err := hex.DecodeString(strings.TrimPrefix(codeOrFile, "0x"))
+	if err != nil {
+		return nil, err
+	}
+	return asm.AnalyzeCode(code), nil
+}
+
 func extractKeypair(keystorePath, passwd string) (map[string]interface{}, error) {
 	keyjson, err := ioutil.ReadFile(keystorePath)
 	if err != nil {
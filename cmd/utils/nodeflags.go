@@ -35,6 +35,9 @@ func AllNodeFlags() []cli.Flag {
 	nodeFlags = append(nodeFlags, ConsoleFlags...)
 	nodeFlags = append(nodeFlags, debug.Flags...)
 	nodeFlags = append(nodeFlags, ChainDataFetcherFlags...)
+	nodeFlags = append(nodeFlags, FeeRelayFlags...)
+	nodeFlags = append(nodeFlags, VerifierFlags...)
+	nodeFlags = append(nodeFlags, GraphQLFlags...)
 	nodeFlags = union(nodeFlags, SnapshotFlags)
 	nodeFlags = union(nodeFlags, DBMigrationSrcFlags)
 	nodeFlags = union(nodeFlags, DBMigrationDstFlags)
@@ -124,6 +127,9 @@ func KenAppFlags() []cli.Flag {
 	flags = append(flags, debug.Flags...)
 	flags = append(flags, DBMigrationDstFlags...)
 	flags = append(flags, ChainDataFetcherFlags...)
+	flags = append(flags, FeeRelayFlags...)
+	flags = append(flags, VerifierFlags...)
+	flags = append(flags, GraphQLFlags...)
 	return flags
 }
 
@@ -150,6 +156,9 @@ func KsenAppFlags() []cli.Flag {
 	flags = append(flags, ConsoleFlags...)
 	flags = append(flags, debug.Flags...)
 	flags = append(flags, ChainDataFetcherFlags...)
+	flags = append(flags, FeeRelayFlags...)
+	flags = append(flags, VerifierFlags...)
+	flags = append(flags, GraphQLFlags...)
 	return flags
 }
 
@@ -157,6 +166,7 @@ var ConsoleFlags = []cli.Flag{
 	altsrc.NewStringFlag(JSpathFlag),
 	altsrc.NewStringFlag(ExecFlag),
 	altsrc.NewStringFlag(PreloadJSFlag),
+	altsrc.NewStringFlag(ABIDirFlag),
 }
 
 // Common flags that configure the node
@@ -191,8 +201,11 @@ var CommonNodeFlags = []cli.Flag{
 	NewWrappedTextMarshalerFlag(SyncModeFlag),
 	altsrc.NewStringFlag(GCModeFlag),
 	altsrc.NewBoolFlag(LightKDFFlag),
+	altsrc.NewStringFlag(ExternalSignerFlag),
 	altsrc.NewBoolFlag(SingleDBFlag),
 	altsrc.NewUintFlag(NumStateTrieShardsFlag),
+	altsrc.NewBoolFlag(StateTrieShardMirrorFlag),
+	altsrc.NewDurationFlag(StateTrieShardScrubIntervalFlag),
 	altsrc.NewIntFlag(LevelDBCompressionTypeFlag),
 	altsrc.NewBoolFlag(LevelDBNoBufferPoolFlag),
 	altsrc.NewBoolFlag(DBNoPerformanceMetricsFlag),
@@ -205,6 +218,9 @@ var CommonNodeFlags = []cli.Flag{
 	altsrc.NewBoolFlag(RocksDBDisableMetricsFlag),
 	altsrc.NewIntFlag(RocksDBMaxOpenFilesFlag),
 	altsrc.NewBoolFlag(RocksDBCacheIndexAndFilterFlag),
+	altsrc.NewUint64Flag(PebbleDBCacheSizeFlag),
+	altsrc.NewIntFlag(PebbleDBMaxOpenFilesFlag),
+	altsrc.NewBoolFlag(PebbleDBDisableMetricsFlag),
 	altsrc.NewStringFlag(DynamoDBTableNameFlag),
 	altsrc.NewStringFlag(DynamoDBRegionFlag),
 	altsrc.NewBoolFlag(DynamoDBIsProvisionedFlag),
@@ -286,14 +302,32 @@ var CommonRPCFlags = []cli.Flag{
 	altsrc.NewStringFlag(GRPCListenAddrFlag),
 	altsrc.NewIntFlag(GRPCPortFlag),
 	altsrc.NewIntFlag(RPCConcurrencyLimit),
+	altsrc.NewIntFlag(RPCCacheSizeFlag),
+	altsrc.NewIntFlag(RPCCacheTTLFlag),
+	altsrc.NewPathFlag(RPCRecordDirFlag),
+	altsrc.NewFloat64Flag(RPCRecordSampleRateFlag),
+	altsrc.NewIntFlag(RPCRecordFileSizeFlag),
+	altsrc.NewIntFlag(RPCRecordFilesFlag),
+	altsrc.NewIntFlag(RPCBatchLimitFlag),
+	altsrc.NewIntFlag(RPCResponseSizeLimitFlag),
+	altsrc.NewStringFlag(RPCMethodTimeoutsFlag),
+	altsrc.NewStringFlag(RPCMethodConcurrencyFlag),
 	altsrc.NewStringFlag(WSApiFlag),
 	altsrc.NewStringFlag(WSAllowedOriginsFlag),
 	altsrc.NewIntFlag(WSMaxSubscriptionPerConn),
 	altsrc.NewInt64Flag(WSReadDeadLine),
 	altsrc.NewInt64Flag(WSWriteDeadLine),
 	altsrc.NewIntFlag(WSMaxConnections),
+	altsrc.NewIntFlag(WSBatchLimitFlag),
+	altsrc.NewIntFlag(WSResponseSizeLimitFlag),
+	altsrc.NewStringFlag(WSMethodTimeoutsFlag),
+	altsrc.NewStringFlag(WSMethodConcurrencyFlag),
 	altsrc.NewBoolFlag(IPCDisabledFlag),
 	altsrc.NewPathFlag(IPCPathFlag),
+	altsrc.NewIntFlag(IPCBatchLimitFlag),
+	altsrc.NewIntFlag(IPCResponseSizeLimitFlag),
+	altsrc.NewStringFlag(IPCMethodTimeoutsFlag),
+	altsrc.NewStringFlag(IPCMethodConcurrencyFlag),
 	altsrc.NewIntFlag(RPCReadTimeout),
 	altsrc.NewIntFlag(RPCWriteTimeoutFlag),
 	altsrc.NewIntFlag(RPCIdleTimeoutFlag),
@@ -383,10 +417,14 @@ var KSCNFlags = []cli.Flag{
 	altsrc.NewIntFlag(ParentChainIDFlag),
 	altsrc.NewBoolFlag(VTRecoveryFlag),
 	altsrc.NewUint64Flag(VTRecoveryIntervalFlag),
+	altsrc.NewBoolFlag(VTThresholdSigningFlag),
 	altsrc.NewBoolFlag(ServiceChainNewAccountFlag),
 	altsrc.NewBoolFlag(ServiceChainAnchoringFlag),
+	altsrc.NewBoolFlag(ServiceChainMerkleAnchoringFlag),
 	altsrc.NewUint64Flag(ServiceChainParentOperatorTxGasLimitFlag),
 	altsrc.NewUint64Flag(ServiceChainChildOperatorTxGasLimitFlag),
+	altsrc.NewStringFlag(ServiceChainParentOperatorFlag),
+	altsrc.NewStringFlag(ServiceChainChildOperatorFlag),
 	// KAS
 	altsrc.NewBoolFlag(KASServiceChainAnchorFlag),
 	altsrc.NewUint64Flag(KASServiceChainAnchorPeriodFlag),
@@ -414,10 +452,14 @@ var KSPNFlags = []cli.Flag{
 	altsrc.NewIntFlag(ParentChainIDFlag),
 	altsrc.NewBoolFlag(VTRecoveryFlag),
 	altsrc.NewUint64Flag(VTRecoveryIntervalFlag),
+	altsrc.NewBoolFlag(VTThresholdSigningFlag),
 	altsrc.NewBoolFlag(ServiceChainNewAccountFlag),
 	altsrc.NewBoolFlag(ServiceChainAnchoringFlag),
+	altsrc.NewBoolFlag(ServiceChainMerkleAnchoringFlag),
 	altsrc.NewUint64Flag(ServiceChainParentOperatorTxGasLimitFlag),
 	altsrc.NewUint64Flag(ServiceChainChildOperatorTxGasLimitFlag),
+	altsrc.NewStringFlag(ServiceChainParentOperatorFlag),
+	altsrc.NewStringFlag(ServiceChainChildOperatorFlag),
 	// KAS
 	altsrc.NewBoolFlag(KASServiceChainAnchorFlag),
 	altsrc.NewUint64Flag(KASServiceChainAnchorPeriodFlag),
@@ -441,10 +483,14 @@ var KSENFlags = []cli.Flag{
 	altsrc.NewIntFlag(ParentChainIDFlag),
 	altsrc.NewBoolFlag(VTRecoveryFlag),
 	altsrc.NewUint64Flag(VTRecoveryIntervalFlag),
+	altsrc.NewBoolFlag(VTThresholdSigningFlag),
 	altsrc.NewBoolFlag(ServiceChainAnchoringFlag),
+	altsrc.NewBoolFlag(ServiceChainMerkleAnchoringFlag),
 	altsrc.NewBoolFlag(KESNodeTypeServiceFlag),
 	altsrc.NewUint64Flag(ServiceChainParentOperatorTxGasLimitFlag),
 	altsrc.NewUint64Flag(ServiceChainChildOperatorTxGasLimitFlag),
+	altsrc.NewStringFlag(ServiceChainParentOperatorFlag),
+	altsrc.NewStringFlag(ServiceChainChildOperatorFlag),
 	// KAS
 	altsrc.NewBoolFlag(KASServiceChainAnchorFlag),
 	altsrc.NewUint64Flag(KASServiceChainAnchorPeriodFlag),
@@ -483,6 +529,7 @@ var SnapshotFlags = []cli.Flag{
 	altsrc.NewPathFlag(ChainDataDirFlag),
 	altsrc.NewBoolFlag(SingleDBFlag),
 	altsrc.NewUintFlag(NumStateTrieShardsFlag),
+	altsrc.NewBoolFlag(StateTrieShardMirrorFlag),
 	altsrc.NewStringFlag(DynamoDBTableNameFlag),
 	altsrc.NewStringFlag(DynamoDBRegionFlag),
 	altsrc.NewBoolFlag(DynamoDBIsProvisionedFlag),
@@ -498,6 +545,9 @@ var SnapshotFlags = []cli.Flag{
 	altsrc.NewBoolFlag(RocksDBDisableMetricsFlag),
 	altsrc.NewIntFlag(RocksDBMaxOpenFilesFlag),
 	altsrc.NewBoolFlag(RocksDBCacheIndexAndFilterFlag),
+	altsrc.NewUint64Flag(PebbleDBCacheSizeFlag),
+	altsrc.NewIntFlag(PebbleDBMaxOpenFilesFlag),
+	altsrc.NewBoolFlag(PebbleDBDisableMetricsFlag),
 }
 
 var DBMigrationSrcFlags = []cli.Flag{
@@ -506,6 +556,7 @@ var DBMigrationSrcFlags = []cli.Flag{
 	altsrc.NewBoolFlag(SingleDBFlag),
 	altsrc.NewIntFlag(LevelDBCacheSizeFlag),
 	altsrc.NewUintFlag(NumStateTrieShardsFlag),
+	altsrc.NewBoolFlag(StateTrieShardMirrorFlag),
 	altsrc.NewStringFlag(DynamoDBTableNameFlag),
 	altsrc.NewStringFlag(DynamoDBRegionFlag),
 	altsrc.NewBoolFlag(DynamoDBIsProvisionedFlag),
@@ -522,6 +573,9 @@ var DBMigrationSrcFlags = []cli.Flag{
 	altsrc.NewBoolFlag(RocksDBDisableMetricsFlag),
 	altsrc.NewIntFlag(RocksDBMaxOpenFilesFlag),
 	altsrc.NewBoolFlag(RocksDBCacheIndexAndFilterFlag),
+	altsrc.NewUint64Flag(PebbleDBCacheSizeFlag),
+	altsrc.NewIntFlag(PebbleDBMaxOpenFilesFlag),
+	altsrc.NewBoolFlag(PebbleDBDisableMetricsFlag),
 }
 
 var DBMigrationDstFlags = []cli.Flag{
@@ -531,6 +585,7 @@ var DBMigrationDstFlags = []cli.Flag{
 	altsrc.NewIntFlag(DstLevelDBCacheSizeFlag),
 	altsrc.NewIntFlag(DstLevelDBCompressionTypeFlag),
 	altsrc.NewUintFlag(DstNumStateTrieShardsFlag),
+	altsrc.NewBoolFlag(DstStateTrieShardMirrorFlag),
 	altsrc.NewStringFlag(DstDynamoDBTableNameFlag),
 	altsrc.NewStringFlag(DstDynamoDBRegionFlag),
 	altsrc.NewBoolFlag(DstDynamoDBIsProvisionedFlag),
@@ -545,6 +600,27 @@ var DBMigrationDstFlags = []cli.Flag{
 	altsrc.NewStringFlag(DstRocksDBFilterPolicyFlag),
 	altsrc.NewIntFlag(DstRocksDBMaxOpenFilesFlag),
 	altsrc.NewBoolFlag(DstRocksDBCacheIndexAndFilterFlag),
+	altsrc.NewUint64Flag(DstPebbleDBCacheSizeFlag),
+	altsrc.NewIntFlag(DstPebbleDBMaxOpenFilesFlag),
+	altsrc.NewBoolFlag(DstPebbleDBDisableMetricsFlag),
+}
+
+var FeeRelayFlags = []cli.Flag{
+	altsrc.NewBoolFlag(FeeRelayFlag),
+	altsrc.NewStringFlag(FeeRelayFeePayerFlag),
+	altsrc.NewStringFlag(FeeRelayPolicyFlag),
+	altsrc.NewStringFlag(FeeRelayBudgetFileFlag),
+}
+
+var VerifierFlags = []cli.Flag{
+	altsrc.NewBoolFlag(VerifierFlag),
+	altsrc.NewStringFlag(VerifierSolcFlag),
+}
+
+var GraphQLFlags = []cli.Flag{
+	altsrc.NewBoolFlag(GraphQLFlag),
+	altsrc.NewIntFlag(GraphQLMaxCostFlag),
+	altsrc.NewIntFlag(GraphQLMaxDepthFlag),
 }
 
 var ChainDataFetcherFlags = []cli.Flag{
@@ -0,0 +1,162 @@
+// Copyright 2024 The klaytn Authors
+// This file is part of the klaytn library.
+//
+// The klaytn library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The klaytn library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the klaytn library. If not, see <http://www.gnu.org/licenses/>.
+
+package compiler
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os/exec"
+	"strings"
+)
+
+// standardJSONOutputSelection is the output selection required to verify deployed contracts.
+var standardJSONOutputSelection = map[string]map[string][]string{
+	"*": {
+		"*": {"abi", "metadata", "evm.deployedBytecode.object", "evm.deployedBytecode.immutableReferences"},
+	},
+}
+
+// StandardJSONError is an error or a warning reported by solc for a standard JSON input.
+type StandardJSONError struct {
+	Severity         string `json:"severity"`
+	Type             string `json:"type"`
+	Message          string `json:"message"`
+	FormattedMessage string `json:"formattedMessage"`
+}
+
+// ImmutableReference is the position of an immutable variable in the deployed bytecode.
+type ImmutableReference struct {
+	Start  int `json:"start"`
+	Length int `json:"length"`
+}
+
+// StandardJSONContract is a contract compiled from a standard JSON input.
+type StandardJSONContract struct {
+	ABI      json.RawMessage `json:"abi"`
+	Metadata string          `json:"metadata"`
+	EVM      struct {
+		DeployedBytecode struct {
+			Object              string                          `json:"object"`
+			ImmutableReferences map[string][]ImmutableReference `json:"immutableReferences"`
+		} `json:"deployedBytecode"`
+	} `json:"evm"`
+}
+
+// StandardJSONOutput is the output of solc for a standard JSON input.
+// Contracts are indexed by the source file name and the contract name.
+type StandardJSONOutput struct {
+	Errors    []StandardJSONError                         `json:"errors"`
+	Contracts map[string]map[string]*StandardJSONContract `json:"contracts"`
+	Version   string                                      `json:"-"`
+}
+
+// CompileStandardJSON compiles the given solc standard JSON input. The output selection of
+// the input is replaced so that the ABI, the metadata and the deployed bytecode of every
+// contract are returned. An error is returned if solc reports any error.
+func CompileStandardJSON(solc string, input []byte) (*StandardJSONOutput, error) {
+	var in map[string]interface{}
+	if err := json.Unmarshal(input, &in); err != nil {
+		return nil, fmt.Errorf("solc: invalid standard JSON input: %v", err)
+	}
+	if lang, ok := in["language"].(string); ok && lang != "Solidity" {
+		return nil, fmt.Errorf("solc: unsupported language %q", lang)
+	}
+	if _, ok := in["sources"]; !ok {
+		return nil, errors.New("solc: no sources in standard JSON input")
+	}
+	settings, ok := in["settings"].(map[string]interface{})
+	if !ok {
+		settings = make(map[string]interface{})
+		in["settings"] = settings
+	}
+	settings["outputSelection"] = standardJSONOutputSelection
+	input, err := json.Marshal(in)
+	if err != nil {
+		return nil, err
+	}
+
+	s, err := SolidityVersion(solc)
+	if err != nil {
+		return nil, err
+	}
+	var stderr, stdout bytes.Buffer
+	cmd := exec.Command(s.Path, "--standard-json")
+	cmd.Stdin = bytes.NewReader(input)
+	cmd.Stderr = &stderr
+	cmd.Stdout = &stdout
+	if err := cmd.Run(); err != nil {
+		return nil, fmt.Errorf("solc: %v\n%s", err, stderr.Bytes())
+	}
+	output, err := ParseStandardJSONOutput(stdout.Bytes())
+	if err != nil {
+		return nil, err
+	}
+	output.Version = s.Version
+	return output, nil
+}
+
+// ParseStandardJSONOutput parses the output of a solc --standard-json run.
+// It returns an error if the output contains any error reported by solc.
+func ParseStandardJSONOutput(data []byte) (*StandardJSONOutput, error) {
+	output := new(StandardJSONOutput)
+	if err := json.Unmarshal(data, output); err != nil {
+		return nil, fmt.Errorf("solc: error reading standard JSON output (%v)", err)
+	}
+	var msgs []string
+	for _, e := range output.Errors {
+		if e.Severity == "error" {
+			msg := e.FormattedMessage
+			if msg == "" {
+				msg = e.Message
+			}
+			msgs = append(msgs, msg)
+		}
+	}
+	if len(msgs) > 0 {
+		return nil, fmt.Errorf("solc: compilation failed\n%s", strings.Join(msgs, "\n"))
+	}
+	return output, nil
+}
+
+// Contract returns the compiled contract of the given name. The name can be given as
+// "<source>:<contract>" or just "<contract>" if it is unique among the sources.
+func (o *StandardJSONOutput) Contract(name string) (string, *StandardJSONContract, error) {
+	if idx := strings.LastIndex(name, ":"); idx >= 0 {
+		if c, ok := o.Contracts[name[:idx]][name[idx+1:]]; ok {
+			return name, c, nil
+		}
+		return "", nil, fmt.Errorf("contract %q not found", name)
+	}
+	var (
+		found    *StandardJSONContract
+		fullName string
+	)
+	for source, contracts := range o.Contracts {
+		if c, ok := contracts[name]; ok {
+			if found != nil {
+				return "", nil, fmt.Errorf("ambiguous contract name %q", name)
+			}
+			found, fullName = c, source+":"+name
+		}
+	}
+	if found == nil {
+		return "", nil, fmt.Errorf("contract %q not found", name)
+	}
+	return fullName, found, nil
+}
@@ -0,0 +1,78 @@
+// Copyright 2024 The klaytn Authors
+// This file is part of the klaytn library.
+//
+// The klaytn library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The klaytn library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the klaytn library. If not, see <http://www.gnu.org/licenses/>.
+
+package compiler
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const testStandardJSONOutput = `{
+  "errors": [{"severity": "warning", "type": "Warning", "message": "unused variable"}],
+  "contracts": {
+    "contracts/Token.sol": {
+      "Token": {
+        "abi": [{"type": "function", "name": "totalSupply", "inputs": [], "outputs": [{"name": "", "type": "uint256"}], "stateMutability": "view"}],
+        "metadata": "{}",
+        "evm": {"deployedBytecode": {"object": "6080604052", "immutableReferences": {"12": [{"start": 1, "length": 2}]}}}
+      }
+    },
+    "contracts/Other.sol": {
+      "Token": {"abi": [], "evm": {"deployedBytecode": {"object": ""}}},
+      "Other": {"abi": [], "evm": {"deployedBytecode": {"object": "00"}}}
+    }
+  }
+}`
+
+func TestParseStandardJSONOutput(t *testing.T) {
+	output, err := ParseStandardJSONOutput([]byte(testStandardJSONOutput))
+	assert.NoError(t, err)
+
+	name, c, err := output.Contract("contracts/Token.sol:Token")
+	assert.NoError(t, err)
+	assert.Equal(t, "contracts/Token.sol:Token", name)
+	assert.Equal(t, "6080604052", c.EVM.DeployedBytecode.Object)
+	assert.Equal(t, []ImmutableReference{{Start: 1, Length: 2}}, c.EVM.DeployedBytecode.ImmutableReferences["12"])
+
+	name, _, err = output.Contract("Other")
+	assert.NoError(t, err)
+	assert.Equal(t, "contracts/Other.sol:Other", name)
+
+	_, _, err = output.Contract("Token")
+	assert.Error(t, err)
+	_, _, err = output.Contract("contracts/Other.sol:Unknown")
+	assert.Error(t, err)
+
+	_, err = ParseStandardJSONOutput([]byte(`{"errors": [{"severity": "error", "formattedMessage": "ParserError: expected ';'"}]}`))
+	assert.ErrorContains(t, err, "ParserError")
+}
+
+func TestCompileStandardJSON(t *testing.T) {
+	skipWithoutSolc(t)
+
+	source, _ := json.Marshal(testSource)
+	input := `{"language": "Solidity", "sources": {"test.sol": {"content": ` + string(source) + `}}}`
+	output, err := CompileStandardJSON("", []byte(input))
+	assert.NoError(t, err)
+
+	_, c, err := output.Contract("test")
+	assert.NoError(t, err)
+	assert.NotEmpty(t, c.EVM.DeployedBytecode.Object)
+	assert.NotEmpty(t, c.ABI)
+}
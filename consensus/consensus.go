@@ -163,6 +163,9 @@ type Istanbul interface {
 
 	// UpdateParam updates the governance parameter
 	UpdateParam(num uint64) error
+
+	// WriteTreasuryRebalanceResult stores the treasury rebalance result of the written block
+	WriteTreasuryRebalanceResult(block *types.Block) error
 }
 
 type ConsensusInfo struct {
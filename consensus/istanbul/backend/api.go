@@ -21,6 +21,7 @@
 package backend
 
 import (
+	"encoding/json"
 	"errors"
 	"fmt"
 	"math/big"
@@ -30,6 +31,7 @@ import (
 	"github.com/klaytn/klaytn/blockchain"
 	"github.com/klaytn/klaytn/blockchain/types"
 	"github.com/klaytn/klaytn/common"
+	"github.com/klaytn/klaytn/common/hexutil"
 	"github.com/klaytn/klaytn/consensus"
 	"github.com/klaytn/klaytn/consensus/istanbul"
 	"github.com/klaytn/klaytn/networks/rpc"
@@ -208,6 +210,7 @@ var (
 	errExtractIstanbulExtra    = errors.New("extract Istanbul Extra from block header of the given block number")
 	errNoBlockExist            = errors.New("block with the given block number is not existed")
 	errNoBlockNumber           = errors.New("block number is not assigned")
+	errNoRebalanceResult       = errors.New("treasury rebalance result is not found")
 )
 
 // GetCouncil retrieves the list of authorized validators at the specified block.
@@ -455,6 +458,87 @@ func (api *APIExtension) GetBlockWithConsensusInfoByHash(blockHash common.Hash)
 	return api.makeRPCBlockOutput(block, cInfo, block.Transactions(), receipts), nil
 }
 
+// GetTreasuryRebalanceResult returns the result memo of the treasury rebalance executed at the given block.
+func (api *APIExtension) GetTreasuryRebalanceResult(number rpc.BlockNumber) (json.RawMessage, error) {
+	header, err := headerByRpcNumber(api.chain, &number)
+	if err != nil {
+		return nil, err
+	}
+	if !api.chain.Config().IsTreasuryRebalanceBlock(header.Number) {
+		return nil, errNoTreasuryRebalance
+	}
+	memo, err := api.istanbul.db.ReadTreasuryRebalanceResult(header.Number.Uint64())
+	if err != nil || len(memo) == 0 {
+		return nil, errNoRebalanceResult
+	}
+	return memo, nil
+}
+
+// TreasuryRebalanceDryRunResult is the result of a treasury rebalance executed without changing the state.
+type TreasuryRebalanceDryRunResult struct {
+	BlockNumber hexutil.Uint64           `json:"blockNumber"`
+	Contract    common.Address           `json:"contract"`
+	Result      *TreasuryRebalanceResult `json:"result"`
+	Error       string                   `json:"error,omitempty"`
+}
+
+// DryRunTreasuryRebalance executes the treasury rebalance at the given block without changing the state.
+// The rebalance is executed on the state of the parent block, or the latest state if the block is not mined yet.
+// The latest or pending block number means the next block. If the contract is not given, the contract
+// scheduled at the block in the chain config is used.
+func (api *APIExtension) DryRunTreasuryRebalance(number rpc.BlockNumber, contract *common.Address) (*TreasuryRebalanceDryRunResult, error) {
+	current := api.chain.CurrentHeader()
+
+	var target uint64
+	switch {
+	case number == rpc.LatestBlockNumber || number == rpc.PendingBlockNumber:
+		target = current.Number.Uint64() + 1
+	case number.Int64() > 0:
+		target = uint64(number.Int64())
+	default:
+		return nil, errStartNotPositive
+	}
+
+	if contract == nil {
+		addr, ok := api.chain.Config().TreasuryRebalanceContract(new(big.Int).SetUint64(target))
+		if !ok {
+			return nil, errNoTreasuryRebalance
+		}
+		contract = &addr
+	}
+
+	// Prepare the state before the target block and the header of the target block.
+	var header *types.Header
+	parent := current
+	if target <= current.Number.Uint64() {
+		parent = api.chain.GetHeaderByNumber(target - 1)
+		header = api.chain.GetHeaderByNumber(target)
+		if parent == nil || header == nil {
+			return nil, errUnknownBlock
+		}
+		header = types.CopyHeader(header)
+	} else {
+		header = types.CopyHeader(current)
+		header.ParentHash = current.Hash()
+		header.Number = new(big.Int).SetUint64(target)
+	}
+	state, err := api.chain.StateAt(parent.Root)
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := DryRunTreasuryRebalance(state, api.chain, header, *contract)
+	dryRun := &TreasuryRebalanceDryRunResult{
+		BlockNumber: hexutil.Uint64(target),
+		Contract:    *contract,
+		Result:      result,
+	}
+	if err != nil {
+		dryRun.Error = err.Error()
+	}
+	return dryRun, nil
+}
+
 func (api *API) GetTimeout() uint64 {
 	return istanbul.DefaultConfig.Timeout
 }
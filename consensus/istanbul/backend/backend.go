@@ -54,6 +54,7 @@ func New(rewardbase common.Address, config *istanbul.Config, privateKey *ecdsa.P
 	recents, _ := lru.NewARC(inmemorySnapshots)
 	recentMessages, _ := lru.NewARC(inmemoryPeers)
 	knownMessages, _ := lru.NewARC(inmemoryMessages)
+	rebalanceMemos, _ := lru.NewARC(inmemoryRebalances)
 	backend := &backend{
 		config:            config,
 		istanbulEventMux:  new(event.TypeMux),
@@ -67,6 +68,7 @@ func New(rewardbase common.Address, config *istanbul.Config, privateKey *ecdsa.P
 		coreStarted:       false,
 		recentMessages:    recentMessages,
 		knownMessages:     knownMessages,
+		rebalanceMemos:    rebalanceMemos,
 		rewardbase:        rewardbase,
 		governance:        governance,
 		nodetype:          nodetype,
@@ -110,6 +112,7 @@ type backend struct {
 
 	recentMessages *lru.ARCCache // the cache of peer's messages
 	knownMessages  *lru.ARCCache // the cache of self messages
+	rebalanceMemos *lru.ARCCache // the cache of treasury rebalance memos of finalized blocks
 
 	rewardbase  common.Address
 	currentView atomic.Value //*istanbul.View
@@ -26,6 +26,7 @@ Implementation of Backend interface and APIs are included in this package
  - `backend.go`: Defines backend struct which implements Backend interface working as a backbone of the consensus engine
  - `engine.go`: Implements various backend methods especially for verifying and building header information
  - `handler.go`: Implements backend methods for handling messages and broadcaster
+ - `kip103.go`: Implements treasury rebalancing executed at the KIP-103 block and the scheduled treasury rebalance blocks
  - `snapshot.go`: Defines snapshot struct which handles votes from nodes and makes governance changes
 
 */
@@ -52,6 +52,7 @@ const (
 
 	checkpointInterval = 1024 // Number of blocks after which to save the vote snapshot to the database
 	inmemorySnapshots  = 496  // Number of recent vote snapshots to keep in memory
+	inmemoryRebalances = 16   // Number of treasury rebalance memos of finalized blocks to keep in memory
 	inmemoryPeers      = 200
 	inmemoryMessages   = 4096
 
@@ -505,24 +506,35 @@ func (sb *backend) Finalize(chain consensus.ChainReader, header *types.Header, s
 
 	reward.DistributeBlockReward(state, rewardSpec.Rewards)
 
-	// Only on the KIP-103 hardfork block, the following logic should be executed
-	if chain.Config().IsKIP103ForkBlock(header.Number) {
+	// Only on the KIP-103 hardfork block or the scheduled treasury rebalance blocks,
+	// the following logic should be executed
+	var rebalanceMemo []byte
+	if chain.Config().IsTreasuryRebalanceBlock(header.Number) {
 		// RebalanceTreasury can modify the global state (state),
 		// so the existing state db should be used to apply the rebalancing result.
 		c := &Kip103ContractCaller{state, chain, header}
 		result, err := RebalanceTreasury(state, chain, header, c)
 		if err != nil {
-			logger.Error("failed to execute treasury rebalancing (KIP-103). State not changed", "err", err)
+			logger.Error("failed to execute treasury rebalancing. State not changed", "number", header.Number, "err", err)
+		}
+		memo, marshalErr := json.Marshal(result)
+		if marshalErr != nil {
+			logger.Warn("failed to marshal treasury rebalancing result", "err", marshalErr, "result", result)
 		} else {
-			memo, err := json.Marshal(result)
-			if err != nil {
-				logger.Warn("failed to marshal KIP-103 result", "err", err, "result", result)
-			}
-			logger.Info("successfully executed treasury rebalancing (KIP-103)", "memo", string(memo))
+			rebalanceMemo = memo
+		}
+		if err == nil {
+			logger.Info("successfully executed treasury rebalancing", "number", header.Number, "memo", string(memo))
 		}
 	}
 	header.Root = state.IntermediateRoot(true)
 
+	// The block may never be written (e.g. a discarded proposal or a failed validation),
+	// so the memo is kept until WriteTreasuryRebalanceResult is called for the written block.
+	if rebalanceMemo != nil {
+		sb.rebalanceMemos.Add(rebalanceMemoKey{header.Number.Uint64(), header.Root}, rebalanceMemo)
+	}
+
 	// Assemble and return the final block for sealing
 	return types.NewBlock(header, txs, receipts), nil
 }
@@ -684,6 +696,28 @@ func (sb *backend) UpdateParam(number uint64) error {
 	return nil
 }
 
+// rebalanceMemoKey identifies the finalized block of a treasury rebalance memo.
+// The block hash is not determined yet when a proposal is finalized, so the state root is used.
+type rebalanceMemoKey struct {
+	number uint64
+	root   common.Hash
+}
+
+// WriteTreasuryRebalanceResult implements consensus.Istanbul.WriteTreasuryRebalanceResult and
+// it stores the treasury rebalance memo of the given block, which is kept when it was finalized.
+func (sb *backend) WriteTreasuryRebalanceResult(block *types.Block) error {
+	key := rebalanceMemoKey{block.NumberU64(), block.Root()}
+	memo, ok := sb.rebalanceMemos.Get(key)
+	if !ok || sb.db == nil {
+		return nil
+	}
+	if err := sb.db.WriteTreasuryRebalanceResult(block.NumberU64(), memo.([]byte)); err != nil {
+		return err
+	}
+	sb.rebalanceMemos.Remove(key)
+	return nil
+}
+
 // initSnapshot initializes and stores a new Snapshot.
 func (sb *backend) initSnapshot(chain consensus.ChainReader) (*Snapshot, error) {
 	genesis := chain.GetHeaderByNumber(0)
@@ -19,6 +19,9 @@ import (
 var (
 	errNotEnoughRetiredBal = errors.New("the sum of retired accounts' balance is smaller than the distributing amount")
 	errNotProperStatus     = errors.New("cannot read a proper status value")
+	errMemoAlreadyStored   = errors.New("the contract already has a memo of a finalized rebalance")
+	errNoTreasuryRebalance = errors.New("no treasury rebalance is scheduled at the block")
+	errNoRebalanceBlock    = errors.New("cannot find a proper target block number")
 )
 
 // Kip103ContractCaller is an implementation of contractCaller only for KIP-103.
@@ -55,15 +58,17 @@ func (caller *Kip103ContractCaller) CallContract(ctx context.Context, call klayt
 	return result.Return(), err
 }
 
-type kip103result struct {
+// TreasuryRebalanceResult is the memo of a treasury rebalance. It is logged, stored in the database,
+// and expected to be recorded in the contract by its owner after the rebalance.
+type TreasuryRebalanceResult struct {
 	Retired map[common.Address]*big.Int `json:"retired"`
 	Newbie  map[common.Address]*big.Int `json:"newbie"`
 	Burnt   *big.Int                    `json:"burnt"`
 	Success bool                        `json:"success"`
 }
 
-func newKip103Receipt() *kip103result {
-	return &kip103result{
+func newTreasuryRebalanceResult() *TreasuryRebalanceResult {
+	return &TreasuryRebalanceResult{
 		Retired: make(map[common.Address]*big.Int),
 		Newbie:  make(map[common.Address]*big.Int),
 		Burnt:   big.NewInt(0),
@@ -71,7 +76,7 @@ func newKip103Receipt() *kip103result {
 	}
 }
 
-func (result *kip103result) fillRetired(contract *kip103.TreasuryRebalanceCaller, state *state.StateDB) error {
+func (result *TreasuryRebalanceResult) fillRetired(contract *kip103.TreasuryRebalanceCaller, state *state.StateDB) error {
 	numRetiredBigInt, err := contract.GetRetiredCount(nil)
 	if err != nil {
 		logger.Error("Failed to get RetiredCount from TreasuryRebalance contract", "err", err)
@@ -89,7 +94,7 @@ func (result *kip103result) fillRetired(contract *kip103.TreasuryRebalanceCaller
 	return nil
 }
 
-func (result *kip103result) fillNewbie(contract *kip103.TreasuryRebalanceCaller) error {
+func (result *TreasuryRebalanceResult) fillNewbie(contract *kip103.TreasuryRebalanceCaller) error {
 	numNewbieBigInt, err := contract.GetNewbieCount(nil)
 	if err != nil {
 		logger.Error("Failed to get NewbieCount from TreasuryRebalance contract", "err", err)
@@ -107,7 +112,7 @@ func (result *kip103result) fillNewbie(contract *kip103.TreasuryRebalanceCaller)
 	return nil
 }
 
-func (result *kip103result) totalRetriedBalance() *big.Int {
+func (result *TreasuryRebalanceResult) totalRetriedBalance() *big.Int {
 	total := big.NewInt(0)
 	for _, bal := range result.Retired {
 		total.Add(total, bal)
@@ -115,7 +120,7 @@ func (result *kip103result) totalRetriedBalance() *big.Int {
 	return total
 }
 
-func (result *kip103result) totalNewbieBalance() *big.Int {
+func (result *TreasuryRebalanceResult) totalNewbieBalance() *big.Int {
 	total := big.NewInt(0)
 	for _, bal := range result.Newbie {
 		total.Add(total, bal)
@@ -126,10 +131,29 @@ func (result *kip103result) totalNewbieBalance() *big.Int {
 // RebalanceTreasury reads data from a contract, validates stored values, and executes treasury rebalancing (KIP-103).
 // It can change the global state by removing old treasury balances and allocating new treasury balances.
 // The new allocation can be larger than the removed amount, and the difference between two amounts will be burnt.
-func RebalanceTreasury(state *state.StateDB, chain consensus.ChainReader, header *types.Header, c bind.ContractCaller) (*kip103result, error) {
-	result := newKip103Receipt()
+// The contract is the one scheduled at the block of the header, which is either the KIP-103 contract or
+// one of the treasury rebalances in the chain config.
+func RebalanceTreasury(state *state.StateDB, chain consensus.ChainReader, header *types.Header, c bind.ContractCaller) (*TreasuryRebalanceResult, error) {
+	contract, ok := chain.Config().TreasuryRebalanceContract(header.Number)
+	if !ok {
+		return newTreasuryRebalanceResult(), errNoTreasuryRebalance
+	}
+	return rebalanceTreasury(state, header, contract, c)
+}
+
+// DryRunTreasuryRebalance executes the treasury rebalance of the contract on a copy of the given state
+// as if the header is the target block. The given state is not modified.
+// Note that block rewards distributed in the target block are not considered.
+func DryRunTreasuryRebalance(state *state.StateDB, chain consensus.ChainReader, header *types.Header, contract common.Address) (*TreasuryRebalanceResult, error) {
+	dryRunState := state.Copy()
+	c := &Kip103ContractCaller{dryRunState, chain, header}
+	return rebalanceTreasury(dryRunState, header, contract, c)
+}
+
+func rebalanceTreasury(state *state.StateDB, header *types.Header, contract common.Address, c bind.ContractCaller) (*TreasuryRebalanceResult, error) {
+	result := newTreasuryRebalanceResult()
 
-	caller, err := kip103.NewTreasuryRebalanceCaller(chain.Config().Kip103ContractAddress, c)
+	caller, err := kip103.NewTreasuryRebalanceCaller(contract, c)
 	if err != nil {
 		return result, err
 	}
@@ -146,7 +170,7 @@ func RebalanceTreasury(state *state.StateDB, chain consensus.ChainReader, header
 
 	// Validation 1) Check the target block number
 	if blockNum, err := caller.RebalanceBlockNumber(nil); err != nil || blockNum.Cmp(header.Number) != 0 {
-		return result, errors.New("cannot find a proper target block number")
+		return result, errNoRebalanceBlock
 	}
 
 	// Validation 2) Check whether status is approved. It should be 2 meaning approved
@@ -159,7 +183,15 @@ func RebalanceTreasury(state *state.StateDB, chain consensus.ChainReader, header
 		return result, err
 	}
 
-	// Validation 4) Check the total balance of retirees are bigger than the distributing amount
+	// Validation 4) Check the memo is empty. A memo is recorded only after the rebalance is executed,
+	// so a contract with a memo must not be executed again.
+	if memo, err := caller.Memo(nil); err != nil {
+		return result, err
+	} else if memo != "" {
+		return result, errMemoAlreadyStored
+	}
+
+	// Validation 5) Check the total balance of retirees are bigger than the distributing amount
 	totalRetiredAmount := result.totalRetriedBalance()
 	totalNewbieAmount := result.totalNewbieBalance()
 	if totalRetiredAmount.Cmp(totalNewbieAmount) < 0 {
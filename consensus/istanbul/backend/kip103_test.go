@@ -11,6 +11,7 @@ import (
 
 	"github.com/klaytn/klaytn"
 	"github.com/klaytn/klaytn/accounts/abi"
+	"github.com/klaytn/klaytn/blockchain/types"
 	"github.com/klaytn/klaytn/common"
 	"github.com/klaytn/klaytn/common/hexutil"
 	"github.com/klaytn/klaytn/contracts/kip103"
@@ -98,6 +99,7 @@ func TestRebalanceTreasury(t *testing.T) {
 
 	defaultReturnMap["rebalanceBlockNumber"] = []interface{}{block.Number()}
 	defaultReturnMap["status"] = []interface{}{uint8(2)}
+	defaultReturnMap["memo"] = []interface{}{""}
 
 	testCases := []struct {
 		modifier func(retMap map[string][]interface{})
@@ -116,6 +118,12 @@ func TestRebalanceTreasury(t *testing.T) {
 			},
 			errNotProperStatus,
 		},
+		{
+			func(retMap map[string][]interface{}) {
+				retMap["memo"] = []interface{}{`{"success":true}`}
+			},
+			errMemoAlreadyStored,
+		},
 		{
 			func(retMap map[string][]interface{}) {
 				retMap["newbies0"][1] = totalRetiredBalance
@@ -178,3 +186,94 @@ func TestRebalanceTreasury(t *testing.T) {
 		t.Log(string(memo))
 	}
 }
+
+func TestRebalanceTreasury_Scheduled(t *testing.T) {
+	bc, istBackend := newBlockChain(1)
+	defer func() {
+		istBackend.Stop()
+		bc.Stop()
+	}()
+
+	parsed, err := abi.JSON(strings.NewReader(kip103.TreasuryRebalanceABI))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// time to generate blocks
+	time.Sleep(2 * time.Second)
+
+	block := bc.CurrentBlock()
+
+	retired := common.HexToAddress("0x9712f943b296758aaae79944ec975884188d3a96")
+	newbie := common.HexToAddress("0x819104a190255e0cedbdd9d5f59a557633d79db1")
+	amount := new(big.Int).Mul(big.NewInt(1000), big.NewInt(params.KLAY))
+
+	retMap := map[string][]interface{}{
+		"getRetiredCount":      {big.NewInt(1)},
+		"retirees0":            {retired},
+		"getNewbieCount":       {big.NewInt(1)},
+		"newbies0":             {newbie, amount},
+		"rebalanceBlockNumber": {block.Number()},
+		"status":               {uint8(2)},
+		"memo":                 {""},
+	}
+	c := &mockKip103ContractCaller{abi: parsed, funcSigMap: kip103.TreasuryRebalanceFuncSigs, retMap: retMap}
+
+	// not scheduled
+	bc.Config().Kip103CompatibleBlock = nil
+	bc.Config().TreasuryRebalances = nil
+	assert.False(t, bc.Config().IsTreasuryRebalanceBlock(block.Number()))
+
+	state, err := bc.StateAt(block.Root())
+	if err != nil {
+		t.Fatal(err)
+	}
+	state.SetBalance(retired, new(big.Int).Mul(amount, big.NewInt(2)))
+
+	_, err = RebalanceTreasury(state, bc, block.Header(), c)
+	assert.Equal(t, errNoTreasuryRebalance, err)
+
+	// scheduled as one of the treasury rebalances after a previous rebalance
+	bc.Config().TreasuryRebalances = []*params.TreasuryRebalanceConfig{
+		{Block: new(big.Int).Sub(block.Number(), common.Big1), Contract: common.HexToAddress("0x1")},
+		{Block: block.Number(), Contract: common.HexToAddress("0x2")},
+	}
+	defer func() { bc.Config().TreasuryRebalances = nil }()
+	assert.True(t, bc.Config().IsTreasuryRebalanceBlock(block.Number()))
+
+	ret, err := RebalanceTreasury(state, bc, block.Header(), c)
+	assert.NoError(t, err)
+	assert.True(t, ret.Success)
+	assert.Equal(t, amount, ret.Burnt)
+	assert.Equal(t, big.NewInt(0), state.GetBalance(retired))
+	assert.Equal(t, amount, state.GetBalance(newbie))
+}
+
+func TestRebalanceTreasury_WriteResult(t *testing.T) {
+	chain, engine := newBlockChain(1)
+	defer func() {
+		engine.Stop()
+		chain.Stop()
+	}()
+
+	chain.Config().TreasuryRebalances = []*params.TreasuryRebalanceConfig{
+		{Block: big.NewInt(1), Contract: common.HexToAddress("0x9c0a3e3b8b3b4e4a2e2c5c5c9d7d2c1b4f6a8e01")}, // not deployed
+	}
+	defer func() { chain.Config().TreasuryRebalances = nil }()
+
+	// finalizing a block does not store the result
+	block := makeBlockWithSeal(chain, engine, chain.Genesis())
+	_, err := engine.db.ReadTreasuryRebalanceResult(1)
+	assert.Error(t, err)
+
+	// the result is stored when the block is written
+	_, err = chain.InsertChain(types.Blocks{block})
+	assert.NoError(t, err)
+
+	memo, err := engine.db.ReadTreasuryRebalanceResult(1)
+	assert.NoError(t, err)
+
+	result := newTreasuryRebalanceResult()
+	assert.NoError(t, json.Unmarshal(memo, result))
+	assert.False(t, result.Success)
+}
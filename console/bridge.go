@@ -394,6 +394,11 @@ func (b *bridge) Send(call otto.FunctionCall) (response otto.Value) {
 			}
 		case rpc.Error:
 			setError(resp, err.ErrorCode(), err.Error())
+			// Keep the error data such as the revert data to be decoded by the contract helpers
+			if dataErr, ok := err.(rpc.DataError); ok && dataErr.ErrorData() != nil {
+				errObj, _ := resp.Get("error")
+				errObj.Object().Set("data", dataErr.ErrorData())
+			}
 		default:
 			setError(resp, -32603, err.Error())
 		}
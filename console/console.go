@@ -63,6 +63,7 @@ type Config struct {
 	Prompter UserPrompter // Input prompter to allow interactive user feedback (defaults to TerminalPrompter)
 	Printer  io.Writer    // Output writer to serialize any display strings to (defaults to os.Stdout)
 	Preload  []string     // Absolute paths to JavaScript files to preload
+	ABIDir   string       // Directory to load contract ABIs by name (defaults to ABIDirName in DataDir)
 }
 
 // Console is a JavaScript interpreted runtime environment. It is a fully fleged
@@ -76,6 +77,7 @@ type Console struct {
 	histPath string       // Absolute path to the console scrollback history
 	history  []string     // Scroll history maintained by the console
 	printer  io.Writer    // Output writer to serialize any display strings to
+	abiDir   string       // Directory to load contract ABIs by name
 }
 
 func New(config Config) (*Console, error) {
@@ -89,6 +91,9 @@ func New(config Config) (*Console, error) {
 	if config.Printer == nil {
 		config.Printer = colorable.NewColorableStdout()
 	}
+	if config.ABIDir == "" {
+		config.ABIDir = filepath.Join(config.DataDir, ABIDirName)
+	}
 	// Initialize the console and return
 	console := &Console{
 		client:   config.Client,
@@ -97,6 +102,7 @@ func New(config Config) (*Console, error) {
 		prompter: config.Prompter,
 		printer:  config.Printer,
 		histPath: filepath.Join(config.DataDir, HistoryFile),
+		abiDir:   config.ABIDir,
 	}
 	if err := os.MkdirAll(config.DataDir, 0o700); err != nil {
 		return nil, err
@@ -159,6 +165,18 @@ func (c *Console) init(preload []string) error {
 	if _, err = c.jsre.Run(flatten); err != nil {
 		return fmt.Errorf("namespace flattening: %v", err)
 	}
+	// Load the contract helpers encoding and decoding contract calls with the ABIs
+	helper := newContractHelper(c.abiDir)
+	jethObj.Object().Set("abiLoad", helper.Load)
+	jethObj.Object().Set("abiList", helper.List)
+	jethObj.Object().Set("abiMethods", helper.Methods)
+	jethObj.Object().Set("abiEncode", helper.Encode)
+	jethObj.Object().Set("abiDecode", helper.Decode)
+	jethObj.Object().Set("abiDecodeLog", helper.DecodeLog)
+	jethObj.Object().Set("abiDecodeError", helper.DecodeError)
+	if _, err = c.jsre.Run(contractJS); err != nil {
+		return fmt.Errorf("contract helpers: %v", err)
+	}
 	// Initialize the global name register (disabled for now)
 	// c.jsre.Run(`var GlobalRegistrar = klay.contract(` + registrar.GlobalRegistrarAbi + `);   registrar = GlobalRegistrar.at("` + registrar.GlobalRegistrarAddr + `");`)
 
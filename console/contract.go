@@ -0,0 +1,815 @@
+// Copyright 2024 The klaytn Authors
+// This file is part of the klaytn library.
+//
+// The klaytn library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The klaytn library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the klaytn library. If not, see <http://www.gnu.org/licenses/>.
+
+package console
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"os"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/klaytn/klaytn/accounts/abi"
+	"github.com/klaytn/klaytn/common"
+	"github.com/klaytn/klaytn/common/hexutil"
+	"github.com/klaytn/klaytn/crypto"
+	"github.com/robertkrimen/otto"
+)
+
+// ABIDirName is the directory within the data directory to load ABI JSON files by name.
+const ABIDirName = "abi"
+
+var (
+	errABINotFound      = errors.New("abi is neither a JSON, a file nor a name in the ABI directory")
+	errInvalidABIName   = errors.New("invalid abi name")
+	errNoABIInArtifact  = errors.New("no abi in the JSON object")
+	errMethodNotFound   = errors.New("method not found in the abi")
+	errEventNotFound    = errors.New("event not found in the abi")
+	errArgumentMismatch = errors.New("argument count mismatch")
+
+	panicSelector = crypto.Keccak256([]byte("Panic(uint256)"))[:4]
+)
+
+// contractHelper is a collection of JavaScript utility methods to encode and decode
+// contract calls with accounts/abi. ABIs and values are exchanged in JSON strings
+// and the user facing `abi` and `contract` objects are defined in contractJS.
+type contractHelper struct {
+	abiDir string // Directory to load ABI JSON files by name
+}
+
+func newContractHelper(abiDir string) *contractHelper {
+	return &contractHelper{abiDir: abiDir}
+}
+
+// Load returns the ABI JSON of the given source which is an ABI JSON, a path of an ABI file
+// or a name of an ABI file in the ABI directory. Compiler artifacts having an "abi" field are also accepted.
+func (h *contractHelper) Load(call otto.FunctionCall) otto.Value {
+	source, err := call.Argument(0).ToString()
+	if err != nil {
+		throwJSException(err.Error())
+	}
+	loaded, err := h.load(source)
+	if err != nil {
+		throwJSException(err.Error())
+	}
+	return toJSValue(string(loaded))
+}
+
+// List returns the names of the ABI files in the ABI directory.
+func (h *contractHelper) List(call otto.FunctionCall) otto.Value {
+	names := []string{}
+	entries, err := os.ReadDir(h.abiDir)
+	if err != nil && !os.IsNotExist(err) {
+		throwJSException(err.Error())
+	}
+	for _, entry := range entries {
+		if ext := filepath.Ext(entry.Name()); !entry.IsDir() && (ext == ".json" || ext == ".abi") {
+			names = append(names, strings.TrimSuffix(entry.Name(), ext))
+		}
+	}
+	sort.Strings(names)
+	return toJSONValue(names)
+}
+
+// Methods returns the methods of the ABI with the information to build typed methods.
+func (h *contractHelper) Methods(call otto.FunctionCall) otto.Value {
+	parsed := parseABIArgument(call, 0)
+
+	type methodInfo struct {
+		Name      string `json:"name"`
+		RawName   string `json:"rawName"`
+		Signature string `json:"signature"`
+		Constant  bool   `json:"constant"`
+		Payable   bool   `json:"payable"`
+		Inputs    int    `json:"inputs"`
+		Outputs   int    `json:"outputs"`
+	}
+	methods := make([]methodInfo, 0, len(parsed.Methods))
+	for name, method := range parsed.Methods {
+		methods = append(methods, methodInfo{
+			Name:      name,
+			RawName:   method.RawName,
+			Signature: method.Sig,
+			Constant:  method.IsConstant(),
+			Payable:   method.IsPayable(),
+			Inputs:    len(method.Inputs),
+			Outputs:   len(method.Outputs),
+		})
+	}
+	sort.Slice(methods, func(i, j int) bool { return methods[i].Name < methods[j].Name })
+	return toJSONValue(methods)
+}
+
+// Encode returns the hex encoded calldata of the method with the arguments given in a JSON array.
+// The constructor arguments are encoded if the method name is empty.
+func (h *contractHelper) Encode(call otto.FunctionCall) otto.Value {
+	parsed := parseABIArgument(call, 0)
+	name, _ := call.Argument(1).ToString()
+	argsJSON, _ := call.Argument(2).ToString()
+
+	var inputs abi.Arguments
+	if name == "" {
+		inputs = parsed.Constructor.Inputs
+	} else if method, ok := parsed.Methods[name]; ok {
+		inputs = method.Inputs
+	} else {
+		throwJSException(fmt.Sprintf("%v: %s", errMethodNotFound, name))
+	}
+	args, err := convertABIArguments(inputs, argsJSON)
+	if err != nil {
+		throwJSException(err.Error())
+	}
+	data, err := parsed.Pack(name, args...)
+	if err != nil {
+		throwJSException(err.Error())
+	}
+	return toJSValue(hexutil.Encode(data))
+}
+
+// Decode returns the outputs of the method decoded from the hex encoded return data.
+func (h *contractHelper) Decode(call otto.FunctionCall) otto.Value {
+	parsed := parseABIArgument(call, 0)
+	name, _ := call.Argument(1).ToString()
+	data := parseHexArgument(call, 2)
+
+	method, ok := parsed.Methods[name]
+	if !ok {
+		throwJSException(fmt.Sprintf("%v: %s", errMethodNotFound, name))
+	}
+	values, err := method.Outputs.UnpackValues(data)
+	if err != nil {
+		throwJSException(err.Error())
+	}
+	if len(values) == 1 {
+		return toJSONValue(formatABIValue(reflect.ValueOf(values[0])))
+	}
+	outputs := make(map[string]interface{}, 2*len(values))
+	for i, value := range values {
+		formatted := formatABIValue(reflect.ValueOf(value))
+		outputs[fmt.Sprint(i)] = formatted
+		if name := method.Outputs[i].Name; name != "" {
+			outputs[name] = formatted
+		}
+	}
+	return toJSONValue(outputs)
+}
+
+// DecodeLog returns the event name and the arguments of the given log in JSON.
+// It returns null if the log is not an event of the ABI.
+func (h *contractHelper) DecodeLog(call otto.FunctionCall) otto.Value {
+	parsed := parseABIArgument(call, 0)
+	logJSON, _ := call.Argument(1).ToString()
+
+	var log struct {
+		Address common.Address `json:"address"`
+		Topics  []common.Hash  `json:"topics"`
+		Data    hexutil.Bytes  `json:"data"`
+	}
+	if err := json.Unmarshal([]byte(logJSON), &log); err != nil {
+		throwJSException(err.Error())
+	}
+	decoded, err := decodeLog(parsed, log.Topics, log.Data)
+	if err == errEventNotFound {
+		return otto.NullValue()
+	} else if err != nil {
+		throwJSException(err.Error())
+	}
+	decoded["address"] = log.Address.Hex()
+	return toJSONValue(decoded)
+}
+
+// DecodeError returns a human readable reason of the hex encoded revert data. Error(string),
+// Panic(uint256) and the custom errors defined in the ABI are decoded. It returns null if
+// the data cannot be decoded.
+func (h *contractHelper) DecodeError(call otto.FunctionCall) otto.Value {
+	abiJSON, _ := call.Argument(0).ToString()
+	data := parseHexArgument(call, 1)
+
+	reason, err := decodeRevert(abiJSON, data)
+	if err != nil {
+		return otto.NullValue()
+	}
+	return toJSValue(reason)
+}
+
+// load resolves the ABI source into an ABI JSON array.
+func (h *contractHelper) load(source string) ([]byte, error) {
+	source = strings.TrimSpace(source)
+
+	var blob []byte
+	switch {
+	case strings.HasPrefix(source, "[") || strings.HasPrefix(source, "{"):
+		blob = []byte(source)
+	case fileExists(source):
+		content, err := os.ReadFile(source)
+		if err != nil {
+			return nil, err
+		}
+		blob = content
+	default:
+		if source == "" || filepath.Base(source) != source {
+			return nil, errInvalidABIName
+		}
+		for _, ext := range []string{".json", ".abi"} {
+			if path := filepath.Join(h.abiDir, source+ext); fileExists(path) {
+				content, err := os.ReadFile(path)
+				if err != nil {
+					return nil, err
+				}
+				blob = content
+				break
+			}
+		}
+		if blob == nil {
+			return nil, errABINotFound
+		}
+	}
+
+	// Compiler artifacts such as truffle or hardhat ones have the ABI in the "abi" field.
+	blob = bytes.TrimSpace(blob)
+	if len(blob) > 0 && blob[0] == '{' {
+		var artifact struct {
+			ABI json.RawMessage `json:"abi"`
+		}
+		if err := json.Unmarshal(blob, &artifact); err != nil {
+			return nil, err
+		}
+		if len(artifact.ABI) == 0 {
+			return nil, errNoABIInArtifact
+		}
+		blob = artifact.ABI
+	}
+	if _, err := parseABI(blob); err != nil {
+		return nil, err
+	}
+	return blob, nil
+}
+
+// parseABI parses the ABI JSON array.
+func parseABI(abiJSON []byte) (abi.ABI, error) {
+	return abi.JSON(bytes.NewReader(abiJSON))
+}
+
+func fileExists(path string) bool {
+	info, err := os.Stat(path)
+	return err == nil && !info.IsDir()
+}
+
+// decodeLog decodes the indexed and non-indexed arguments of the event matching the first topic.
+func decodeLog(parsed abi.ABI, topics []common.Hash, data []byte) (map[string]interface{}, error) {
+	if len(topics) == 0 {
+		return nil, errEventNotFound
+	}
+	event, err := parsed.EventByID(topics[0])
+	if err != nil {
+		return nil, errEventNotFound
+	}
+	values := make(map[string]interface{})
+	if len(data) > 0 {
+		if err := event.Inputs.NonIndexed().UnpackIntoMap(values, data); err != nil {
+			return nil, err
+		}
+	}
+	var indexed abi.Arguments
+	for _, input := range event.Inputs {
+		if input.Indexed {
+			indexed = append(indexed, input)
+		}
+	}
+	if err := abi.ParseTopicsIntoMap(values, indexed, topics[1:]); err != nil {
+		return nil, err
+	}
+
+	args := make(map[string]interface{}, len(values))
+	for name, value := range values {
+		args[name] = formatABIValue(reflect.ValueOf(value))
+	}
+	return map[string]interface{}{
+		"event":     event.Name,
+		"signature": event.Sig,
+		"args":      args,
+	}, nil
+}
+
+// decodeRevert decodes the revert data with Error(string), Panic(uint256) and the custom errors in the ABI JSON.
+func decodeRevert(abiJSON string, data []byte) (string, error) {
+	if reason, err := abi.UnpackRevert(data); err == nil {
+		return reason, nil
+	}
+	if len(data) < 4 {
+		return "", errors.New("invalid data for unpacking")
+	}
+	if bytes.Equal(data[:4], panicSelector) {
+		code := new(big.Int).SetBytes(data[4:])
+		return fmt.Sprintf("panic: 0x%x", code), nil
+	}
+
+	parsed, err := parseABI([]byte(abiJSON))
+	if err != nil {
+		return "", err
+	}
+	customErr, err := parsed.ErrorByID(data)
+	if err != nil {
+		return "", err
+	}
+	values, err := customErr.UnpackValues(data)
+	if err != nil {
+		return "", err
+	}
+	args := make([]string, len(values))
+	for i, value := range values {
+		formatted, _ := json.Marshal(formatABIValue(reflect.ValueOf(value)))
+		args[i] = fmt.Sprintf("%s: %s", customErr.Inputs[i].Name, formatted)
+	}
+	return fmt.Sprintf("%s(%s)", customErr.RawName, strings.Join(args, ", ")), nil
+}
+
+// convertABIArguments converts the arguments given in a JSON array into the Go values of the ABI types.
+func convertABIArguments(inputs abi.Arguments, argsJSON string) ([]interface{}, error) {
+	var args []interface{}
+	if argsJSON != "" && argsJSON != "undefined" {
+		dec := json.NewDecoder(strings.NewReader(argsJSON))
+		dec.UseNumber() // avoid float64s
+		if err := dec.Decode(&args); err != nil {
+			return nil, err
+		}
+	}
+	if len(args) != len(inputs) {
+		return nil, fmt.Errorf("%v: expected %d, got %d", errArgumentMismatch, len(inputs), len(args))
+	}
+	values := make([]interface{}, len(args))
+	for i, arg := range args {
+		value, err := convertABIValue(inputs[i].Type, arg)
+		if err != nil {
+			return nil, fmt.Errorf("argument %d: %v", i, err)
+		}
+		values[i] = value.Interface()
+	}
+	return values, nil
+}
+
+// convertABIValue converts a JSON decoded value into the Go value of the ABI type.
+func convertABIValue(t abi.Type, v interface{}) (reflect.Value, error) {
+	typ := t.GetType()
+	switch t.T {
+	case abi.IntTy, abi.UintTy:
+		n, err := toBigInt(v)
+		if err != nil {
+			return reflect.Value{}, err
+		}
+		if err := checkIntRange(t, n); err != nil {
+			return reflect.Value{}, err
+		}
+		if typ == reflect.TypeOf(n) {
+			return reflect.ValueOf(n), nil
+		}
+		rv := reflect.New(typ).Elem()
+		if t.T == abi.IntTy {
+			rv.SetInt(n.Int64())
+		} else {
+			rv.SetUint(n.Uint64())
+		}
+		return rv, nil
+
+	case abi.BoolTy:
+		b, ok := v.(bool)
+		if !ok {
+			return reflect.Value{}, fmt.Errorf("%v is not a bool", v)
+		}
+		return reflect.ValueOf(b), nil
+
+	case abi.StringTy:
+		s, ok := v.(string)
+		if !ok {
+			return reflect.Value{}, fmt.Errorf("%v is not a string", v)
+		}
+		return reflect.ValueOf(s), nil
+
+	case abi.AddressTy:
+		s, ok := v.(string)
+		if !ok || !common.IsHexAddress(s) {
+			return reflect.Value{}, fmt.Errorf("%v is not an address", v)
+		}
+		return reflect.ValueOf(common.HexToAddress(s)), nil
+
+	case abi.BytesTy, abi.FixedBytesTy, abi.FunctionTy:
+		s, ok := v.(string)
+		if !ok {
+			return reflect.Value{}, fmt.Errorf("%v is not a hex string", v)
+		}
+		b, err := hexutil.Decode(s)
+		if err != nil {
+			return reflect.Value{}, err
+		}
+		if t.T == abi.BytesTy {
+			return reflect.ValueOf(b), nil
+		}
+		rv := reflect.New(typ).Elem()
+		if len(b) > rv.Len() {
+			return reflect.Value{}, fmt.Errorf("%v is longer than %v", s, t)
+		}
+		reflect.Copy(rv, reflect.ValueOf(b))
+		return rv, nil
+
+	case abi.SliceTy, abi.ArrayTy:
+		elems, ok := v.([]interface{})
+		if !ok {
+			return reflect.Value{}, fmt.Errorf("%v is not an array", v)
+		}
+		var rv reflect.Value
+		if t.T == abi.SliceTy {
+			rv = reflect.MakeSlice(typ, len(elems), len(elems))
+		} else if len(elems) != t.Size {
+			return reflect.Value{}, fmt.Errorf("%v requires %d elements, got %d", t, t.Size, len(elems))
+		} else {
+			rv = reflect.New(typ).Elem()
+		}
+		for i, elem := range elems {
+			ev, err := convertABIValue(*t.Elem, elem)
+			if err != nil {
+				return reflect.Value{}, err
+			}
+			rv.Index(i).Set(ev)
+		}
+		return rv, nil
+
+	case abi.TupleTy:
+		rv := reflect.New(typ).Elem()
+		for i, elemType := range t.TupleElems {
+			var elem interface{}
+			switch fields := v.(type) {
+			case []interface{}:
+				if len(fields) != len(t.TupleElems) {
+					return reflect.Value{}, fmt.Errorf("%v requires %d fields, got %d", t, len(t.TupleElems), len(fields))
+				}
+				elem = fields[i]
+			case map[string]interface{}:
+				value, ok := fields[t.TupleRawNames[i]]
+				if !ok {
+					return reflect.Value{}, fmt.Errorf("missing field %s of %v", t.TupleRawNames[i], t)
+				}
+				elem = value
+			default:
+				return reflect.Value{}, fmt.Errorf("%v is not a tuple", v)
+			}
+			ev, err := convertABIValue(*elemType, elem)
+			if err != nil {
+				return reflect.Value{}, err
+			}
+			rv.Field(i).Set(ev)
+		}
+		return rv, nil
+	}
+	return reflect.Value{}, fmt.Errorf("unsupported type %v", t)
+}
+
+// toBigInt converts a JSON number or a decimal or hex string into a big integer.
+func toBigInt(v interface{}) (*big.Int, error) {
+	var s string
+	switch x := v.(type) {
+	case json.Number:
+		s = x.String()
+	case string:
+		s = x
+	default:
+		return nil, fmt.Errorf("%v is not a number", v)
+	}
+	n, ok := new(big.Int).SetString(s, 0)
+	if !ok {
+		return nil, fmt.Errorf("%v is not an integer", v)
+	}
+	return n, nil
+}
+
+// checkIntRange checks the integer fits in the integer type.
+func checkIntRange(t abi.Type, n *big.Int) error {
+	if t.T == abi.UintTy {
+		if n.Sign() < 0 || n.BitLen() > t.Size {
+			return fmt.Errorf("%v overflows %v", n, t)
+		}
+		return nil
+	}
+	max := new(big.Int).Lsh(common.Big1, uint(t.Size-1))
+	min := new(big.Int).Neg(max)
+	if n.Cmp(min) < 0 || n.Cmp(max) >= 0 {
+		return fmt.Errorf("%v overflows %v", n, t)
+	}
+	return nil
+}
+
+// formatABIValue converts a Go value decoded by accounts/abi into a JSON friendly value.
+// Integers are converted into decimal strings not to lose their precision in JavaScript.
+func formatABIValue(v reflect.Value) interface{} {
+	if !v.IsValid() {
+		return nil
+	}
+	switch x := v.Interface().(type) {
+	case *big.Int:
+		return x.String()
+	case common.Address:
+		return x.Hex()
+	case common.Hash:
+		return x.Hex()
+	case []byte:
+		return hexutil.Encode(x)
+	}
+	switch v.Kind() {
+	case reflect.Ptr, reflect.Interface:
+		return formatABIValue(v.Elem())
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return fmt.Sprint(v.Int())
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return fmt.Sprint(v.Uint())
+	case reflect.Array:
+		if v.Type().Elem().Kind() == reflect.Uint8 {
+			b := make([]byte, v.Len())
+			reflect.Copy(reflect.ValueOf(b), v)
+			return hexutil.Encode(b)
+		}
+		fallthrough
+	case reflect.Slice:
+		list := make([]interface{}, v.Len())
+		for i := range list {
+			list[i] = formatABIValue(v.Index(i))
+		}
+		return list
+	case reflect.Struct:
+		fields := make(map[string]interface{}, v.NumField())
+		for i := 0; i < v.NumField(); i++ {
+			field := v.Type().Field(i)
+			name := field.Tag.Get("json")
+			if name == "" {
+				name = field.Name
+			}
+			fields[name] = formatABIValue(v.Field(i))
+		}
+		return fields
+	}
+	return v.Interface()
+}
+
+func parseABIArgument(call otto.FunctionCall, i int) abi.ABI {
+	abiJSON, err := call.Argument(i).ToString()
+	if err != nil {
+		throwJSException(err.Error())
+	}
+	parsed, err := parseABI([]byte(abiJSON))
+	if err != nil {
+		throwJSException(err.Error())
+	}
+	return parsed
+}
+
+func parseHexArgument(call otto.FunctionCall, i int) []byte {
+	s, err := call.Argument(i).ToString()
+	if err != nil {
+		throwJSException(err.Error())
+	}
+	data, err := hexutil.Decode(s)
+	if err != nil {
+		throwJSException(err.Error())
+	}
+	return data
+}
+
+func toJSValue(v interface{}) otto.Value {
+	val, err := otto.ToValue(v)
+	if err != nil {
+		throwJSException(err.Error())
+	}
+	return val
+}
+
+func toJSONValue(v interface{}) otto.Value {
+	blob, err := json.Marshal(v)
+	if err != nil {
+		throwJSException(err.Error())
+	}
+	return toJSValue(string(blob))
+}
+
+// contractJS defines the user facing `abi` and `contract` objects in the console.
+// The typed methods of a contract object call the view methods and send transactions
+// to the other methods. The last argument of a method can be an object of options such
+// as from, value, gas, gasPrice, nonce, typeInt, feePayer, feeRatio and block.
+const contractJS = `
+var abi = (function(helper) {
+	var toJSON = function(def) {
+		return typeof def === 'string' ? def : JSON.stringify(def);
+	};
+	return {
+		load: function(source) { return JSON.parse(helper.load(toJSON(source))); },
+		list: function() { return JSON.parse(helper.list()); },
+		methods: function(def) { return JSON.parse(helper.methods(toJSON(def))); },
+		encode: function(def, method, args) { return helper.encode(toJSON(def), method || '', JSON.stringify(args || [])); },
+		decode: function(def, method, data) { return JSON.parse(helper.decode(toJSON(def), method, data)); },
+		decodeLog: function(def, log) {
+			var decoded = helper.decodeLog(toJSON(def), JSON.stringify(log));
+			return decoded === null ? null : JSON.parse(decoded);
+		},
+		decodeError: function(def, data) { return helper.decodeError(toJSON(def), data); }
+	};
+})({
+	load: jeth.abiLoad,
+	list: jeth.abiList,
+	methods: jeth.abiMethods,
+	encode: jeth.abiEncode,
+	decode: jeth.abiDecode,
+	decodeLog: jeth.abiDecodeLog,
+	decodeError: jeth.abiDecodeError
+});
+
+var contract = (function(abi, web3, send) {
+	var rpc = function(method, params) {
+		var res = send({jsonrpc: '2.0', id: 1, method: method, params: params});
+		if (res.error) {
+			var err = new Error(res.error.message);
+			err.data = res.error.data;
+			throw err;
+		}
+		return res.result;
+	};
+	var toBlock = function(block) {
+		if (block === undefined || block === null) {
+			return 'latest';
+		}
+		return typeof block === 'string' && isNaN(block) ? block : web3.toHex(block);
+	};
+	var revertError = function(def, e) {
+		if (typeof e.data === 'string' && e.data.length > 2) {
+			var reason = abi.decodeError(def, e.data);
+			if (reason !== null) {
+				var err = new Error('execution reverted: ' + reason);
+				err.data = e.data;
+				return err;
+			}
+		}
+		return e;
+	};
+	var splitOptions = function(args, inputs) {
+		args = Array.prototype.slice.call(args);
+		var opts = {};
+		if (args.length === inputs + 1) {
+			opts = args.pop() || {};
+		}
+		if (args.length !== inputs) {
+			throw new Error('expected ' + inputs + ' arguments, got ' + args.length);
+		}
+		return {args: args, opts: opts};
+	};
+
+	var call = function(c, m, args, opts) {
+		var tx = {to: c.address, data: abi.encode(c.abi, m.name, args)};
+		if (opts.from) {
+			tx.from = opts.from;
+		}
+		if (opts.value !== undefined && opts.value !== null) {
+			tx.value = web3.toHex(opts.value);
+		}
+		var out;
+		try {
+			out = rpc('klay_call', [tx, toBlock(opts.block)]);
+		} catch (e) {
+			throw revertError(c.abi, e);
+		}
+		if (m.outputs === 0) {
+			return null;
+		}
+		if (out === '0x') {
+			throw new Error('empty return data, the contract may not exist at ' + c.address);
+		}
+		return abi.decode(c.abi, m.name, out);
+	};
+
+	var transact = function(c, m, args, opts) {
+		var tx = {from: opts.from || web3.klay.defaultAccount, to: c.address, data: abi.encode(c.abi, m.name, args)};
+		if (!tx.from) {
+			throw new Error('from is required to send a transaction');
+		}
+		['value', 'gas', 'gasPrice', 'maxFeePerGas', 'maxPriorityFeePerGas', 'nonce'].forEach(function(field) {
+			if (opts[field] !== undefined && opts[field] !== null) {
+				tx[field] = web3.toHex(opts[field]);
+			}
+		});
+		if (opts.typeInt !== undefined) {
+			tx.typeInt = opts.typeInt;
+		}
+		if (!opts.feePayer) {
+			if (tx.typeInt !== undefined && tx.value === undefined) {
+				tx.value = '0x0'; // value is required for Klaytn transaction types
+			}
+			return rpc('klay_sendTransaction', [tx]);
+		}
+
+		// The sender signs the fee delegated transaction and the fee payer sends it.
+		tx.feePayer = opts.feePayer;
+		if (opts.feeRatio !== undefined) {
+			tx.feeRatio = opts.feeRatio;
+		}
+		if (tx.typeInt === undefined) {
+			tx.typeInt = opts.feeRatio !== undefined ? 0x32 : 0x31; // TxTypeFeeDelegatedSmartContractExecution(WithRatio)
+		}
+		if (tx.value === undefined) {
+			tx.value = '0x0';
+		}
+		var signed = rpc('klay_signTransaction', [tx]);
+		['nonce', 'gas', 'gasPrice'].forEach(function(field) {
+			tx[field] = signed.tx[field];
+		});
+		tx.signatures = signed.tx.signatures;
+		return rpc('klay_sendTransactionAsFeePayer', [tx]);
+	};
+
+	var decodeLogs = function(c, logs) {
+		if (logs && logs.logs) {
+			logs = logs.logs;
+		}
+		var decoded = [];
+		(logs || []).forEach(function(log) {
+			if (c.address && log.address && log.address.toLowerCase() !== c.address.toLowerCase()) {
+				return;
+			}
+			var event = abi.decodeLog(c.abi, log);
+			if (event !== null) {
+				event.blockNumber = log.blockNumber;
+				event.transactionHash = log.transactionHash;
+				event.logIndex = log.logIndex;
+				decoded.push(event);
+			}
+		});
+		return decoded;
+	};
+
+	var newContract = function(def, address) {
+		var c = {abi: JSON.stringify(abi.load(def)), address: address};
+		c.at = function(address) { return newContract(c.abi, address); };
+		c.encode = function(method) { return abi.encode(c.abi, method, Array.prototype.slice.call(arguments, 1)); };
+		c.decodeLogs = function(logs) { return decodeLogs(c, logs); };
+		c.getReceipt = function(txHash) {
+			var receipt = rpc('klay_getTransactionReceipt', [txHash]);
+			if (receipt) {
+				receipt.events = decodeLogs(c, receipt.logs);
+			}
+			return receipt;
+		};
+		c.getLogs = function(opts) {
+			opts = opts || {};
+			var filter = {address: c.address, fromBlock: toBlock(opts.fromBlock), toBlock: toBlock(opts.toBlock)};
+			return decodeLogs(c, rpc('klay_getLogs', [filter]));
+		};
+		abi.methods(c.abi).forEach(function(m) {
+			var method = function() {
+				var split = splitOptions(arguments, m.inputs);
+				return m.constant ? call(c, m, split.args, split.opts) : transact(c, m, split.args, split.opts);
+			};
+			method.call = function() {
+				var split = splitOptions(arguments, m.inputs);
+				return call(c, m, split.args, split.opts);
+			};
+			method.send = function() {
+				var split = splitOptions(arguments, m.inputs);
+				return transact(c, m, split.args, split.opts);
+			};
+			method.encode = function() {
+				return abi.encode(c.abi, m.name, Array.prototype.slice.call(arguments));
+			};
+			method.signature = m.signature;
+			c[m.name] = method;
+			if (c[m.rawName] === undefined) {
+				c[m.rawName] = method;
+			}
+		});
+		return c;
+	};
+	return function(def, address) {
+		// A single address loads the ABI verified by the node's contract verifier.
+		if (address === undefined && web3.isAddress(def)) {
+			var verified = rpc('klay_getContractABI', [def]);
+			if (verified === null) {
+				throw new Error('no verified ABI for ' + def);
+			}
+			return newContract(verified, def);
+		}
+		return newContract(def, address);
+	};
+})(abi, web3, jeth.send);
+`
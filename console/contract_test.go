@@ -0,0 +1,197 @@
+// Copyright 2024 The klaytn Authors
+// This file is part of the klaytn library.
+//
+// The klaytn library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The klaytn library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the klaytn library. If not, see <http://www.gnu.org/licenses/>.
+
+package console
+
+import (
+	"math/big"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/klaytn/klaytn/common"
+	"github.com/klaytn/klaytn/common/hexutil"
+	"github.com/klaytn/klaytn/crypto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testTokenABI = `[
+	{"type":"function","name":"transfer","stateMutability":"nonpayable","inputs":[{"name":"to","type":"address"},{"name":"amount","type":"uint256"}],"outputs":[{"name":"","type":"bool"}]},
+	{"type":"function","name":"balanceOf","stateMutability":"view","inputs":[{"name":"owner","type":"address"}],"outputs":[{"name":"","type":"uint256"}]},
+	{"type":"function","name":"setInfo","stateMutability":"nonpayable","inputs":[{"name":"info","type":"tuple","components":[{"name":"name","type":"string"},{"name":"id","type":"uint64"},{"name":"tags","type":"bytes4[2]"}]}],"outputs":[]},
+	{"type":"function","name":"adjust","stateMutability":"nonpayable","inputs":[{"name":"delta","type":"int8"}],"outputs":[]},
+	{"type":"event","name":"Transfer","anonymous":false,"inputs":[{"name":"from","type":"address","indexed":true},{"name":"to","type":"address","indexed":true},{"name":"value","type":"uint256","indexed":false}]},
+	{"type":"error","name":"InsufficientBalance","inputs":[{"name":"available","type":"uint256"},{"name":"required","type":"uint256"}]}
+]`
+
+var (
+	testTokenAddress = common.HexToAddress("0x000000000000000000000000000000000000abcd")
+	testRecipient    = common.HexToAddress("0x00000000000000000000000000000000000a11ce")
+)
+
+func TestConvertABIArguments(t *testing.T) {
+	parsed, err := parseABI([]byte(testTokenABI))
+	require.NoError(t, err)
+
+	// numbers can be given as JSON numbers, decimal strings or hex strings
+	for _, args := range []string{
+		`["` + testRecipient.Hex() + `", 1000]`,
+		`["` + testRecipient.Hex() + `", "1000"]`,
+		`["` + testRecipient.Hex() + `", "0x3e8"]`,
+	} {
+		values, err := convertABIArguments(parsed.Methods["transfer"].Inputs, args)
+		require.NoError(t, err)
+		assert.Equal(t, []interface{}{testRecipient, big.NewInt(1000)}, values)
+	}
+
+	// tuples can be given as objects or arrays
+	for _, args := range []string{
+		`[{"name": "klaytn", "id": 7, "tags": ["0x01020304", "0x05"]}]`,
+		`[["klaytn", "7", ["0x01020304", "0x05"]]]`,
+	} {
+		values, err := convertABIArguments(parsed.Methods["setInfo"].Inputs, args)
+		require.NoError(t, err)
+		packed, err := parsed.Methods["setInfo"].Inputs.Pack(values...)
+		require.NoError(t, err)
+
+		unpacked, err := parsed.Methods["setInfo"].Inputs.UnpackValues(packed)
+		require.NoError(t, err)
+		assert.Equal(t, map[string]interface{}{
+			"name": "klaytn",
+			"id":   "7",
+			"tags": []interface{}{"0x01020304", "0x05000000"},
+		}, formatABIValue(reflect.ValueOf(unpacked[0])))
+	}
+
+	// invalid arguments
+	for _, tc := range []struct {
+		method string
+		args   string
+	}{
+		{"transfer", `["` + testRecipient.Hex() + `"]`},
+		{"transfer", `["0x1234", 1]`},
+		{"transfer", `["` + testRecipient.Hex() + `", -1]`},
+		{"transfer", `["` + testRecipient.Hex() + `", 1.5]`},
+		{"adjust", `[128]`},
+		{"adjust", `[-129]`},
+		{"setInfo", `[{"name": "klaytn", "id": 7}]`},
+		{"setInfo", `[{"name": "klaytn", "id": 7, "tags": ["0x0102030405", "0x05"]}]`},
+	} {
+		_, err := convertABIArguments(parsed.Methods[tc.method].Inputs, tc.args)
+		assert.Error(t, err, tc.args)
+	}
+
+	values, err := convertABIArguments(parsed.Methods["adjust"].Inputs, `[-128]`)
+	require.NoError(t, err)
+	assert.Equal(t, []interface{}{int8(-128)}, values)
+}
+
+func TestDecodeRevert(t *testing.T) {
+	// Error(string)
+	data := append(crypto.Keccak256([]byte("Error(string)"))[:4], common.Hex2Bytes(
+		"0000000000000000000000000000000000000000000000000000000000000020"+
+			"0000000000000000000000000000000000000000000000000000000000000004"+
+			"6661696c00000000000000000000000000000000000000000000000000000000")...)
+	reason, err := decodeRevert(testTokenABI, data)
+	require.NoError(t, err)
+	assert.Equal(t, "fail", reason)
+
+	// Panic(uint256)
+	data = append(crypto.Keccak256([]byte("Panic(uint256)"))[:4], common.LeftPadBytes([]byte{0x11}, 32)...)
+	reason, err = decodeRevert(testTokenABI, data)
+	require.NoError(t, err)
+	assert.Equal(t, "panic: 0x11", reason)
+
+	// custom error
+	data = append(crypto.Keccak256([]byte("InsufficientBalance(uint256,uint256)"))[:4], common.LeftPadBytes([]byte{1}, 32)...)
+	data = append(data, common.LeftPadBytes([]byte{2}, 32)...)
+	reason, err = decodeRevert(testTokenABI, data)
+	require.NoError(t, err)
+	assert.Equal(t, `InsufficientBalance(available: "1", required: "2")`, reason)
+
+	// unknown error
+	_, err = decodeRevert(testTokenABI, common.Hex2Bytes("deadbeef"))
+	assert.Error(t, err)
+}
+
+// Tests that contract objects are created from the ABI in the ABI directory and
+// encode calls and decode logs and errors in the console.
+func TestContractHelpers(t *testing.T) {
+	tester := newTester(t, nil)
+	defer tester.Close(t)
+
+	abiDir := filepath.Join(tester.stack.DataDir(), ABIDirName)
+	require.NoError(t, os.MkdirAll(abiDir, 0o700))
+	require.NoError(t, os.WriteFile(filepath.Join(abiDir, "Token.json"), []byte(`{"contractName":"Token","abi":`+testTokenABI+`}`), 0o600))
+
+	parsed, err := parseABI([]byte(testTokenABI))
+	require.NoError(t, err)
+
+	evaluate := func(statement string) string {
+		tester.output.Reset()
+		tester.console.Evaluate(statement)
+		return tester.output.String()
+	}
+
+	assert.Contains(t, evaluate("abi.list()"), "Token")
+	evaluate("var token = contract('Token', '" + testTokenAddress.Hex() + "')")
+
+	// typed methods encode the calldata
+	expected, err := parsed.Pack("transfer", testRecipient, big.NewInt(1000))
+	require.NoError(t, err)
+	assert.Contains(t, evaluate("token.transfer.encode('"+testRecipient.Hex()+"', '1000')"), hexutil.Encode(expected))
+	assert.Contains(t, evaluate("token.transfer.signature"), "transfer(address,uint256)")
+	assert.Contains(t, evaluate("token.balanceOf()"), "expected 1 arguments, got 0")
+
+	// logs of the contract are decoded
+	event := parsed.Events["Transfer"]
+	log := `{"address": "` + testTokenAddress.Hex() + `", "topics": ["` + event.ID.Hex() + `", "` +
+		common.BytesToHash(testTokenAddress.Bytes()).Hex() + `", "` + common.BytesToHash(testRecipient.Bytes()).Hex() + `"], "data": "` +
+		hexutil.Encode(common.LeftPadBytes(big.NewInt(1000).Bytes(), 32)) + `"}`
+	output := evaluate("JSON.stringify(token.decodeLogs([" + log + "]))")
+	assert.Contains(t, output, `\"event\":\"Transfer\"`)
+	assert.Contains(t, output, `\"value\":\"1000\"`)
+	assert.Contains(t, output, `\"to\":\"`+testRecipient.Hex()+`\"`)
+
+	// logs of other contracts are ignored
+	assert.Contains(t, evaluate("token.at('"+testRecipient.Hex()+"').decodeLogs(["+log+"]).length"), "0")
+
+	// custom errors are decoded
+	data := append(crypto.Keccak256([]byte("InsufficientBalance(uint256,uint256)"))[:4], common.LeftPadBytes([]byte{1}, 32)...)
+	data = append(data, common.LeftPadBytes([]byte{2}, 32)...)
+	assert.Contains(t, evaluate("abi.decodeError(token.abi, '"+hexutil.Encode(data)+"')"), "InsufficientBalance")
+}
+
+// Tests that a contract object is created from the ABI verified by the node if only an address is given.
+func TestContractHelpers_VerifiedABI(t *testing.T) {
+	tester := newTester(t, nil)
+	defer tester.Close(t)
+
+	evaluate := func(statement string) string {
+		tester.output.Reset()
+		tester.console.Evaluate(statement)
+		return tester.output.String()
+	}
+
+	assert.Contains(t, evaluate("contract('"+testTokenAddress.Hex()+"')"), "no verified ABI")
+
+	require.NoError(t, tester.cn.ChainDB().WriteContractABI(testTokenAddress, []byte(testTokenABI)))
+	evaluate("var token = contract('" + testTokenAddress.Hex() + "')")
+	assert.Contains(t, evaluate("token.address"), testTokenAddress.Hex())
+	assert.Contains(t, evaluate("token.transfer.signature"), "transfer(address,uint256)")
+}
@@ -26,6 +26,7 @@ Source Files
 Each file provides following features
  - bridge.go	: bridge is a collection of JavaScript utility methods to bridge the .js runtime environment and the Go RPC connection backing the remote method calls
  - console.go	: Implements a console which supports JavaScript runtime environment
+ - contract.go	: Provides `abi` and `contract` objects which encode and decode contract calls, event logs and revert reasons with accounts/abi
  - prompter.go	: Provides UserPrompter which defines the methods needed by the console to prompt the user for various types of inputs, such as normal text, a password and a confirmation
 */
 package console
@@ -35,6 +35,8 @@ var Modules = map[string]string{
 	"governance":       Governance_JS,
 	"bootnode":         Bootnode_JS,
 	"chaindatafetcher": ChainDataFetcher_JS,
+	"feerelay":         FeeRelay_JS,
+	"verifier":         Verifier_JS,
 	"eth":              Eth_JS,
 }
 
@@ -161,6 +163,48 @@ web3._extend({
 });
 `
 
+const FeeRelay_JS = `
+web3._extend({
+	property: 'feerelay',
+	methods: [
+		new web3._extend.Method({
+			name: 'sendRawTransaction',
+			call: 'feerelay_sendRawTransaction',
+			params: 1
+		}),
+		new web3._extend.Method({
+			name: 'getBudget',
+			call: 'feerelay_getBudget',
+			params: 1
+		}),
+	],
+	properties: [
+		new web3._extend.Property({
+			name: 'feePayer',
+			getter: 'feerelay_feePayer'
+		}),
+		new web3._extend.Property({
+			name: 'policy',
+			getter: 'feerelay_policy'
+		}),
+	]
+});
+`
+
+const Verifier_JS = `
+web3._extend({
+	property: 'verifier',
+	methods: [
+		new web3._extend.Method({
+			name: 'verifyContract',
+			call: 'verifier_verifyContract',
+			params: 3,
+			inputFormatter: [web3._extend.formatters.inputAddressFormatter, null, null]
+		}),
+	]
+});
+`
+
 const ChainDataFetcher_JS = `
 web3._extend({
 	property: 'chaindatafetcher',
@@ -443,6 +487,15 @@ web3._extend({
 			name: 'stopStateMigration',
 			call: 'admin_stopStateMigration',
 		}),
+		new web3._extend.Method({
+			name: 'startOnlineDBMigration',
+			call: 'admin_startOnlineDBMigration',
+			params: 2,
+		}),
+		new web3._extend.Method({
+			name: 'stopOnlineDBMigration',
+			call: 'admin_stopOnlineDBMigration',
+		}),
 		new web3._extend.Method({
 			name: 'saveTrieNodeCacheToDisk',
 			call: 'admin_saveTrieNodeCacheToDisk',
@@ -506,6 +559,10 @@ web3._extend({
 			name: 'stateMigrationStatus',
 			getter: 'admin_stateMigrationStatus'
 		}),
+		new web3._extend.Property({
+			name: 'onlineDBMigrationStatus',
+			getter: 'admin_onlineDBMigrationStatus'
+		}),
 		new web3._extend.Property({
 			name: 'spamThrottlerConfig',
 			getter: 'admin_spamThrottlerConfig'
@@ -533,6 +590,12 @@ web3._extend({
 			call: 'debug_getBlockRlp',
 			params: 1
 		}),
+		new web3._extend.Method({
+			name: 'analyzeCode',
+			call: 'debug_analyzeCode',
+			params: 2,
+			inputFormatter: [web3._extend.formatters.inputAddressFormatter, web3._extend.formatters.inputDefaultBlockNumberFormatter]
+		}),
 		new web3._extend.Method({
 			name: 'getModifiedAccountsByNumber',
 			call: 'debug_getModifiedAccountsByNumber',
@@ -1059,6 +1122,29 @@ web3._extend({
 			params: 3,
 			inputFormatter: [null, web3._extend.formatters.inputBlockNumberFormatter, null]
 		}),
+		new web3._extend.Method({
+			name: 'suggestFees',
+			call: 'klay_suggestFees',
+			params: 0,
+		}),
+		new web3._extend.Method({
+			name: 'getContractABI',
+			call: 'klay_getContractABI',
+			params: 1,
+			inputFormatter: [web3._extend.formatters.inputAddressFormatter]
+		}),
+		new web3._extend.Method({
+			name: 'getTreasuryRebalanceResult',
+			call: 'klay_getTreasuryRebalanceResult',
+			params: 1,
+			inputFormatter: [web3._extend.formatters.inputBlockNumberFormatter]
+		}),
+		new web3._extend.Method({
+			name: 'dryRunTreasuryRebalance',
+			call: 'klay_dryRunTreasuryRebalance',
+			params: 2,
+			inputFormatter: [web3._extend.formatters.inputBlockNumberFormatter, null]
+		}),
 	],
 	properties: [
 		new web3._extend.Property({
@@ -1161,7 +1247,7 @@ web3._extend({
 		new web3._extend.Method({
 			name: 'deriveAccount',
 			call: 'personal_deriveAccount',
-			params: 3
+			params: 4
 		}),
 		new web3._extend.Method({
 			name: 'sendValueTransfer',
@@ -1194,7 +1280,12 @@ web3._extend({
 const RPC_JS = `
 web3._extend({
 	property: 'rpc',
-	methods: [],
+	methods: [
+		new web3._extend.Method({
+			name: 'discover',
+			call: 'rpc_discover'
+		}),
+	],
 	properties: [
 		new web3._extend.Property({
 			name: 'modules',
@@ -1342,6 +1433,11 @@ web3._extend({
 			call: 'subbridge_getAnchoringTxHashByBlockNumber',
 			params: 1
 		}),
+		new web3._extend.Method({
+			name: 'getAnchoringProof',
+			call: 'subbridge_getAnchoringProof',
+			params: 1
+		}),
 		new web3._extend.Method({
 			name: 'registerOperator',
 			call: 'subbridge_registerOperator',
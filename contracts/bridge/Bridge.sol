@@ -27,4 +27,27 @@ contract Bridge is BridgeCounterPart, BridgeTransferKLAY, BridgeTransferERC20, B
 
     constructor(bool _modeMintBurn) BridgeTransfer(_modeMintBurn) public payable {
     }
+
+    // handleValueTransferWithSignatures handles a value transfer with a single transaction carrying
+    // the signatures of the operators instead of their votes. _handleCall is the call data of
+    // handleKLAYTransfer, handleERC20Transfer or handleERC721Transfer which the operators signed.
+    function handleValueTransferWithSignatures(bytes calldata _handleCall, bytes calldata _signatures)
+        external
+        onlyOperators
+    {
+        require(_handleCall.length >= 4, "invalid handle call");
+        bytes memory handleCall = _handleCall;
+        bytes4 selector;
+        assembly {
+            selector := and(mload(add(handleCall, 32)), 0xffffffff00000000000000000000000000000000000000000000000000000000)
+        }
+        require(
+            selector == this.handleKLAYTransfer.selector ||
+            selector == this.handleERC20Transfer.selector ||
+            selector == this.handleERC721Transfer.selector,
+            "not a handle value transfer call"
+        );
+
+        _handleSignedValueTransfer(handleCall, _signatures);
+    }
 }
@@ -41,6 +41,8 @@ contract BridgeOperator is Ownable {
     mapping(uint8 => uint8) public operatorThresholds; // <vote type, uint8>
     uint64 public configurationNonce;
 
+    bool private signedValueTransfer; // true while handling a value transfer signed by the operators.
+
     enum VoteType {
         ValueTransfer,
         Configuration,
@@ -118,6 +120,13 @@ contract BridgeOperator is Ownable {
     {
         require(!closedValueTransferVotes[_requestNonce], "closed vote");
 
+        if (signedValueTransfer) {
+            // the operators already agreed on the value transfer with their signatures.
+            _removeVoteData(VoteType.ValueTransfer, _requestNonce);
+            closedValueTransferVotes[_requestNonce] = true;
+            return true;
+        }
+
         bytes32 voteKey = keccak256(msg.data);
         if (_voteCommon(VoteType.ValueTransfer, _requestNonce, voteKey)) {
             closedValueTransferVotes[_requestNonce] = true;
@@ -127,6 +136,60 @@ contract BridgeOperator is Ownable {
         return false;
     }
 
+    // _handleSignedValueTransfer executes the handle value transfer call after checking that
+    // enough distinct operators signed keccak256(address(this), keccak256(_handleCall)).
+    // The signatures are concatenated 65 bytes [R || S || V], sorted by the address of the signers.
+    function _handleSignedValueTransfer(bytes memory _handleCall, bytes memory _signatures)
+        internal
+    {
+        require(_signatures.length % 65 == 0, "invalid signatures length");
+        uint256 count = _signatures.length / 65;
+        require(count >= operatorThresholds[uint8(VoteType.ValueTransfer)], "not enough signatures");
+
+        bytes32 digest = keccak256(abi.encodePacked(address(this), keccak256(_handleCall)));
+        address lastSigner = address(0);
+        for (uint256 i = 0; i < count; i++) {
+            address signer = _recoverSigner(digest, _signatures, i * 65);
+            require(signer > lastSigner, "unsorted or duplicated signer");
+            require(operators[signer], "signer is not an operator");
+            lastSigner = signer;
+        }
+
+        signedValueTransfer = true;
+        (bool success, bytes memory result) = address(this).delegatecall(_handleCall);
+        signedValueTransfer = false;
+        if (!success) {
+            assembly {
+                revert(add(result, 32), mload(result))
+            }
+        }
+    }
+
+    // _recoverSigner recovers the signer of the digest from the signature at the offset of the signatures.
+    function _recoverSigner(bytes32 _digest, bytes memory _signatures, uint256 _offset)
+        internal
+        pure
+        returns(address)
+    {
+        bytes32 r;
+        bytes32 s;
+        uint8 v;
+        assembly {
+            let sig := add(add(_signatures, 32), _offset)
+            r := mload(sig)
+            s := mload(add(sig, 32))
+            v := byte(0, mload(add(sig, 64)))
+        }
+        if (v < 27) {
+            v += 27;
+        }
+        require(v == 27 || v == 28, "invalid signature");
+
+        address signer = ecrecover(_digest, v, r, s);
+        require(signer != address(0), "invalid signature");
+        return signer;
+    }
+
     // _voteConfiguration votes contract configuration transaction with the operator.
     function _voteConfiguration(uint64 _requestNonce)
         internal
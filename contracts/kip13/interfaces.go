@@ -0,0 +1,44 @@
+// Copyright 2024 The klaytn Authors
+// This file is part of the klaytn library.
+//
+// The klaytn library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The klaytn library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the klaytn library. If not, see <http://www.gnu.org/licenses/>.
+
+package kip13
+
+// SupportsInterfaceGas is the maximum gas which `supportsInterface` may use according to KIP-13.
+const SupportsInterfaceGas = 30000
+
+var (
+	// InterfaceIDKIP13 is the interface identifier of KIP-13 itself.
+	InterfaceIDKIP13 = [4]byte{0x01, 0xff, 0xc9, 0xa7}
+	// InterfaceIDInvalid must not be supported by any KIP-13 compliant contract.
+	InterfaceIDInvalid = [4]byte{0xff, 0xff, 0xff, 0xff}
+)
+
+// Interface is a well-known interface which can be detected with KIP-13.
+type Interface struct {
+	Name string
+	ID   [4]byte
+}
+
+// KnownInterfaces lists the token standards of Klaytn and their extensions.
+var KnownInterfaces = []Interface{
+	{"KIP-7", [4]byte{0x65, 0x78, 0x73, 0x71}},
+	{"KIP-7 Metadata", [4]byte{0xa2, 0x19, 0xa0, 0x25}},
+	{"KIP-17", [4]byte{0x80, 0xac, 0x58, 0xcd}},
+	{"KIP-17 Metadata", [4]byte{0x5b, 0x5e, 0x13, 0x9f}},
+	{"KIP-17 Enumerable", [4]byte{0x78, 0x0e, 0x9d, 0x63}},
+	{"KIP-37", [4]byte{0x64, 0x33, 0xca, 0x1f}},
+	{"KIP-37 Metadata", [4]byte{0x0e, 0x89, 0x34, 0x1c}},
+}
@@ -0,0 +1,49 @@
+// Copyright 2024 The klaytn Authors
+// This file is part of the klaytn library.
+//
+// The klaytn library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The klaytn library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the klaytn library. If not, see <http://www.gnu.org/licenses/>.
+
+// Package secp256r1 implements the signature verification on the secp256r1 (P-256) curve
+// which is used by the P256VERIFY precompiled contract (RIP-7212) and AccountKeyP256.
+package secp256r1
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"math/big"
+)
+
+// Verify checks the given signature (r, s) for the given hash and public key (x, y).
+// It returns false if the public key is not a valid point on the curve or
+// if r or s is not in the range [1, n-1].
+func Verify(hash []byte, r, s, x, y *big.Int) bool {
+	pubKey := NewPublicKey(x, y)
+	if pubKey == nil {
+		return false
+	}
+	return ecdsa.Verify(pubKey, hash, r, s)
+}
+
+// NewPublicKey creates an ecdsa.PublicKey on the secp256r1 curve.
+// It returns nil if (x, y) is not on the curve. The point at infinity is also rejected.
+func NewPublicKey(x, y *big.Int) *ecdsa.PublicKey {
+	if x == nil || y == nil || !elliptic.P256().IsOnCurve(x, y) {
+		return nil
+	}
+	return &ecdsa.PublicKey{
+		Curve: elliptic.P256(),
+		X:     x,
+		Y:     y,
+	}
+}
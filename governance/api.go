@@ -420,8 +420,10 @@ func getChainConfig(governance Engine, num *rpc.BlockNumber) *params.ChainConfig
 	config.KoreCompatibleBlock = latestConfig.KoreCompatibleBlock
 	config.ShanghaiCompatibleBlock = latestConfig.ShanghaiCompatibleBlock
 	config.CancunCompatibleBlock = latestConfig.CancunCompatibleBlock
+	config.PragueCompatibleBlock = latestConfig.PragueCompatibleBlock
 	config.Kip103CompatibleBlock = latestConfig.Kip103CompatibleBlock
 	config.Kip103ContractAddress = latestConfig.Kip103ContractAddress
+	config.TreasuryRebalances = latestConfig.TreasuryRebalances
 
 	return config
 }
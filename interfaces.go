@@ -185,6 +185,28 @@ type GasPricer interface {
 	SuggestGasPrice(ctx context.Context) (*big.Int, error)
 }
 
+// FeeTier is a suggestion of the fees for a transaction expected to be included
+// in ExpectedBlock.
+type FeeTier struct {
+	MaxFeePerGas         *big.Int
+	MaxPriorityFeePerGas *big.Int
+	ExpectedBlock        uint64
+}
+
+// FeeSuggestion contains the suggested fees of slow, normal and fast tiers, which are
+// derived from the projected base fees of the next blocks.
+type FeeSuggestion struct {
+	HeadBlock   uint64     // Block number of the chain head the suggestion is based on
+	NextBaseFee *big.Int   // Base fee of the next block, which is determined by the head block
+	BaseFees    []*big.Int // Projected base fees of the next blocks considering the pending transactions
+	MinBaseFee  *big.Int   // Lowest base fee of the next blocks if they are empty
+	MaxBaseFee  *big.Int   // Highest base fee of the next blocks if they are full
+	PendingGas  uint64     // Total gas limit of the pending transactions
+	Slow        FeeTier
+	Normal      FeeTier
+	Fast        FeeTier
+}
+
 // A PendingStateReader provides access to the pending state, which is the result of all
 // known executable transactions which have not yet been included in the blockchain. It is
 // commonly used to display the result of ’unconfirmed’ actions (e.g. wallet value
@@ -50,6 +50,8 @@ var (
 	ErrLengthTooLong                        = errors.New("length too long")
 	ErrNestedCompositeType                  = errors.New("nested composite type")
 	ErrLegacyTransactionMustBeWithLegacyKey = errors.New("a legacy transaction must be with a legacy account key")
+	ErrAccountKeyContractNotSupported       = errors.New("AccountKeyContract is not supported before the prague fork")
+	ErrAccountKeyP256NotSupported           = errors.New("AccountKeyP256 is not supported before the prague fork")
 
 	ErrDeprecated   = errors.New("deprecated feature")
 	ErrNotSupported = errors.New("not supported")
@@ -126,6 +126,14 @@ const (
 	KAS
 	FORK
 	NodeCnGasPrice
+	AccountsExternal
+	Signer
+	CMDKSigner
+
+	// 61~70
+	NodeFeeRelay
+	NodeVerifier
+	NodeGraphQL
 
 	// ModuleNameLen should be placed at the end of the list.
 	ModuleNameLen
@@ -203,4 +211,12 @@ var moduleNames = [ModuleNameLen]string{
 	"kas",
 	"fork",
 	"node/cn/gasprice",
+	"accounts/external",
+	"signer",
+	"cmd/ksigner",
+
+	// 61~70
+	"node/feerelay",
+	"node/verifier",
+	"node/graphql",
 }
@@ -0,0 +1,567 @@
+// Copyright 2024 The klaytn Authors
+// This file is part of the klaytn library.
+//
+// The klaytn library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The klaytn library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the klaytn library. If not, see <http://www.gnu.org/licenses/>.
+
+package grpc
+
+import (
+	"context"
+	"math/big"
+
+	"github.com/klaytn/klaytn/api"
+	"github.com/klaytn/klaytn/blockchain"
+	"github.com/klaytn/klaytn/blockchain/types"
+	"github.com/klaytn/klaytn/blockchain/types/account"
+	"github.com/klaytn/klaytn/blockchain/types/accountkey"
+	"github.com/klaytn/klaytn/common"
+	"github.com/klaytn/klaytn/networks/rpc"
+	"github.com/klaytn/klaytn/node/cn/filters"
+	"github.com/klaytn/klaytn/rlp"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var (
+	errBlockNotFound       = status.Error(codes.NotFound, "block not found")
+	errTransactionNotFound = status.Error(codes.NotFound, "transaction not found")
+	errReceiptNotFound     = status.Error(codes.NotFound, "receipt not found")
+	errInvalidHash         = status.Error(codes.InvalidArgument, "hash must be 32 bytes")
+	errInvalidAddress      = status.Error(codes.InvalidArgument, "address must be 20 bytes")
+	errSubscriberTooSlow   = status.Error(codes.ResourceExhausted, "subscriber is too slow")
+)
+
+// subscriberQueueSize is the maximum number of the messages queued for a subscriber.
+// A subscriber not receiving its messages in time is dropped, so that it does not block
+// the event feeds shared with the other subscribers.
+const subscriberQueueSize = 1024
+
+// Backend is the backend of the typed gRPC API.
+type Backend interface {
+	api.Backend
+	filters.Backend
+}
+
+// apiServer is an implementation of KlaytnAPIServer resolving the requests through the API backend.
+type apiServer struct {
+	UnimplementedKlaytnAPIServer
+
+	backend Backend
+	txPool  *api.PublicTransactionPoolAPI
+}
+
+func newAPIServer(backend Backend) *apiServer {
+	return &apiServer{
+		backend: backend,
+		txPool:  api.NewPublicTransactionPoolAPI(backend, new(api.AddrLocker)),
+	}
+}
+
+func (s *apiServer) GetBlockNumber(ctx context.Context, req *BlockNumberRequest) (*BlockNumber, error) {
+	return &BlockNumber{Number: s.backend.CurrentBlock().NumberU64()}, nil
+}
+
+func (s *apiServer) GetBlock(ctx context.Context, req *BlockRequest) (*Block, error) {
+	var (
+		block *types.Block
+		err   error
+	)
+	if len(req.Hash) > 0 {
+		hash, herr := toHash(req.Hash)
+		if herr != nil {
+			return nil, herr
+		}
+		block, err = s.backend.BlockByHash(ctx, hash)
+	} else {
+		block, err = s.backend.BlockByNumber(ctx, rpc.BlockNumber(req.Number))
+	}
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	if block == nil {
+		return nil, errBlockNotFound
+	}
+	txs := make([]*Transaction, len(block.Transactions()))
+	for i, tx := range block.Transactions() {
+		txs[i] = newTransaction(tx, block.Hash(), block.NumberU64(), uint64(i))
+	}
+	return &Block{Header: newHeader(block.Header()), Transactions: txs}, nil
+}
+
+func (s *apiServer) GetHeader(ctx context.Context, req *BlockRequest) (*Header, error) {
+	var (
+		header *types.Header
+		err    error
+	)
+	if len(req.Hash) > 0 {
+		hash, herr := toHash(req.Hash)
+		if herr != nil {
+			return nil, herr
+		}
+		header, err = s.backend.HeaderByHash(ctx, hash)
+	} else {
+		header, err = s.backend.HeaderByNumber(ctx, rpc.BlockNumber(req.Number))
+	}
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	if header == nil {
+		return nil, errBlockNotFound
+	}
+	return newHeader(header), nil
+}
+
+func (s *apiServer) GetTransaction(ctx context.Context, req *TransactionRequest) (*Transaction, error) {
+	hash, err := toHash(req.Hash)
+	if err != nil {
+		return nil, err
+	}
+	if tx, blockHash, blockNumber, index := s.backend.GetTxAndLookupInfo(hash); tx != nil {
+		return newTransaction(tx, blockHash, blockNumber, index), nil
+	}
+	if tx := s.backend.GetPoolTransaction(hash); tx != nil {
+		return newTransaction(tx, common.Hash{}, 0, 0), nil
+	}
+	return nil, errTransactionNotFound
+}
+
+func (s *apiServer) GetReceipt(ctx context.Context, req *TransactionRequest) (*Receipt, error) {
+	hash, err := toHash(req.Hash)
+	if err != nil {
+		return nil, err
+	}
+	tx, blockHash, blockNumber, index := s.backend.GetTxAndLookupInfo(hash)
+	if tx == nil {
+		return nil, errReceiptNotFound
+	}
+	receipts := s.backend.GetBlockReceipts(ctx, blockHash)
+	if index >= uint64(len(receipts)) {
+		return nil, errReceiptNotFound
+	}
+	receipt := receipts[index]
+	logs := make([]*Log, len(receipt.Logs))
+	for i, log := range receipt.Logs {
+		logs[i] = newLog(log)
+	}
+	res := &Receipt{
+		TransactionHash:  hash.Bytes(),
+		Status:           uint64(receipt.Status),
+		GasUsed:          receipt.GasUsed,
+		LogsBloom:        receipt.Bloom.Bytes(),
+		Logs:             logs,
+		BlockHash:        blockHash.Bytes(),
+		BlockNumber:      blockNumber,
+		TransactionIndex: index,
+	}
+	if receipt.ContractAddress != (common.Address{}) {
+		res.ContractAddress = receipt.ContractAddress.Bytes()
+	}
+	return res, nil
+}
+
+func (s *apiServer) GetLogs(ctx context.Context, req *LogFilter) (*Logs, error) {
+	addresses, topics, err := toCriteria(req)
+	if err != nil {
+		return nil, err
+	}
+	var filter *filters.Filter
+	if len(req.BlockHash) > 0 {
+		hash, err := toHash(req.BlockHash)
+		if err != nil {
+			return nil, err
+		}
+		filter = filters.NewBlockFilter(s.backend, hash, addresses, topics)
+	} else {
+		filter = filters.NewRangeFilter(s.backend, req.FromBlock, req.ToBlock, addresses, topics)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, filters.GetLogsDeadline)
+	defer cancel()
+
+	logs, err := filter.Logs(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	res := &Logs{Logs: make([]*Log, len(logs))}
+	for i, log := range logs {
+		res.Logs[i] = newLog(log)
+	}
+	return res, nil
+}
+
+func (s *apiServer) GetAccount(ctx context.Context, req *AccountRequest) (*Account, error) {
+	address, err := toAddress(req.Address)
+	if err != nil {
+		return nil, err
+	}
+	statedb, _, err := s.backend.StateAndHeaderByNumber(ctx, rpc.BlockNumber(req.BlockNumber))
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	if statedb == nil {
+		return nil, errBlockNotFound
+	}
+	res := &Account{Address: address.Bytes(), Balance: []byte{}}
+	acc := statedb.GetAccount(address)
+	if acc == nil {
+		return res, nil
+	}
+	key, err := rlp.EncodeToBytes(accountkey.NewAccountKeySerializerWithAccountKey(statedb.GetKey(address)))
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	res.Type = uint32(acc.Type())
+	res.Nonce = acc.GetNonce()
+	res.Balance = acc.GetBalance().Bytes()
+	res.HumanReadable = acc.GetHumanReadable()
+	res.Key = key
+	if pa, ok := acc.(account.ProgramAccount); ok {
+		res.CodeHash = pa.GetCodeHash()
+		res.StorageRoot = pa.GetStorageRoot().Unextend().Bytes()
+	}
+	return res, nil
+}
+
+func (s *apiServer) GetBalance(ctx context.Context, req *AccountRequest) (*Balance, error) {
+	address, err := toAddress(req.Address)
+	if err != nil {
+		return nil, err
+	}
+	statedb, _, err := s.backend.StateAndHeaderByNumber(ctx, rpc.BlockNumber(req.BlockNumber))
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	if statedb == nil {
+		return nil, errBlockNotFound
+	}
+	return &Balance{Balance: statedb.GetBalance(address).Bytes()}, nil
+}
+
+func (s *apiServer) SendRawTransaction(ctx context.Context, req *RawTransaction) (*TransactionHash, error) {
+	hash, err := s.txPool.SendRawTransaction(ctx, req.Data)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	return &TransactionHash{Hash: hash.Bytes()}, nil
+}
+
+func (s *apiServer) SubscribeNewHeads(req *SubscribeRequest, stream KlaytnAPI_SubscribeNewHeadsServer) error {
+	heads := make(chan blockchain.ChainHeadEvent, 16)
+	sub := s.backend.SubscribeChainHeadEvent(heads)
+	defer sub.Unsubscribe()
+
+	queue := newSendQueue(subscriberQueueSize, func(msg interface{}) error { return stream.Send(msg.(*Header)) })
+	defer queue.close()
+
+	for {
+		select {
+		case ev := <-heads:
+			if err := queue.push(newHeader(ev.Block.Header())); err != nil {
+				return err
+			}
+		case err := <-queue.errc:
+			return err
+		case err := <-sub.Err():
+			return err
+		case <-stream.Context().Done():
+			return nil
+		}
+	}
+}
+
+func (s *apiServer) SubscribeLogs(req *LogFilter, stream KlaytnAPI_SubscribeLogsServer) error {
+	addresses, topics, err := toCriteria(req)
+	if err != nil {
+		return err
+	}
+	var blockHash *common.Hash
+	if len(req.BlockHash) > 0 {
+		hash, err := toHash(req.BlockHash)
+		if err != nil {
+			return err
+		}
+		blockHash = &hash
+	}
+
+	var (
+		logs        = make(chan []*types.Log, 16)
+		removedLogs = make(chan blockchain.RemovedLogsEvent, 16)
+		logsSub     = s.backend.SubscribeLogsEvent(logs)
+		removedSub  = s.backend.SubscribeRemovedLogsEvent(removedLogs)
+	)
+	defer logsSub.Unsubscribe()
+	defer removedSub.Unsubscribe()
+
+	queue := newSendQueue(subscriberQueueSize, func(msg interface{}) error { return stream.Send(msg.(*Log)) })
+	defer queue.close()
+
+	send := func(logs []*types.Log) error {
+		for _, log := range logs {
+			if (blockHash == nil || log.BlockHash == *blockHash) && matchLog(log, addresses, topics) {
+				if err := queue.push(newLog(log)); err != nil {
+					return err
+				}
+			}
+		}
+		return nil
+	}
+	for {
+		select {
+		case ev := <-logs:
+			if err := send(ev); err != nil {
+				return err
+			}
+		case ev := <-removedLogs:
+			if err := send(ev.Logs); err != nil {
+				return err
+			}
+		case err := <-queue.errc:
+			return err
+		case err := <-logsSub.Err():
+			return err
+		case err := <-removedSub.Err():
+			return err
+		case <-stream.Context().Done():
+			return nil
+		}
+	}
+}
+
+func (s *apiServer) SubscribePendingTransactions(req *SubscribeRequest, stream KlaytnAPI_SubscribePendingTransactionsServer) error {
+	txs := make(chan blockchain.NewTxsEvent, 16)
+	sub := s.backend.SubscribeNewTxsEvent(txs)
+	defer sub.Unsubscribe()
+
+	queue := newSendQueue(subscriberQueueSize, func(msg interface{}) error { return stream.Send(msg.(*TransactionHash)) })
+	defer queue.close()
+
+	for {
+		select {
+		case ev := <-txs:
+			for _, tx := range ev.Txs {
+				if err := queue.push(&TransactionHash{Hash: tx.Hash().Bytes()}); err != nil {
+					return err
+				}
+			}
+		case err := <-queue.errc:
+			return err
+		case err := <-sub.Err():
+			return err
+		case <-stream.Context().Done():
+			return nil
+		}
+	}
+}
+
+// sendQueue sends the messages of a subscription to its stream on its own goroutine, so
+// that a slow stream does not block the event feed.
+type sendQueue struct {
+	queue chan interface{}
+	errc  chan error // receives the error of the stream
+	quit  chan struct{}
+}
+
+func newSendQueue(size int, send func(interface{}) error) *sendQueue {
+	q := &sendQueue{
+		queue: make(chan interface{}, size),
+		errc:  make(chan error, 1),
+		quit:  make(chan struct{}),
+	}
+	go func() {
+		for {
+			select {
+			case msg := <-q.queue:
+				if err := send(msg); err != nil {
+					q.errc <- err
+					return
+				}
+			case <-q.quit:
+				return
+			}
+		}
+	}()
+	return q
+}
+
+// push queues the message, or returns errSubscriberTooSlow if the queue is full.
+func (q *sendQueue) push(msg interface{}) error {
+	select {
+	case q.queue <- msg:
+		return nil
+	default:
+		return errSubscriberTooSlow
+	}
+}
+
+// close stops sending the queued messages.
+func (q *sendQueue) close() {
+	close(q.quit)
+}
+
+func newHeader(header *types.Header) *Header {
+	return &Header{
+		Hash:             header.Hash().Bytes(),
+		ParentHash:       header.ParentHash.Bytes(),
+		Rewardbase:       header.Rewardbase.Bytes(),
+		StateRoot:        header.Root.Bytes(),
+		TransactionsRoot: header.TxHash.Bytes(),
+		ReceiptsRoot:     header.ReceiptHash.Bytes(),
+		LogsBloom:        header.Bloom.Bytes(),
+		BlockScore:       bigBytes(header.BlockScore),
+		Number:           header.Number.Uint64(),
+		GasUsed:          header.GasUsed,
+		Time:             header.Time.Uint64(),
+		TimeFos:          uint32(header.TimeFoS),
+		ExtraData:        header.Extra,
+		GovernanceData:   header.Governance,
+		VoteData:         header.Vote,
+		BaseFee:          bigBytes(header.BaseFee),
+	}
+}
+
+// newTransaction returns the transaction with its location. The block hash is empty for a pending transaction.
+func newTransaction(tx *types.Transaction, blockHash common.Hash, blockNumber uint64, index uint64) *Transaction {
+	raw, _ := rlp.EncodeToBytes(tx)
+	res := &Transaction{
+		Hash:     tx.Hash().Bytes(),
+		Type:     uint32(tx.Type()),
+		Nonce:    tx.Nonce(),
+		Value:    bigBytes(tx.Value()),
+		Gas:      tx.Gas(),
+		GasPrice: bigBytes(tx.GasPrice()),
+		Input:    tx.Data(),
+		Raw:      raw,
+	}
+	var from common.Address
+	if tx.IsEthereumTransaction() {
+		from, _ = types.Sender(types.LatestSignerForChainID(tx.ChainId()), tx)
+	} else {
+		from, _ = tx.From()
+	}
+	res.From = from.Bytes()
+	if to := tx.To(); to != nil {
+		res.To = to.Bytes()
+	}
+	if tx.IsFeeDelegatedTransaction() {
+		if feePayer, err := tx.FeePayer(); err == nil {
+			res.FeePayer = feePayer.Bytes()
+		}
+	}
+	if ratio, ok := tx.FeeRatio(); ok {
+		res.FeeRatio = uint32(ratio)
+	}
+	if blockHash != (common.Hash{}) {
+		res.BlockHash = blockHash.Bytes()
+		res.BlockNumber = blockNumber
+		res.Index = index
+	}
+	return res
+}
+
+func newLog(log *types.Log) *Log {
+	topics := make([][]byte, len(log.Topics))
+	for i, topic := range log.Topics {
+		topics[i] = topic.Bytes()
+	}
+	return &Log{
+		Address:          log.Address.Bytes(),
+		Topics:           topics,
+		Data:             log.Data,
+		BlockNumber:      log.BlockNumber,
+		BlockHash:        log.BlockHash.Bytes(),
+		TransactionHash:  log.TxHash.Bytes(),
+		TransactionIndex: uint64(log.TxIndex),
+		Index:            uint64(log.Index),
+		Removed:          log.Removed,
+	}
+}
+
+// matchLog reports whether the log is emitted by one of the addresses and matches the topics.
+func matchLog(log *types.Log, addresses []common.Address, topics [][]common.Hash) bool {
+	if len(addresses) > 0 {
+		found := false
+		for _, address := range addresses {
+			if log.Address == address {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	if len(topics) > len(log.Topics) {
+		return false
+	}
+	for i, alternatives := range topics {
+		if len(alternatives) == 0 {
+			continue
+		}
+		found := false
+		for _, topic := range alternatives {
+			if log.Topics[i] == topic {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
+
+func toCriteria(filter *LogFilter) ([]common.Address, [][]common.Hash, error) {
+	addresses := make([]common.Address, len(filter.Addresses))
+	for i, address := range filter.Addresses {
+		a, err := toAddress(address)
+		if err != nil {
+			return nil, nil, err
+		}
+		addresses[i] = a
+	}
+	topics := make([][]common.Hash, len(filter.Topics))
+	for i, alternatives := range filter.Topics {
+		for _, topic := range alternatives.Hashes {
+			hash, err := toHash(topic)
+			if err != nil {
+				return nil, nil, err
+			}
+			topics[i] = append(topics[i], hash)
+		}
+	}
+	return addresses, topics, nil
+}
+
+func toHash(b []byte) (common.Hash, error) {
+	if len(b) != common.HashLength {
+		return common.Hash{}, errInvalidHash
+	}
+	return common.BytesToHash(b), nil
+}
+
+func toAddress(b []byte) (common.Address, error) {
+	if len(b) != common.AddressLength {
+		return common.Address{}, errInvalidAddress
+	}
+	return common.BytesToAddress(b), nil
+}
+
+// bigBytes returns the big-endian bytes of the integer, or nil if the integer is nil.
+func bigBytes(i *big.Int) []byte {
+	if i == nil {
+		return nil
+	}
+	return i.Bytes()
+}
@@ -0,0 +1,220 @@
+// Copyright 2024 The klaytn Authors
+// This file is part of the klaytn library.
+//
+// The klaytn library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The klaytn library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the klaytn library. If not, see <http://www.gnu.org/licenses/>.
+
+package grpc
+
+import (
+	"context"
+	"math/big"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	mock_api "github.com/klaytn/klaytn/api/mocks"
+	"github.com/klaytn/klaytn/blockchain"
+	"github.com/klaytn/klaytn/blockchain/bloombits"
+	"github.com/klaytn/klaytn/blockchain/types"
+	"github.com/klaytn/klaytn/common"
+	"github.com/klaytn/klaytn/crypto"
+	"github.com/klaytn/klaytn/event"
+	"github.com/klaytn/klaytn/networks/rpc"
+	"github.com/klaytn/klaytn/rlp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+// testBackend adds the methods of the filter backend to the mock API backend.
+type testBackend struct {
+	*mock_api.MockBackend
+	logsFeed event.Feed
+}
+
+func (b *testBackend) GetLogs(ctx context.Context, blockHash common.Hash) ([][]*types.Log, error) {
+	return nil, nil
+}
+
+func (b *testBackend) SubscribeLogsEvent(ch chan<- []*types.Log) event.Subscription {
+	return b.logsFeed.Subscribe(ch)
+}
+
+func (b *testBackend) SubscribeRemovedLogsEvent(ch chan<- blockchain.RemovedLogsEvent) event.Subscription {
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		<-quit
+		return nil
+	})
+}
+
+func (b *testBackend) BloomStatus() (uint64, uint64) { return 0, 0 }
+
+func (b *testBackend) ServiceFilter(ctx context.Context, session *bloombits.MatcherSession) {}
+
+func newTestAPIClient(t *testing.T, backend Backend) KlaytnAPIClient {
+	lis := bufconn.Listen(1 << 20)
+	server := grpc.NewServer()
+	RegisterKlaytnAPIServer(server, newAPIServer(backend))
+	go server.Serve(lis)
+	t.Cleanup(server.Stop)
+
+	conn, err := grpc.DialContext(context.Background(), "bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) { return lis.DialContext(ctx) }),
+		grpc.WithTransportCredentials(insecure.NewCredentials()))
+	require.NoError(t, err)
+	t.Cleanup(func() { conn.Close() })
+	return NewKlaytnAPIClient(conn)
+}
+
+func TestAPIServer_GetBlock(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	backend := &testBackend{MockBackend: mock_api.NewMockBackend(ctrl)}
+	client := newTestAPIClient(t, backend)
+
+	key, _ := crypto.GenerateKey()
+	chainID := big.NewInt(1001)
+	tx, err := types.SignTx(types.NewTransaction(1, common.HexToAddress("0x1"), big.NewInt(10), 21000, big.NewInt(25), nil),
+		types.LatestSignerForChainID(chainID), key)
+	require.NoError(t, err)
+	header := &types.Header{Number: big.NewInt(3), BlockScore: big.NewInt(1), Time: big.NewInt(100), Extra: []byte{1}}
+	block := types.NewBlockWithHeader(header).WithBody([]*types.Transaction{tx})
+
+	backend.EXPECT().BlockByNumber(gomock.Any(), rpc.BlockNumber(3)).Return(block, nil)
+	backend.EXPECT().BlockByNumber(gomock.Any(), rpc.LatestBlockNumber).Return(nil, nil)
+
+	res, err := client.GetBlock(context.Background(), &BlockRequest{Number: 3})
+	require.NoError(t, err)
+	assert.Equal(t, block.Hash().Bytes(), res.Header.Hash)
+	assert.Equal(t, uint64(3), res.Header.Number)
+	assert.Empty(t, res.Header.BaseFee)
+	require.Len(t, res.Transactions, 1)
+	assert.Equal(t, tx.Hash().Bytes(), res.Transactions[0].Hash)
+	assert.Equal(t, crypto.PubkeyToAddress(key.PublicKey).Bytes(), res.Transactions[0].From)
+	assert.Equal(t, big.NewInt(10).Bytes(), res.Transactions[0].Value)
+	assert.Equal(t, block.Hash().Bytes(), res.Transactions[0].BlockHash)
+
+	decoded := new(types.Transaction)
+	require.NoError(t, rlp.DecodeBytes(res.Transactions[0].Raw, decoded))
+	assert.Equal(t, tx.Hash(), decoded.Hash())
+
+	_, err = client.GetBlock(context.Background(), &BlockRequest{Number: -1})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+
+	_, err = client.GetHeader(context.Background(), &BlockRequest{Hash: []byte{1, 2, 3}})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestAPIServer_SubscribeLogs(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	backend := &testBackend{MockBackend: mock_api.NewMockBackend(ctrl)}
+	client := newTestAPIClient(t, backend)
+
+	var (
+		address = common.HexToAddress("0xabc")
+		topic   = common.HexToHash("0x01")
+	)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	stream, err := client.SubscribeLogs(ctx, &LogFilter{
+		Addresses: [][]byte{address.Bytes()},
+		Topics:    []*Topics{{Hashes: [][]byte{topic.Bytes()}}},
+	})
+	require.NoError(t, err)
+
+	// Wait for the subscription of the server
+	for i := 0; i < 100 && backend.logsFeed.Send([]*types.Log{}) == 0; i++ {
+		time.Sleep(10 * time.Millisecond)
+	}
+	backend.logsFeed.Send([]*types.Log{
+		{Address: common.HexToAddress("0xdef"), Topics: []common.Hash{topic}, BlockNumber: 1},
+		{Address: address, Topics: []common.Hash{common.HexToHash("0x02")}, BlockNumber: 2},
+		{Address: address, Topics: []common.Hash{topic, common.HexToHash("0x02")}, Data: []byte{1}, BlockNumber: 3},
+	})
+
+	log, err := stream.Recv()
+	require.NoError(t, err)
+	assert.Equal(t, uint64(3), log.BlockNumber)
+	assert.Equal(t, address.Bytes(), log.Address)
+	assert.Equal(t, [][]byte{topic.Bytes(), common.HexToHash("0x02").Bytes()}, log.Topics)
+	assert.Equal(t, []byte{1}, log.Data)
+}
+
+func TestSendQueue(t *testing.T) {
+	var (
+		block = make(chan struct{})
+		sent  = make(chan interface{}, 10)
+	)
+	queue := newSendQueue(2, func(msg interface{}) error {
+		<-block
+		sent <- msg
+		return nil
+	})
+	defer queue.close()
+
+	// The queue is full while the stream is blocked.
+	var err error
+	for i := 0; i < 10 && err == nil; i++ {
+		err = queue.push(i)
+	}
+	assert.Equal(t, errSubscriberTooSlow, err)
+
+	close(block)
+	assert.Equal(t, 0, <-sent)
+	assert.Equal(t, 1, <-sent)
+}
+
+func TestSendQueue_StreamError(t *testing.T) {
+	streamErr := status.Error(codes.Unavailable, "closed")
+	queue := newSendQueue(2, func(msg interface{}) error { return streamErr })
+	defer queue.close()
+
+	require.NoError(t, queue.push(0))
+	select {
+	case err := <-queue.errc:
+		assert.Equal(t, streamErr, err)
+	case <-time.After(5 * time.Second):
+		t.Fatal("the error of the stream is not reported")
+	}
+}
+
+func TestMatchLog(t *testing.T) {
+	var (
+		a1, a2 = common.HexToAddress("0x1"), common.HexToAddress("0x2")
+		t1, t2 = common.HexToHash("0x1"), common.HexToHash("0x2")
+		log    = &types.Log{Address: a1, Topics: []common.Hash{t1, t2}}
+	)
+	testCases := []struct {
+		addresses []common.Address
+		topics    [][]common.Hash
+		match     bool
+	}{
+		{nil, nil, true},
+		{[]common.Address{a2, a1}, nil, true},
+		{[]common.Address{a2}, nil, false},
+		{nil, [][]common.Hash{{}, {t2}}, true},
+		{nil, [][]common.Hash{{t2, t1}}, true},
+		{nil, [][]common.Hash{{t2}}, false},
+		{nil, [][]common.Hash{{t1}, {t2}, {t1}}, false},
+	}
+	for i, tc := range testCases {
+		assert.Equal(t, tc.match, matchLog(log, tc.addresses, tc.topics), i)
+	}
+}
@@ -20,6 +20,13 @@ Package grpc implements the gRPC protocol for Klaytn.
 This package allows you to use Klaytn's RPC API using gRPC.
 See below for gRPC: https://grpc.io/docs/quickstart/go/
 
+Two services are served. KlaytnNode tunnels JSON-RPC requests and responses in protobuf messages.
+KlaytnAPI serves the typed core APIs: blocks, headers, transactions, receipts, logs, accounts and
+balances, transaction submission, and the streams of new heads, logs and pending transactions.
+KlaytnAPI is served if a service of the node provides the API backend, i.e., on a CN, and is resolved
+through the same backend as the JSON-RPC APIs. The reflection service is registered so that tools like
+grpcurl can discover the services. A typed Go client of KlaytnAPI is provided by client.GRPCClient.
+
 Source files
 
 Each file provides the following features
@@ -27,5 +34,8 @@ Each file provides the following features
  - gServer.go : gRPC server implementation.
  - klaytn.proto : Define a interface and messages to use in gRPC server and clients.
  - klaytn.pb.go : the generated Go file from klaytn.proto by protoc-gen-go.
+ - klaytn_api.proto : Define the typed API service and its messages.
+ - klaytn_api.pb.go : the generated Go file from klaytn_api.proto by protoc-gen-go.
+ - api_server.go : Implementation of the typed API service through the API backend.
 */
 package grpc
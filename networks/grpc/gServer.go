@@ -41,6 +41,7 @@ var logger = log.NewModuleLogger(log.NetworksGRPC)
 type Listener struct {
 	Addr       string
 	handler    *rpc.Server
+	backend    Backend
 	grpcServer *grpc.Server
 }
 
@@ -252,6 +253,12 @@ func (gs *Listener) SetRPCServer(handler *rpc.Server) {
 	gs.handler = handler
 }
 
+// SetBackend sets the backend of the typed gRPC API. If it is not set, only the
+// JSON-RPC tunnelling service is served.
+func (gs *Listener) SetBackend(backend Backend) {
+	gs.backend = backend
+}
+
 func (gs *Listener) Start() {
 	lis, err := net.Listen("tcp", gs.Addr)
 	if err != nil {
@@ -261,6 +268,9 @@ func (gs *Listener) Start() {
 	gs.grpcServer = grpc.NewServer()
 
 	RegisterKlaytnNodeServer(gs.grpcServer, &klaytnServer{handler: gs.handler})
+	if gs.backend != nil {
+		RegisterKlaytnAPIServer(gs.grpcServer, newAPIServer(gs.backend))
+	}
 
 	// Register reflection service on gRPC server.
 	reflection.Register(gs.grpcServer)
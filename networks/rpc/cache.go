@@ -0,0 +1,205 @@
+// Copyright 2024 The klaytn Authors
+// This file is part of the klaytn library.
+//
+// The klaytn library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The klaytn library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the klaytn library. If not, see <http://www.gnu.org/licenses/>.
+
+package rpc
+
+import (
+	"bytes"
+	"container/list"
+	"encoding/json"
+	"sync"
+	"time"
+
+	"github.com/klaytn/klaytn/common/hexutil"
+)
+
+// cachedMethods is the allow-list of the methods whose responses are cached. A response is
+// immutable if the block of the result is below the head, since a block is final once it is
+// committed by Istanbul. The value is the field of the result holding the block number.
+var cachedMethods = map[string]string{
+	"klay_getBlockByHash":                      "number",
+	"klay_getBlockByNumber":                    "number",
+	"klay_getHeaderByHash":                     "number",
+	"klay_getHeaderByNumber":                   "number",
+	"klay_getBlockWithConsensusInfoByHash":     "number",
+	"klay_getBlockWithConsensusInfoByNumber":   "number",
+	"klay_getTransactionByHash":                "blockNumber",
+	"klay_getTransactionBySenderTxHash":        "blockNumber",
+	"klay_getTransactionByBlockHashAndIndex":   "blockNumber",
+	"klay_getTransactionByBlockNumberAndIndex": "blockNumber",
+	"klay_getTransactionReceipt":               "blockNumber",
+	"klay_getTransactionReceiptBySenderTxHash": "blockNumber",
+	"eth_getBlockByHash":                       "number",
+	"eth_getBlockByNumber":                     "number",
+	"eth_getHeaderByHash":                      "number",
+	"eth_getHeaderByNumber":                    "number",
+	"eth_getTransactionByHash":                 "blockNumber",
+	"eth_getTransactionByBlockHashAndIndex":    "blockNumber",
+	"eth_getTransactionByBlockNumberAndIndex":  "blockNumber",
+	"eth_getTransactionReceipt":                "blockNumber",
+}
+
+var (
+	responseCacheMu sync.RWMutex
+	responseCache   *ResponseCache
+)
+
+// SetResponseCache sets the response cache used by all RPC servers. A nil cache disables caching.
+func SetResponseCache(c *ResponseCache) {
+	responseCacheMu.Lock()
+	defer responseCacheMu.Unlock()
+	responseCache = c
+}
+
+func getResponseCache() *ResponseCache {
+	responseCacheMu.RLock()
+	defer responseCacheMu.RUnlock()
+	return responseCache
+}
+
+// ResponseCache is an LRU cache of the encoded results of the immutable responses, keyed by
+// the method and the parameters of the request. The size of the cache is limited by the total
+// size of the keys and the results, and an entry expires after the TTL if it is not zero.
+type ResponseCache struct {
+	maxSize int
+	ttl     time.Duration
+	head    func() uint64
+
+	mu    sync.Mutex
+	size  int
+	lru   *list.List // of *cacheEntry, the most recently used at the front
+	items map[string]*list.Element
+}
+
+type cacheEntry struct {
+	key    string
+	result json.RawMessage
+	expire time.Time
+}
+
+// NewResponseCache creates a response cache of the given size in bytes and TTL. head returns
+// the number of the head block, below which the responses are immutable.
+func NewResponseCache(maxSize int, ttl time.Duration, head func() uint64) *ResponseCache {
+	return &ResponseCache{
+		maxSize: maxSize,
+		ttl:     ttl,
+		head:    head,
+		lru:     list.New(),
+		items:   make(map[string]*list.Element),
+	}
+}
+
+func cacheKey(method string, params json.RawMessage) string {
+	var buf bytes.Buffer
+	buf.WriteString(method)
+	buf.WriteByte(0)
+	if err := json.Compact(&buf, params); err != nil {
+		buf.Write(params)
+	}
+	return buf.String()
+}
+
+func (c *ResponseCache) get(method string, params json.RawMessage) (json.RawMessage, bool) {
+	key := cacheKey(method, params)
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	elem, ok := c.items[key]
+	if !ok {
+		rpcCacheMissCounter.Inc(1)
+		return nil, false
+	}
+	entry := elem.Value.(*cacheEntry)
+	if c.ttl > 0 && time.Now().After(entry.expire) {
+		c.remove(elem)
+		rpcCacheMissCounter.Inc(1)
+		return nil, false
+	}
+	c.lru.MoveToFront(elem)
+	rpcCacheHitCounter.Inc(1)
+	return entry.result, true
+}
+
+// add caches the result if the block of the result is below the head, which is read
+// before the request is executed.
+func (c *ResponseCache) add(method string, params json.RawMessage, result json.RawMessage, head uint64) {
+	field, ok := cachedMethods[method]
+	if !ok {
+		return
+	}
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(result, &fields); err != nil || fields == nil {
+		return
+	}
+	var hex string
+	if err := json.Unmarshal(fields[field], &hex); err != nil {
+		return
+	}
+	number, err := hexutil.DecodeUint64(hex)
+	if err != nil || number >= head {
+		return
+	}
+
+	key := cacheKey(method, params)
+	size := len(key) + len(result)
+	if size > c.maxSize {
+		return
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if elem, ok := c.items[key]; ok {
+		c.remove(elem)
+	}
+	for c.size+size > c.maxSize {
+		c.remove(c.lru.Back())
+	}
+	entry := &cacheEntry{key: key, result: result}
+	if c.ttl > 0 {
+		entry.expire = time.Now().Add(c.ttl)
+	}
+	c.items[key] = c.lru.PushFront(entry)
+	c.size += size
+	rpcCacheSizeGauge.Update(int64(c.size))
+}
+
+func (c *ResponseCache) remove(elem *list.Element) {
+	entry := c.lru.Remove(elem).(*cacheEntry)
+	delete(c.items, entry.key)
+	c.size -= len(entry.key) + len(entry.result)
+	rpcCacheSizeGauge.Update(int64(c.size))
+}
+
+// Purge removes all the cached responses. It is called when the head is rewound,
+// since the responses of the removed blocks are no longer valid.
+func (c *ResponseCache) Purge() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.lru.Init()
+	c.items = make(map[string]*list.Element)
+	c.size = 0
+	rpcCacheSizeGauge.Update(0)
+}
+
+// Len returns the number of the cached responses.
+func (c *ResponseCache) Len() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.lru.Len()
+}
@@ -0,0 +1,129 @@
+// Copyright 2024 The klaytn Authors
+// This file is part of the klaytn library.
+//
+// The klaytn library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The klaytn library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the klaytn library. If not, see <http://www.gnu.org/licenses/>.
+
+package rpc
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/klaytn/klaytn/common/hexutil"
+	"github.com/stretchr/testify/assert"
+)
+
+type cacheTestService struct {
+	calls int
+}
+
+func (s *cacheTestService) GetBlockByNumber(number hexutil.Uint64) map[string]interface{} {
+	s.calls++
+	return map[string]interface{}{"number": number, "hash": "0x01"}
+}
+
+func (s *cacheTestService) GetTransactionReceipt(hash string) map[string]interface{} {
+	s.calls++
+	return nil
+}
+
+func TestResponseCache_Add(t *testing.T) {
+	cache := NewResponseCache(1024, 0, nil)
+	params := json.RawMessage(`["0x1", true]`)
+
+	// The response of a block at or above the head can change.
+	cache.add("klay_getBlockByNumber", params, json.RawMessage(`{"number":"0x1"}`), 1)
+	_, ok := cache.get("klay_getBlockByNumber", params)
+	assert.False(t, ok)
+
+	// The responses without a block number or of a method out of the allow-list are not cached.
+	cache.add("klay_getTransactionReceipt", params, json.RawMessage(`null`), 2)
+	cache.add("klay_getTransactionByHash", params, json.RawMessage(`{"blockNumber":null}`), 2)
+	cache.add("klay_getBalance", params, json.RawMessage(`{"number":"0x1"}`), 2)
+	assert.Equal(t, 0, cache.Len())
+
+	cache.add("klay_getBlockByNumber", params, json.RawMessage(`{"number":"0x1"}`), 2)
+	result, ok := cache.get("klay_getBlockByNumber", json.RawMessage(`[ "0x1",true ]`))
+	assert.True(t, ok)
+	assert.Equal(t, `{"number":"0x1"}`, string(result))
+
+	_, ok = cache.get("klay_getBlockByNumber", json.RawMessage(`["0x1", false]`))
+	assert.False(t, ok)
+}
+
+func TestResponseCache_Limits(t *testing.T) {
+	result := json.RawMessage(`{"number":"0x1"}`)
+	entrySize := len(cacheKey("klay_getBlockByNumber", json.RawMessage(`["0x1"]`))) + len(result)
+
+	// The least recently used response is evicted when the cache is full.
+	cache := NewResponseCache(2*entrySize, 0, nil)
+	cache.add("klay_getBlockByNumber", json.RawMessage(`["0x1"]`), result, 2)
+	cache.add("klay_getBlockByNumber", json.RawMessage(`["0x2"]`), result, 2)
+	_, ok := cache.get("klay_getBlockByNumber", json.RawMessage(`["0x1"]`))
+	assert.True(t, ok)
+	cache.add("klay_getBlockByNumber", json.RawMessage(`["0x3"]`), result, 2)
+	assert.Equal(t, 2, cache.Len())
+	_, ok = cache.get("klay_getBlockByNumber", json.RawMessage(`["0x2"]`))
+	assert.False(t, ok)
+	_, ok = cache.get("klay_getBlockByNumber", json.RawMessage(`["0x1"]`))
+	assert.True(t, ok)
+
+	cache.Purge()
+	assert.Equal(t, 0, cache.Len())
+
+	// The response expires after the TTL.
+	cache = NewResponseCache(2*entrySize, 10*time.Millisecond, nil)
+	cache.add("klay_getBlockByNumber", json.RawMessage(`["0x1"]`), result, 2)
+	_, ok = cache.get("klay_getBlockByNumber", json.RawMessage(`["0x1"]`))
+	assert.True(t, ok)
+	time.Sleep(20 * time.Millisecond)
+	_, ok = cache.get("klay_getBlockByNumber", json.RawMessage(`["0x1"]`))
+	assert.False(t, ok)
+	assert.Equal(t, 0, cache.Len())
+}
+
+func TestResponseCache_Handler(t *testing.T) {
+	service := &cacheTestService{}
+	server := newTestServer("klay", service)
+	defer server.Stop()
+	client := DialInProc(server)
+	defer client.Close()
+
+	head := uint64(1)
+	SetResponseCache(NewResponseCache(1024, 0, func() uint64 { return head }))
+	defer SetResponseCache(nil)
+
+	var block map[string]interface{}
+	for i := 0; i < 2; i++ {
+		assert.NoError(t, client.Call(&block, "klay_getBlockByNumber", hexutil.Uint64(1)))
+		assert.Equal(t, "0x1", block["number"])
+	}
+	assert.Equal(t, 2, service.calls)
+
+	// The block is immutable once it is below the head.
+	head = 2
+	for i := 0; i < 2; i++ {
+		assert.NoError(t, client.Call(&block, "klay_getBlockByNumber", hexutil.Uint64(1)))
+		assert.Equal(t, "0x1", block["number"])
+	}
+	assert.Equal(t, 3, service.calls)
+
+	var receipt map[string]interface{}
+	for i := 0; i < 2; i++ {
+		assert.NoError(t, client.Call(&receipt, "klay_getTransactionReceipt", "0x01"))
+		assert.Nil(t, receipt)
+	}
+	assert.Equal(t, 5, service.calls)
+}
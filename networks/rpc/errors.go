@@ -71,6 +71,13 @@ func (e *callbackError) ErrorCode() int { return defaultErrorCode }
 
 func (e *callbackError) Error() string { return e.message }
 
+// a limit of the server is exceeded by the request
+type limitExceededError struct{ message string }
+
+func (e *limitExceededError) ErrorCode() int { return -32005 }
+
+func (e *limitExceededError) Error() string { return e.message }
+
 // issued when a request is received after the server is issued to stop.
 type shutdownError struct{}
 
@@ -73,6 +73,9 @@ type handler struct {
 type callProc struct {
 	ctx       context.Context
 	notifiers []*Notifier
+
+	respUsed     int  // size of the responses of the batch so far
+	respTooLarge bool // whether the last response exceeded the size limit
 }
 
 func newHandler(connCtx context.Context, conn jsonWriter, idgen func() ID, reg *serviceRegistry) *handler {
@@ -105,6 +108,26 @@ func (h *handler) handleBatch(msgs []*jsonrpcMessage) {
 
 	rpcTotalRequestsCounter.Inc(int64(len(msgs)))
 
+	// Reject all the calls of a batch exceeding the limit, answering each of them so that
+	// the clients waiting for the responses of their calls are not blocked.
+	limits := h.reg.limiter()
+	if err := limits.checkBatch(len(msgs)); err != nil {
+		rpcErrorResponsesCounter.Inc(int64(len(msgs)))
+		h.startCallProc(func(cp *callProc) {
+			answers := make([]*jsonrpcMessage, 0, len(msgs))
+			for _, msg := range msgs {
+				if msg.isCall() {
+					answers = append(answers, msg.errorResponse(err))
+				}
+			}
+			if len(answers) == 0 {
+				answers = append(answers, errorMessage(err))
+			}
+			h.conn.writeJSON(cp.ctx, answers)
+		})
+		return
+	}
+
 	// Handle non-call messages first:
 	calls := make([]*jsonrpcMessage, 0, len(msgs))
 	for _, msg := range msgs {
@@ -128,11 +151,35 @@ func (h *handler) handleBatch(msgs []*jsonrpcMessage) {
 
 	// Process calls on a goroutine because they may block indefinitely:
 	h.startCallProc(func(cp *callProc) {
-		answers := make([]*jsonrpcMessage, 0, len(msgs))
+		var (
+			answers  = make([]*jsonrpcMessage, 0, len(msgs))
+			size     int
+			tooLarge error
+		)
 		for _, msg := range calls {
-			if answer := h.handleCallMsg(cp, msg); answer != nil {
-				answers = append(answers, answer)
+			// Once the responses exceed the size limit, the remaining calls are not executed.
+			if tooLarge != nil {
+				if msg.isCall() {
+					rpcErrorResponsesCounter.Inc(1)
+					answers = append(answers, msg.errorResponse(tooLarge))
+				}
+				continue
+			}
+			cp.respUsed = size
+			answer := h.handleCallMsg(cp, msg)
+			if answer == nil {
+				continue
 			}
+			size += responseSize(answer)
+			if cp.respTooLarge {
+				tooLarge = limits.responseTooLarge()
+			} else if tooLarge = limits.checkResponse(size); tooLarge != nil {
+				rpcErrorResponsesCounter.Inc(1)
+				size -= responseSize(answer)
+				answer = msg.errorResponse(tooLarge)
+				size += responseSize(answer)
+			}
+			answers = append(answers, answer)
 		}
 		h.addSubscriptions(cp.notifiers)
 		if len(answers) > 0 {
@@ -331,8 +378,12 @@ func (h *handler) handleCallMsg(ctx *callProc, msg *jsonrpcMessage) *jsonrpcMess
 		return nil
 	case msg.isCall():
 		resp := h.handleCall(ctx, msg)
+		duration := time.Since(start)
+		if rec := getRecorder(); rec != nil {
+			rec.record(ctx.ctx, msg, resp, start, duration)
+		}
 		var ctx []interface{}
-		ctx = append(ctx, "reqid", idForLog{msg.ID}, "duration", time.Since(start))
+		ctx = append(ctx, "reqid", idForLog{msg.ID}, "duration", duration)
 		if resp.Error != nil {
 			ctx = append(ctx, "err", resp.Error.Message)
 			if resp.Error.Data != nil {
@@ -373,7 +424,45 @@ func (h *handler) handleCall(cp *callProc, msg *jsonrpcMessage) *jsonrpcMessage
 		rpcErrorResponsesCounter.Inc(1)
 		return msg.errorResponse(&invalidParamsError{err.Error()})
 	}
-	return h.runMethod(cp.ctx, msg, callb, args)
+
+	limits := h.reg.limiter()
+	release, err := limits.acquire(msg.Method)
+	if err != nil {
+		rpcErrorResponsesCounter.Inc(1)
+		return msg.errorResponse(err)
+	}
+	defer release()
+	ctx, cancel := limits.withTimeout(cp.ctx, msg.Method)
+	defer cancel()
+
+	if cache := getResponseCache(); cache != nil {
+		if _, ok := cachedMethods[msg.Method]; ok {
+			return h.runCachedMethod(ctx, cp, cache, msg, callb, args)
+		}
+	}
+	return h.runMethod(ctx, cp, msg, callb, args)
+}
+
+// runCachedMethod returns the cached response of the method, or runs the method and caches
+// its response if it is immutable.
+func (h *handler) runCachedMethod(ctx context.Context, cp *callProc, cache *ResponseCache, msg *jsonrpcMessage, callb *callback, args []reflect.Value) *jsonrpcMessage {
+	if result, ok := cache.get(msg.Method, msg.Params); ok {
+		if limits := h.reg.limiter(); limits.checkResponse(cp.respUsed+len(result)) != nil {
+			cp.respTooLarge = true
+			rpcErrorResponsesCounter.Inc(1)
+			return msg.errorResponse(limits.responseTooLarge())
+		}
+		rpcSuccessResponsesCounter.Inc(1)
+		return &jsonrpcMessage{Version: vsn, ID: msg.ID, Result: result}
+	}
+	// Read the head before running the method, so that the result of a block tag like
+	// "latest" is never below the head.
+	head := cache.head()
+	resp := h.runMethod(ctx, cp, msg, callb, args)
+	if resp.Error == nil {
+		cache.add(msg.Method, msg.Params, resp.Result, head)
+	}
+	return resp
 }
 
 // handleSubscribe processes *_subscribe method calls.
@@ -420,19 +509,38 @@ func (h *handler) handleSubscribe(cp *callProc, msg *jsonrpcMessage) *jsonrpcMes
 
 	wsSubscriptionReqCounter.Inc(1)
 
-	return h.runMethod(ctx, msg, callb, args)
+	return h.runMethod(ctx, cp, msg, callb, args)
 }
 
-// runMethod runs the Go callback for an RPC method.
-func (h *handler) runMethod(ctx context.Context, msg *jsonrpcMessage, callb *callback, args []reflect.Value) *jsonrpcMessage {
+// runMethod runs the Go callback for an RPC method. The encoding of the result is aborted
+// once it exceeds the size left for the response.
+func (h *handler) runMethod(ctx context.Context, cp *callProc, msg *jsonrpcMessage, callb *callback, args []reflect.Value) *jsonrpcMessage {
 	result, err := callb.call(ctx, msg.Method, args)
 	if err != nil {
 		rpcErrorResponsesCounter.Inc(1)
 		return msg.errorResponse(err)
 	}
 
+	limits := h.reg.limiter()
+	enc, err := encodeResult(result, limits.responseLimit(cp.respUsed))
+	if err == errResultTooLarge {
+		cp.respTooLarge = true
+		rpcErrorResponsesCounter.Inc(1)
+		return msg.errorResponse(limits.responseTooLarge())
+	} else if err != nil {
+		rpcErrorResponsesCounter.Inc(1)
+		return msg.errorResponse(err)
+	}
 	rpcSuccessResponsesCounter.Inc(1)
-	return msg.response(result)
+	return &jsonrpcMessage{Version: vsn, ID: msg.ID, Result: enc}
+}
+
+// responseSize returns the size of the result or the error message of a response.
+func responseSize(resp *jsonrpcMessage) int {
+	if resp.Error != nil {
+		return len(resp.Error.Message)
+	}
+	return len(resp.Result)
 }
 
 // unsubscribe is the callback function for all *_unsubscribe calls.
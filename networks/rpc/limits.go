@@ -0,0 +1,280 @@
+// Copyright 2024 The klaytn Authors
+// This file is part of the klaytn library.
+//
+// The klaytn library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The klaytn library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the klaytn library. If not, see <http://www.gnu.org/licenses/>.
+
+package rpc
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"sort"
+	"time"
+)
+
+// Limits are the limits of the calls served by a Server. The zero value has no limits.
+type Limits struct {
+	// BatchItemLimit is the maximum number of the calls in a batch. 0 is unlimited.
+	BatchItemLimit int `toml:",omitempty"`
+
+	// ResponseSizeLimit is the maximum size in bytes of a response. The remaining calls
+	// of a batch are not executed once the responses exceed the limit. 0 is unlimited.
+	ResponseSizeLimit int `toml:",omitempty"`
+
+	// MethodTimeouts are the execution timeouts of the methods. For the methods running
+	// the EVM, such as klay_call, the timeout overrides the global RPCEVMTimeout.
+	MethodTimeouts map[string]time.Duration `toml:",omitempty"`
+
+	// MethodConcurrency are the maximum numbers of the concurrent calls of the methods.
+	// The calls exceeding the limit are rejected.
+	MethodConcurrency map[string]int `toml:",omitempty"`
+}
+
+// limiter enforces the Limits of a Server.
+type limiter struct {
+	Limits
+	slots map[string]chan struct{} // Semaphores of the methods with a concurrency limit
+}
+
+func newLimiter(limits Limits) *limiter {
+	l := &limiter{Limits: limits, slots: make(map[string]chan struct{})}
+	for method, n := range limits.MethodConcurrency {
+		if n > 0 {
+			l.slots[method] = make(chan struct{}, n)
+		}
+	}
+	return l
+}
+
+// SetLimits sets the limits of the calls served by the server.
+func (s *Server) SetLimits(limits Limits) {
+	s.services.setLimiter(newLimiter(limits))
+}
+
+func (r *serviceRegistry) setLimiter(l *limiter) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.limits = l
+}
+
+// limiter returns the limiter of the server, or nil if it has no limits.
+func (r *serviceRegistry) limiter() *limiter {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.limits
+}
+
+// checkBatch returns an error if the batch has too many calls.
+func (l *limiter) checkBatch(n int) error {
+	if l != nil && l.BatchItemLimit > 0 && n > l.BatchItemLimit {
+		return &limitExceededError{fmt.Sprintf("batch too large: %d calls exceed the limit of %d", n, l.BatchItemLimit)}
+	}
+	return nil
+}
+
+// checkResponse returns an error if the size of the response exceeds the limit.
+func (l *limiter) checkResponse(size int) error {
+	if l != nil && l.ResponseSizeLimit > 0 && size > l.ResponseSizeLimit {
+		return l.responseTooLarge()
+	}
+	return nil
+}
+
+// responseLimit returns the number of bytes left for a response after the given number of
+// bytes are used by the other responses of a batch. 0 is unlimited.
+func (l *limiter) responseLimit(used int) int {
+	if l == nil || l.ResponseSizeLimit <= 0 {
+		return 0
+	}
+	if left := l.ResponseSizeLimit - used; left > 0 {
+		return left
+	}
+	return 1
+}
+
+func (l *limiter) responseTooLarge() error {
+	return &limitExceededError{fmt.Sprintf("response too large: exceeds the limit of %d bytes", l.ResponseSizeLimit)}
+}
+
+var errResultTooLarge = errors.New("result exceeds the size limit")
+
+// limitedBuffer is a buffer failing the writes exceeding its limit. 0 is unlimited.
+type limitedBuffer struct {
+	buf   bytes.Buffer
+	limit int
+}
+
+func (b *limitedBuffer) Write(p []byte) (int, error) {
+	if b.limit > 0 && b.buf.Len()+len(p) > b.limit {
+		return 0, errResultTooLarge
+	}
+	return b.buf.Write(p)
+}
+
+// encodeResult encodes the result of a call as json.Marshal does, but fails with
+// errResultTooLarge as soon as the encoding exceeds the limit. The elements of slices,
+// arrays and maps are encoded one by one, so that a large result is aborted without
+// being encoded entirely. 0 is unlimited.
+func encodeResult(result interface{}, limit int) ([]byte, error) {
+	if limit <= 0 {
+		return json.Marshal(result)
+	}
+	buf := &limitedBuffer{limit: limit}
+	if err := encodeValue(buf, reflect.ValueOf(result)); err != nil {
+		return nil, err
+	}
+	return buf.buf.Bytes(), nil
+}
+
+func encodeValue(buf *limitedBuffer, v reflect.Value) error {
+	if !v.IsValid() {
+		_, err := buf.Write([]byte("null"))
+		return err
+	}
+	// Values with their own encoding are encoded by json.Marshal.
+	t := v.Type()
+	if t.Implements(jsonMarshalerType) || t.Implements(textMarshalerType) {
+		return encodeLeaf(buf, v)
+	}
+	if v.CanAddr() && (reflect.PtrTo(t).Implements(jsonMarshalerType) || reflect.PtrTo(t).Implements(textMarshalerType)) {
+		return encodeLeaf(buf, v.Addr())
+	}
+
+	switch v.Kind() {
+	case reflect.Ptr, reflect.Interface:
+		if v.IsNil() {
+			_, err := buf.Write([]byte("null"))
+			return err
+		}
+		return encodeValue(buf, v.Elem())
+	case reflect.Slice:
+		if v.IsNil() {
+			_, err := buf.Write([]byte("null"))
+			return err
+		}
+		if t.Elem().Kind() == reflect.Uint8 {
+			return encodeLeaf(buf, v) // base64 encoded
+		}
+		return encodeArray(buf, v)
+	case reflect.Array:
+		return encodeArray(buf, v)
+	case reflect.Map:
+		if t.Key().Kind() != reflect.String || t.Key().Implements(textMarshalerType) {
+			return encodeLeaf(buf, v)
+		}
+		if v.IsNil() {
+			_, err := buf.Write([]byte("null"))
+			return err
+		}
+		return encodeMap(buf, v)
+	default:
+		return encodeLeaf(buf, v)
+	}
+}
+
+func encodeLeaf(buf *limitedBuffer, v reflect.Value) error {
+	enc, err := json.Marshal(v.Interface())
+	if err != nil {
+		return err
+	}
+	_, err = buf.Write(enc)
+	return err
+}
+
+func encodeArray(buf *limitedBuffer, v reflect.Value) error {
+	if _, err := buf.Write([]byte{'['}); err != nil {
+		return err
+	}
+	for i := 0; i < v.Len(); i++ {
+		if i > 0 {
+			if _, err := buf.Write([]byte{','}); err != nil {
+				return err
+			}
+		}
+		if err := encodeValue(buf, v.Index(i)); err != nil {
+			return err
+		}
+	}
+	_, err := buf.Write([]byte{']'})
+	return err
+}
+
+func encodeMap(buf *limitedBuffer, v reflect.Value) error {
+	keys := v.MapKeys()
+	sort.Slice(keys, func(i, j int) bool { return keys[i].String() < keys[j].String() })
+	if _, err := buf.Write([]byte{'{'}); err != nil {
+		return err
+	}
+	for i, key := range keys {
+		if i > 0 {
+			if _, err := buf.Write([]byte{','}); err != nil {
+				return err
+			}
+		}
+		enc, err := json.Marshal(key.String())
+		if err != nil {
+			return err
+		}
+		if _, err := buf.Write(append(enc, ':')); err != nil {
+			return err
+		}
+		if err := encodeValue(buf, v.MapIndex(key)); err != nil {
+			return err
+		}
+	}
+	_, err := buf.Write([]byte{'}'})
+	return err
+}
+
+// acquire takes a slot to call the method, and returns the function releasing the slot.
+func (l *limiter) acquire(method string) (func(), error) {
+	if l == nil {
+		return func() {}, nil
+	}
+	slots, ok := l.slots[method]
+	if !ok {
+		return func() {}, nil
+	}
+	select {
+	case slots <- struct{}{}:
+		return func() { <-slots }, nil
+	default:
+		return nil, &limitExceededError{fmt.Sprintf("too many concurrent calls of %s: exceeds the limit of %d", method, cap(slots))}
+	}
+}
+
+// withTimeout returns the context of the call with the timeout of the method.
+func (l *limiter) withTimeout(ctx context.Context, method string) (context.Context, context.CancelFunc) {
+	if l == nil {
+		return ctx, func() {}
+	}
+	timeout, ok := l.MethodTimeouts[method]
+	if !ok || timeout <= 0 {
+		return ctx, func() {}
+	}
+	ctx = context.WithValue(ctx, methodTimeoutKey{}, timeout)
+	return context.WithTimeout(ctx, timeout)
+}
+
+type methodTimeoutKey struct{}
+
+// MethodTimeout returns the timeout of the method being called, if it is configured.
+func MethodTimeout(ctx context.Context) (time.Duration, bool) {
+	timeout, ok := ctx.Value(methodTimeoutKey{}).(time.Duration)
+	return timeout, ok
+}
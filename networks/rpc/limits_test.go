@@ -0,0 +1,206 @@
+// Copyright 2024 The klaytn Authors
+// This file is part of the klaytn library.
+//
+// The klaytn library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The klaytn library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the klaytn library. If not, see <http://www.gnu.org/licenses/>.
+
+package rpc
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/klaytn/klaytn/common"
+	"github.com/klaytn/klaytn/common/hexutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type limitsTestService struct {
+	entered chan struct{}
+	block   chan struct{}
+}
+
+func (s *limitsTestService) Data(size int) string {
+	return strings.Repeat("x", size)
+}
+
+func (s *limitsTestService) Timeout(ctx context.Context) (time.Duration, error) {
+	timeout, _ := MethodTimeout(ctx)
+	<-ctx.Done()
+	return timeout, ctx.Err()
+}
+
+func (s *limitsTestService) Block() {
+	s.entered <- struct{}{}
+	<-s.block
+}
+
+func newLimitsTestClient(t *testing.T, limits Limits) (*Client, *limitsTestService) {
+	service := &limitsTestService{entered: make(chan struct{}, 1), block: make(chan struct{})}
+	server := newTestServer("test", service)
+	server.SetLimits(limits)
+	t.Cleanup(server.Stop)
+	client := DialInProc(server)
+	t.Cleanup(client.Close)
+	return client, service
+}
+
+func TestLimits_Batch(t *testing.T) {
+	client, _ := newLimitsTestClient(t, Limits{BatchItemLimit: 2})
+
+	batch := []BatchElem{
+		{Method: "test_data", Args: []interface{}{1}, Result: new(string)},
+		{Method: "test_data", Args: []interface{}{1}, Result: new(string)},
+	}
+	require.NoError(t, client.BatchCall(batch))
+	for _, elem := range batch {
+		assert.NoError(t, elem.Error)
+	}
+
+	batch = append(batch, BatchElem{Method: "test_data", Args: []interface{}{1}, Result: new(string)})
+	require.NoError(t, client.BatchCall(batch))
+	for _, elem := range batch {
+		require.Error(t, elem.Error)
+		assert.Contains(t, elem.Error.Error(), "batch too large")
+	}
+}
+
+func TestLimits_ResponseSize(t *testing.T) {
+	client, _ := newLimitsTestClient(t, Limits{ResponseSizeLimit: 100})
+
+	var result string
+	assert.NoError(t, client.Call(&result, "test_data", 50))
+	err := client.Call(&result, "test_data", 200)
+	require.Error(t, err)
+	assert.Equal(t, -32005, err.(Error).ErrorCode())
+	assert.Contains(t, err.Error(), "response too large")
+
+	// The calls after the responses exceed the limit are aborted.
+	batch := []BatchElem{
+		{Method: "test_data", Args: []interface{}{50}, Result: new(string)},
+		{Method: "test_data", Args: []interface{}{50}, Result: new(string)},
+		{Method: "test_data", Args: []interface{}{1}, Result: new(string)},
+	}
+	require.NoError(t, client.BatchCall(batch))
+	assert.NoError(t, batch[0].Error)
+	assert.Equal(t, strings.Repeat("x", 50), *batch[0].Result.(*string))
+	assert.Error(t, batch[1].Error)
+	assert.Error(t, batch[2].Error)
+}
+
+func TestLimits_ResponseSizeBatch(t *testing.T) {
+	client, _ := newLimitsTestClient(t, Limits{ResponseSizeLimit: 100})
+
+	// The calls after a response exceeding the limit are aborted.
+	batch := []BatchElem{
+		{Method: "test_data", Args: []interface{}{200}, Result: new(string)},
+		{Method: "test_data", Args: []interface{}{1}, Result: new(string)},
+	}
+	require.NoError(t, client.BatchCall(batch))
+	for _, elem := range batch {
+		require.Error(t, elem.Error)
+		assert.Contains(t, elem.Error.Error(), "response too large")
+	}
+}
+
+func TestLimits_StartEndpoint(t *testing.T) {
+	endpoint := fmt.Sprintf("%s/klaytn-test-limits-%d.ipc", t.TempDir(), os.Getpid())
+	apis := []API{{Namespace: "test", Service: new(limitsTestService)}}
+	listener, server, err := StartIPCEndpoint(endpoint, apis, Limits{ResponseSizeLimit: 100})
+	require.NoError(t, err)
+	defer server.Stop()
+	defer listener.Close()
+
+	// The limits are applied from the first call.
+	client, err := Dial(endpoint)
+	require.NoError(t, err)
+	defer client.Close()
+	err = client.Call(new(string), "test_data", 200)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "response too large")
+}
+
+func TestEncodeResult(t *testing.T) {
+	type item struct {
+		Hash  common.Hash    `json:"hash"`
+		Value *hexutil.Big   `json:"value"`
+		Data  []byte         `json:"data"`
+		Extra map[string]int `json:"extra,omitempty"`
+	}
+	items := []item{
+		{Hash: common.HexToHash("0x01"), Value: (*hexutil.Big)(big.NewInt(1)), Data: []byte{1, 2}},
+		{Hash: common.HexToHash("0x02"), Extra: map[string]int{"b": 2, "a": 1}},
+	}
+	results := []interface{}{
+		nil,
+		"<string>",
+		[]string(nil),
+		[]interface{}{1, "a", nil, []byte{1}},
+		[2]uint8{1, 2},
+		items,
+		&items[0],
+		map[string]interface{}{"z": items, "a": nil, "m": map[string]string{"<": ">"}},
+		map[int]string{2: "b", 1: "a"},
+		map[common.Address]int{{1}: 1},
+		hexutil.Bytes{1, 2, 3},
+	}
+	for _, result := range results {
+		expected, err := json.Marshal(result)
+		require.NoError(t, err)
+		for _, limit := range []int{0, len(expected)} {
+			enc, err := encodeResult(result, limit)
+			require.NoError(t, err)
+			assert.Equal(t, string(expected), string(enc))
+		}
+		_, err = encodeResult(result, len(expected)-1)
+		assert.Equal(t, errResultTooLarge, err)
+	}
+}
+
+func TestLimits_MethodTimeout(t *testing.T) {
+	client, _ := newLimitsTestClient(t, Limits{MethodTimeouts: map[string]time.Duration{"test_timeout": 50 * time.Millisecond}})
+
+	start := time.Now()
+	err := client.Call(nil, "test_timeout")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), context.DeadlineExceeded.Error())
+	assert.Less(t, time.Since(start), 5*time.Second)
+}
+
+func TestLimits_MethodConcurrency(t *testing.T) {
+	client, service := newLimitsTestClient(t, Limits{MethodConcurrency: map[string]int{"test_block": 1}})
+
+	done := make(chan error)
+	go func() { done <- client.Call(nil, "test_block") }()
+
+	<-service.entered
+
+	// The second call is rejected while the first one is running.
+	err := client.Call(nil, "test_block")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "too many concurrent calls of test_block")
+
+	// The other methods are not limited.
+	assert.NoError(t, client.Call(new(string), "test_data", 1))
+
+	close(service.block)
+	assert.NoError(t, <-done)
+	assert.NoError(t, client.Call(nil, "test_block"))
+}
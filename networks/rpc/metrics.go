@@ -11,4 +11,11 @@ var (
 	wsSubscriptionReqCounter   = metrics.NewRegisteredCounter("ws/counts/subscription/request", nil)
 	wsUnsubscriptionReqCounter = metrics.NewRegisteredCounter("ws/counts/unsubscription/request", nil)
 	wsConnCounter              = metrics.NewRegisteredCounter("ws/counts/connections/total", nil)
+
+	rpcCacheHitCounter  = metrics.NewRegisteredCounter("rpc/cache/hit", nil)
+	rpcCacheMissCounter = metrics.NewRegisteredCounter("rpc/cache/miss", nil)
+	rpcCacheSizeGauge   = metrics.NewRegisteredGauge("rpc/cache/size", nil)
+
+	rpcRecordedCounter      = metrics.NewRegisteredCounter("rpc/record/recorded", nil)
+	rpcRecordDroppedCounter = metrics.NewRegisteredCounter("rpc/record/dropped", nil)
 )
@@ -0,0 +1,252 @@
+// Copyright 2024 The klaytn Authors
+// This file is part of the klaytn library.
+//
+// The klaytn library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The klaytn library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the klaytn library. If not, see <http://www.gnu.org/licenses/>.
+
+package rpc
+
+import (
+	"encoding"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"path"
+	"reflect"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/klaytn/klaytn/params"
+)
+
+// OpenRPCVersion is the version of the OpenRPC specification the discovery document follows.
+const OpenRPCVersion = "1.2.6"
+
+var (
+	bigIntType         = reflect.TypeOf(big.Int{})
+	durationType       = reflect.TypeOf(time.Duration(0))
+	jsonMarshalerType  = reflect.TypeOf((*json.Marshaler)(nil)).Elem()
+	textMarshalerType  = reflect.TypeOf((*encoding.TextMarshaler)(nil)).Elem()
+	byteSliceType      = reflect.TypeOf([]byte{})
+	emptyInterfaceType = reflect.TypeOf((*interface{})(nil)).Elem()
+)
+
+// OpenRPCDocument is an OpenRPC document describing the methods served by a Server.
+// See https://spec.open-rpc.org for the specification.
+type OpenRPCDocument struct {
+	OpenRPC    string            `json:"openrpc"`
+	Info       OpenRPCInfo       `json:"info"`
+	Methods    []*OpenRPCMethod  `json:"methods"`
+	Components OpenRPCComponents `json:"components"`
+}
+
+// OpenRPCInfo is the metadata of the API.
+type OpenRPCInfo struct {
+	Title   string `json:"title"`
+	Version string `json:"version"`
+}
+
+// OpenRPCMethod describes a method. Subscriptions are marked with XSubscription and are
+// called through the subscribe method of their namespace with the name as the first parameter.
+type OpenRPCMethod struct {
+	Name          string                      `json:"name"`
+	Summary       string                      `json:"summary,omitempty"`
+	Params        []*OpenRPCContentDescriptor `json:"params"`
+	Result        *OpenRPCContentDescriptor   `json:"result"`
+	XSubscription bool                        `json:"x-subscription,omitempty"`
+}
+
+// OpenRPCContentDescriptor describes a parameter or a result of a method.
+type OpenRPCContentDescriptor struct {
+	Name     string         `json:"name"`
+	Required bool           `json:"required,omitempty"`
+	Schema   *OpenRPCSchema `json:"schema"`
+}
+
+// OpenRPCComponents holds the schemas of the named types referred by the methods.
+type OpenRPCComponents struct {
+	Schemas map[string]*OpenRPCSchema `json:"schemas"`
+}
+
+// OpenRPCSchema is the subset of JSON Schema used to describe the Go types.
+type OpenRPCSchema struct {
+	Ref                  string                    `json:"$ref,omitempty"`
+	Title                string                    `json:"title,omitempty"`
+	Type                 string                    `json:"type,omitempty"`
+	Items                *OpenRPCSchema            `json:"items,omitempty"`
+	Properties           map[string]*OpenRPCSchema `json:"properties,omitempty"`
+	AdditionalProperties *OpenRPCSchema            `json:"additionalProperties,omitempty"`
+}
+
+// openRPC generates the OpenRPC document of the registered services.
+func (r *serviceRegistry) openRPC() *OpenRPCDocument {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	gen := &schemaGenerator{
+		schemas: make(map[string]*OpenRPCSchema),
+		names:   make(map[reflect.Type]string),
+	}
+	doc := &OpenRPCDocument{
+		OpenRPC:    OpenRPCVersion,
+		Info:       OpenRPCInfo{Title: "Klaytn JSON-RPC API", Version: params.Version},
+		Methods:    []*OpenRPCMethod{},
+		Components: OpenRPCComponents{Schemas: gen.schemas},
+	}
+	for name, svc := range r.services {
+		for method, cb := range svc.callbacks {
+			doc.Methods = append(doc.Methods, gen.method(name+serviceMethodSeparator+method, cb))
+		}
+		for subscription, cb := range svc.subscriptions {
+			m := gen.method(name+serviceMethodSeparator+subscription, cb)
+			m.Summary = fmt.Sprintf("Subscription called by %s%ssubscribe with %q", name, serviceMethodSeparator, subscription)
+			m.XSubscription = true
+			doc.Methods = append(doc.Methods, m)
+		}
+	}
+	sort.Slice(doc.Methods, func(i, j int) bool {
+		return doc.Methods[i].Name < doc.Methods[j].Name
+	})
+	return doc
+}
+
+// schemaGenerator derives the schemas from the Go types, collecting the named structs as
+// components so that the recursive types can be described.
+type schemaGenerator struct {
+	schemas map[string]*OpenRPCSchema
+	names   map[reflect.Type]string
+}
+
+func (g *schemaGenerator) method(name string, cb *callback) *OpenRPCMethod {
+	m := &OpenRPCMethod{Name: name, Params: []*OpenRPCContentDescriptor{}}
+	for i, typ := range cb.argTypes {
+		m.Params = append(m.Params, &OpenRPCContentDescriptor{
+			Name:     fmt.Sprintf("arg%d", i),
+			Required: typ.Kind() != reflect.Ptr,
+			Schema:   g.schema(typ),
+		})
+	}
+	fntype := cb.fn.Type()
+	switch {
+	case cb.isSubscribe:
+		m.Result = &OpenRPCContentDescriptor{Name: "subscription", Schema: &OpenRPCSchema{Title: "ID", Type: "string"}}
+	case fntype.NumOut() > 0 && cb.errPos != 0:
+		m.Result = &OpenRPCContentDescriptor{Name: "result", Schema: g.schema(fntype.Out(0))}
+	default:
+		m.Result = &OpenRPCContentDescriptor{Name: "result", Schema: &OpenRPCSchema{Type: "null"}}
+	}
+	return m
+}
+
+func implements(typ, iface reflect.Type) bool {
+	return typ.Implements(iface) || reflect.PtrTo(typ).Implements(iface)
+}
+
+// schema returns the schema of the JSON encoding of the given type.
+func (g *schemaGenerator) schema(typ reflect.Type) *OpenRPCSchema {
+	for typ.Kind() == reflect.Ptr {
+		typ = typ.Elem()
+	}
+	switch {
+	case typ == bigIntType:
+		return &OpenRPCSchema{Title: typ.String(), Type: "integer"}
+	case typ == durationType:
+		return &OpenRPCSchema{Title: typ.String(), Type: "integer"}
+	case typ == emptyInterfaceType:
+		return &OpenRPCSchema{}
+	case implements(typ, jsonMarshalerType):
+		// The encoding is up to the type itself.
+		return &OpenRPCSchema{Title: typ.String()}
+	case implements(typ, textMarshalerType):
+		return &OpenRPCSchema{Title: typ.String(), Type: "string"}
+	case typ == byteSliceType:
+		return &OpenRPCSchema{Type: "string"}
+	}
+
+	switch typ.Kind() {
+	case reflect.Bool:
+		return &OpenRPCSchema{Type: "boolean"}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return &OpenRPCSchema{Type: "integer"}
+	case reflect.Float32, reflect.Float64:
+		return &OpenRPCSchema{Type: "number"}
+	case reflect.String:
+		return &OpenRPCSchema{Type: "string"}
+	case reflect.Slice, reflect.Array:
+		return &OpenRPCSchema{Type: "array", Items: g.schema(typ.Elem())}
+	case reflect.Map:
+		return &OpenRPCSchema{Type: "object", AdditionalProperties: g.schema(typ.Elem())}
+	case reflect.Struct:
+		if typ.Name() == "" {
+			return g.structSchema(typ)
+		}
+		return g.ref(typ)
+	default:
+		// Interfaces can hold any value.
+		return &OpenRPCSchema{}
+	}
+}
+
+// ref returns the reference to the component schema of the named struct.
+func (g *schemaGenerator) ref(typ reflect.Type) *OpenRPCSchema {
+	name, ok := g.names[typ]
+	if !ok {
+		name = typ.Name()
+		if _, taken := g.schemas[name]; taken {
+			name = path.Base(typ.PkgPath()) + "." + name
+		}
+		g.names[typ] = name
+		// Register the name before the fields so that a recursive field refers to it.
+		g.schemas[name] = nil
+		g.schemas[name] = g.structSchema(typ)
+		g.schemas[name].Title = typ.String()
+	}
+	return &OpenRPCSchema{Ref: "#/components/schemas/" + name}
+}
+
+// structSchema returns the schema of the struct following the rules of encoding/json.
+func (g *schemaGenerator) structSchema(typ reflect.Type) *OpenRPCSchema {
+	s := &OpenRPCSchema{Type: "object", Properties: make(map[string]*OpenRPCSchema)}
+	for i := 0; i < typ.NumField(); i++ {
+		field := typ.Field(i)
+		tag := field.Tag.Get("json")
+		if tag == "-" {
+			continue
+		}
+		name := strings.Split(tag, ",")[0]
+		if field.Anonymous && name == "" {
+			ftyp := field.Type
+			for ftyp.Kind() == reflect.Ptr {
+				ftyp = ftyp.Elem()
+			}
+			if ftyp.Kind() == reflect.Struct && !implements(ftyp, jsonMarshalerType) {
+				for k, v := range g.structSchema(ftyp).Properties {
+					if _, ok := s.Properties[k]; !ok {
+						s.Properties[k] = v
+					}
+				}
+				continue
+			}
+		}
+		if !field.IsExported() {
+			continue
+		}
+		if name == "" {
+			name = field.Name
+		}
+		s.Properties[name] = g.schema(field.Type)
+	}
+	return s
+}
@@ -0,0 +1,91 @@
+// Copyright 2024 The klaytn Authors
+// This file is part of the klaytn library.
+//
+// The klaytn library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The klaytn library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the klaytn library. If not, see <http://www.gnu.org/licenses/>.
+
+package rpc
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type TreeNode struct {
+	Name     string      `json:"name"`
+	Children []*TreeNode `json:"children,omitempty"`
+	Ignored  string      `json:"-"`
+	internal int
+}
+
+type openRPCTestService struct{}
+
+func (s *openRPCTestService) Tree(root string, depth *int) (*TreeNode, error) {
+	return nil, nil
+}
+
+func TestOpenRPC(t *testing.T) {
+	server := newTestServer("test", new(Service))
+	require.NoError(t, server.RegisterName("test", new(openRPCTestService)))
+	client := DialInProc(server)
+	defer client.Close()
+
+	var doc OpenRPCDocument
+	require.NoError(t, client.Call(&doc, "rpc_discover"))
+	assert.Equal(t, OpenRPCVersion, doc.OpenRPC)
+
+	methods := make(map[string]*OpenRPCMethod)
+	for _, m := range doc.Methods {
+		methods[m.Name] = m
+	}
+	assert.Contains(t, methods, "rpc_modules")
+	assert.Contains(t, methods, "rpc_discover")
+
+	echo := methods["test_echo"]
+	require.NotNil(t, echo)
+	require.Len(t, echo.Params, 3)
+	assert.Equal(t, "string", echo.Params[0].Schema.Type)
+	assert.Equal(t, "integer", echo.Params[1].Schema.Type)
+	assert.True(t, echo.Params[1].Required)
+	assert.False(t, echo.Params[2].Required)
+	assert.Equal(t, "#/components/schemas/Result", echo.Result.Schema.Ref)
+	assert.Equal(t, "null", methods["test_noArgsRets"].Result.Schema.Type)
+
+	// The context is not a parameter, and the subscription is marked.
+	assert.Len(t, methods["test_sleep"].Params, 1)
+	subscription := methods["test_subscription"]
+	require.NotNil(t, subscription)
+	assert.True(t, subscription.XSubscription)
+	assert.Empty(t, subscription.Params)
+
+	// The recursive struct refers to itself.
+	tree := methods["test_tree"]
+	require.NotNil(t, tree)
+	assert.True(t, tree.Params[0].Required)
+	assert.False(t, tree.Params[1].Required)
+	node := doc.Components.Schemas["TreeNode"]
+	require.NotNil(t, node)
+	assert.Equal(t, "object", node.Type)
+	assert.Len(t, node.Properties, 2)
+	assert.Equal(t, "#/components/schemas/TreeNode", node.Properties["children"].Items.Ref)
+
+	// The document is stable to be compared with the previous one.
+	var again json.RawMessage
+	require.NoError(t, client.Call(&again, "rpc_discover"))
+	first, err := json.Marshal(doc)
+	require.NoError(t, err)
+	assert.JSONEq(t, string(first), string(again))
+}
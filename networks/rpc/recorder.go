@@ -0,0 +1,304 @@
+// Copyright 2024 The klaytn Authors
+// This file is part of the klaytn library.
+//
+// The klaytn library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The klaytn library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the klaytn library. If not, see <http://www.gnu.org/licenses/>.
+
+package rpc
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"math/rand"
+	"net"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	recordFilePrefix = "rpc-"
+	recordFileSuffix = ".jsonl"
+
+	// recordQueueSize is the number of the calls waiting to be written. The calls
+	// recorded while the queue is full are dropped not to slow down the server.
+	recordQueueSize = 4096
+)
+
+var (
+	// unrecordedNamespaces are the namespaces whose calls are never recorded,
+	// since their parameters have passphrases or private keys.
+	unrecordedNamespaces = map[string]bool{"personal": true}
+
+	// unrecordedMethodPrefixes are the prefixes of the methods signing with or
+	// unlocking accounts in any namespace, which are never recorded either.
+	unrecordedMethodPrefixes = []string{"sign", "unlock"}
+)
+
+// isRecordable returns whether the calls of the method can be written to the record files.
+func isRecordable(method string) bool {
+	elem := strings.SplitN(method, serviceMethodSeparator, 2)
+	if len(elem) != 2 || unrecordedNamespaces[elem[0]] {
+		return false
+	}
+	for _, prefix := range unrecordedMethodPrefixes {
+		if strings.HasPrefix(elem[1], prefix) {
+			return false
+		}
+	}
+	return true
+}
+
+// RecordedCall is a sampled call written by the Recorder, one JSON object per line.
+type RecordedCall struct {
+	Time         time.Time       `json:"time"`
+	Method       string          `json:"method"`
+	Params       json.RawMessage `json:"params,omitempty"`
+	Duration     time.Duration   `json:"duration"`
+	ClientID     string          `json:"clientId,omitempty"`
+	ResponseSize int             `json:"responseSize"`
+	ResultHash   string          `json:"resultHash,omitempty"`
+	Error        string          `json:"error,omitempty"`
+}
+
+// ResultHash returns the hash of the result of a call regardless of its formatting,
+// which is compared to find the mismatching responses when the calls are replayed.
+func ResultHash(result json.RawMessage) string {
+	var buf bytes.Buffer
+	if err := json.Compact(&buf, result); err != nil {
+		buf.Reset()
+		buf.Write(result)
+	}
+	sum := sha256.Sum256(buf.Bytes())
+	return hex.EncodeToString(sum[:])
+}
+
+// ReadRecordFile reads the calls written in a record file.
+func ReadRecordFile(path string) ([]*RecordedCall, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	var calls []*RecordedCall
+	dec := json.NewDecoder(bufio.NewReader(f))
+	for dec.More() {
+		call := new(RecordedCall)
+		if err := dec.Decode(call); err != nil {
+			return nil, fmt.Errorf("invalid record in %s: %v", path, err)
+		}
+		calls = append(calls, call)
+	}
+	return calls, nil
+}
+
+var (
+	recorderMu sync.RWMutex
+	recorder   *Recorder
+)
+
+// SetRecorder sets the recorder used by all RPC servers. A nil recorder disables recording.
+func SetRecorder(r *Recorder) {
+	recorderMu.Lock()
+	defer recorderMu.Unlock()
+	recorder = r
+}
+
+func getRecorder() *Recorder {
+	recorderMu.RLock()
+	defer recorderMu.RUnlock()
+	return recorder
+}
+
+// RecorderConfig is the configuration of the Recorder.
+type RecorderConfig struct {
+	Dir         string  // Directory to write the record files to
+	SampleRate  float64 // Fraction of the calls recorded, between 0 and 1
+	MaxFileSize int64   // Size in bytes of a record file before it is rotated
+	MaxFiles    int     // Number of the record files kept, 0 keeps all the files
+}
+
+// Recorder writes the sampled calls to the rotating record files in the background.
+type Recorder struct {
+	config RecorderConfig
+	queue  chan *RecordedCall
+	quit   chan struct{}
+	wg     sync.WaitGroup
+
+	file *os.File
+	w    *bufio.Writer
+	size int64
+}
+
+// NewRecorder creates the record directory and starts writing the recorded calls.
+// The record files are only accessible by the owner, since the parameters of
+// the calls are written as they are.
+func NewRecorder(config RecorderConfig) (*Recorder, error) {
+	if err := os.MkdirAll(config.Dir, 0o700); err != nil {
+		return nil, err
+	}
+	r := &Recorder{
+		config: config,
+		queue:  make(chan *RecordedCall, recordQueueSize),
+		quit:   make(chan struct{}),
+	}
+	if err := r.rotate(); err != nil {
+		return nil, err
+	}
+	r.wg.Add(1)
+	go r.loop()
+	return r, nil
+}
+
+// record samples the call and queues it to be written.
+func (r *Recorder) record(ctx context.Context, msg, resp *jsonrpcMessage, start time.Time, duration time.Duration) {
+	if !isRecordable(msg.Method) || rand.Float64() >= r.config.SampleRate {
+		return
+	}
+	call := &RecordedCall{
+		Time:         start,
+		Method:       msg.Method,
+		Params:       msg.Params,
+		Duration:     duration,
+		ResponseSize: len(resp.Result),
+	}
+	if remote, ok := ctx.Value("remote").(string); ok {
+		if host, _, err := net.SplitHostPort(remote); err == nil {
+			remote = host
+		}
+		call.ClientID = remote
+	}
+	if resp.Error != nil {
+		call.Error = resp.Error.Message
+		call.ResponseSize = len(resp.Error.Message)
+	} else {
+		call.ResultHash = ResultHash(resp.Result)
+	}
+	select {
+	case <-r.quit:
+	case r.queue <- call:
+	default:
+		rpcRecordDroppedCounter.Inc(1)
+	}
+}
+
+func (r *Recorder) loop() {
+	defer r.wg.Done()
+	for {
+		select {
+		case call := <-r.queue:
+			r.writeCall(call)
+			// Flush when the queue is drained, so that the file is written in batches.
+			if len(r.queue) == 0 {
+				if err := r.w.Flush(); err != nil {
+					logger.Error("Failed to flush the RPC record file", "err", err)
+				}
+			}
+		case <-r.quit:
+			for len(r.queue) > 0 {
+				r.writeCall(<-r.queue)
+			}
+			return
+		}
+	}
+}
+
+func (r *Recorder) writeCall(call *RecordedCall) {
+	if err := r.write(call); err != nil {
+		logger.Error("Failed to write the recorded RPC call", "err", err)
+		return
+	}
+	rpcRecordedCounter.Inc(1)
+}
+
+func (r *Recorder) write(call *RecordedCall) error {
+	line, err := json.Marshal(call)
+	if err != nil {
+		return err
+	}
+	line = append(line, '\n')
+	if r.size > 0 && r.size+int64(len(line)) > r.config.MaxFileSize {
+		if err := r.rotate(); err != nil {
+			return err
+		}
+	}
+	n, err := r.w.Write(line)
+	r.size += int64(n)
+	return err
+}
+
+// rotate closes the current record file, opens a new one and removes the oldest files
+// exceeding the limit.
+func (r *Recorder) rotate() error {
+	if err := r.closeFile(); err != nil {
+		return err
+	}
+	name := recordFilePrefix + time.Now().UTC().Format("20060102-150405.000000") + recordFileSuffix
+	file, err := os.OpenFile(filepath.Join(r.config.Dir, name), os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0o600)
+	if err != nil {
+		return err
+	}
+	r.file, r.w, r.size = file, bufio.NewWriter(file), 0
+
+	if r.config.MaxFiles <= 0 {
+		return nil
+	}
+	files, err := RecordFiles(r.config.Dir)
+	if err != nil {
+		return err
+	}
+	for len(files) > r.config.MaxFiles {
+		if err := os.Remove(files[0]); err != nil {
+			return err
+		}
+		files = files[1:]
+	}
+	return nil
+}
+
+func (r *Recorder) closeFile() error {
+	if r.file == nil {
+		return nil
+	}
+	if err := r.w.Flush(); err != nil {
+		r.file.Close()
+		return err
+	}
+	return r.file.Close()
+}
+
+// Close writes the queued calls and closes the record file.
+func (r *Recorder) Close() error {
+	close(r.quit)
+	r.wg.Wait()
+	return r.closeFile()
+}
+
+// RecordFiles returns the record files in the directory from the oldest.
+func RecordFiles(dir string) ([]string, error) {
+	files, err := filepath.Glob(filepath.Join(dir, recordFilePrefix+"*"+recordFileSuffix))
+	if err != nil {
+		return nil, err
+	}
+	sort.Strings(files)
+	return files, nil
+}
@@ -0,0 +1,206 @@
+// Copyright 2024 The klaytn Authors
+// This file is part of the klaytn library.
+//
+// The klaytn library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The klaytn library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the klaytn library. If not, see <http://www.gnu.org/licenses/>.
+
+package rpc
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type replayTestService struct {
+	counter int
+}
+
+func (s *replayTestService) Counter() int {
+	s.counter++
+	return s.counter
+}
+
+func recordCalls(t *testing.T, config RecorderConfig, call func(client *Client)) {
+	server := newTestServer("test", new(Service))
+	defer server.Stop()
+	require.NoError(t, server.RegisterName("test", new(replayTestService)))
+	client := DialInProc(server)
+	defer client.Close()
+
+	recorder, err := NewRecorder(config)
+	require.NoError(t, err)
+	SetRecorder(recorder)
+	call(client)
+	SetRecorder(nil)
+	require.NoError(t, recorder.Close())
+}
+
+func TestRecorder(t *testing.T) {
+	dir := t.TempDir()
+	recordCalls(t, RecorderConfig{Dir: dir, SampleRate: 1, MaxFileSize: 1024 * 1024}, func(client *Client) {
+		var result Result
+		require.NoError(t, client.Call(&result, "test_echo", "x", 1, &Args{"y"}))
+		assert.Error(t, client.Call(nil, "test_unknown"))
+	})
+
+	files, err := RecordFiles(dir)
+	require.NoError(t, err)
+	require.Len(t, files, 1)
+	calls, err := ReadRecordFile(files[0])
+	require.NoError(t, err)
+	require.Len(t, calls, 2)
+
+	assert.Equal(t, "test_echo", calls[0].Method)
+	assert.JSONEq(t, `["x", 1, {"S": "y"}]`, string(calls[0].Params))
+	assert.Equal(t, ResultHash([]byte(`{"String":"x","Int":1,"Args":{"S":"y"}}`)), calls[0].ResultHash)
+	assert.Equal(t, len(`{"String":"x","Int":1,"Args":{"S":"y"}}`), calls[0].ResponseSize)
+	assert.Empty(t, calls[0].Error)
+	assert.False(t, calls[0].Time.IsZero())
+
+	assert.Equal(t, "test_unknown", calls[1].Method)
+	assert.NotEmpty(t, calls[1].Error)
+	assert.Empty(t, calls[1].ResultHash)
+}
+
+func TestRecorder_Permission(t *testing.T) {
+	dir := filepath.Join(t.TempDir(), "record")
+	recordCalls(t, RecorderConfig{Dir: dir, SampleRate: 1, MaxFileSize: 1024 * 1024}, func(client *Client) {
+		require.NoError(t, client.Call(nil, "test_counter"))
+	})
+
+	info, err := os.Stat(dir)
+	require.NoError(t, err)
+	assert.Equal(t, os.FileMode(0o700), info.Mode().Perm())
+	files, err := RecordFiles(dir)
+	require.NoError(t, err)
+	require.Len(t, files, 1)
+	info, err = os.Stat(files[0])
+	require.NoError(t, err)
+	assert.Equal(t, os.FileMode(0o600), info.Mode().Perm())
+}
+
+func TestIsRecordable(t *testing.T) {
+	for method, recordable := range map[string]bool{
+		"klay_getBalance":                true,
+		"eth_call":                       true,
+		"test_unknown":                   true,
+		"personal_unlockAccount":         false,
+		"personal_importRawKey":          false,
+		"personal_sendTransaction":       false,
+		"klay_sign":                      false,
+		"klay_signTransactionAsFeePayer": false,
+		"eth_signTransaction":            false,
+		"subbridge_unlockParentOperator": false,
+		"invalid":                        false,
+	} {
+		assert.Equal(t, recordable, isRecordable(method), method)
+	}
+}
+
+func TestRecorder_Sampling(t *testing.T) {
+	dir := t.TempDir()
+	recordCalls(t, RecorderConfig{Dir: dir, SampleRate: 0, MaxFileSize: 1024 * 1024}, func(client *Client) {
+		for i := 0; i < 10; i++ {
+			require.NoError(t, client.Call(nil, "test_counter"))
+		}
+	})
+	files, err := RecordFiles(dir)
+	require.NoError(t, err)
+	calls, err := ReadRecordFile(files[0])
+	require.NoError(t, err)
+	assert.Empty(t, calls)
+}
+
+func TestRecorder_Rotation(t *testing.T) {
+	dir := t.TempDir()
+	// An old record file which is removed by the rotation.
+	old := filepath.Join(dir, recordFilePrefix+"19700101-000000.000000"+recordFileSuffix)
+	require.NoError(t, os.WriteFile(old, nil, 0o644))
+
+	recordCalls(t, RecorderConfig{Dir: dir, SampleRate: 1, MaxFileSize: 1, MaxFiles: 2}, func(client *Client) {
+		for i := 0; i < 4; i++ {
+			require.NoError(t, client.Call(nil, "test_counter"))
+			// Make the names of the rotated files different.
+			time.Sleep(time.Millisecond)
+		}
+	})
+
+	files, err := RecordFiles(dir)
+	require.NoError(t, err)
+	assert.Len(t, files, 2)
+	assert.NotContains(t, files, old)
+	for _, file := range files {
+		calls, err := ReadRecordFile(file)
+		require.NoError(t, err)
+		assert.Len(t, calls, 1)
+	}
+}
+
+func TestReplay(t *testing.T) {
+	dir := t.TempDir()
+	recordCalls(t, RecorderConfig{Dir: dir, SampleRate: 1, MaxFileSize: 1024 * 1024}, func(client *Client) {
+		for i := 0; i < 3; i++ {
+			require.NoError(t, client.Call(nil, "test_echo", "x", i, &Args{"y"}))
+		}
+		require.NoError(t, client.Call(nil, "test_counter"))
+		assert.Error(t, client.Call(nil, "test_unknown"))
+	})
+	files, err := RecordFiles(dir)
+	require.NoError(t, err)
+	calls, err := ReadRecordFile(files[0])
+	require.NoError(t, err)
+	require.Len(t, calls, 5)
+
+	// The counter of the target is different from the recorded one.
+	service := &replayTestService{counter: 10}
+	server := newTestServer("test", new(Service))
+	defer server.Stop()
+	require.NoError(t, server.RegisterName("test", service))
+	client := DialInProc(server)
+	defer client.Close()
+
+	report, err := Replay(context.Background(), client, calls, ReplayConfig{Speed: 0, Concurrency: 2})
+	require.NoError(t, err)
+	require.Len(t, report.Methods, 3)
+
+	counter, echo, unknown := report.Methods[0], report.Methods[1], report.Methods[2]
+	assert.Equal(t, "test_counter", counter.Method)
+	assert.Equal(t, 1, counter.Calls)
+	assert.Equal(t, 1, counter.Mismatches)
+
+	assert.Equal(t, "test_echo", echo.Method)
+	assert.Equal(t, 3, echo.Calls)
+	assert.Equal(t, 0, echo.Mismatches)
+	assert.Equal(t, 0, echo.Errors)
+	assert.True(t, echo.P50 <= echo.P90 && echo.P90 <= echo.P99 && echo.P99 <= echo.Max)
+
+	assert.Equal(t, "test_unknown", unknown.Method)
+	assert.Equal(t, 0, unknown.Mismatches)
+}
+
+func TestPercentile(t *testing.T) {
+	latencies := make([]time.Duration, 100)
+	for i := range latencies {
+		latencies[i] = time.Duration(i+1) * time.Millisecond
+	}
+	assert.Equal(t, 50*time.Millisecond, percentile(latencies, 0.5))
+	assert.Equal(t, 99*time.Millisecond, percentile(latencies, 0.99))
+	assert.Equal(t, 100*time.Millisecond, percentile(latencies, 1))
+	assert.Equal(t, time.Duration(0), percentile(nil, 0.5))
+}
@@ -0,0 +1,175 @@
+// Copyright 2024 The klaytn Authors
+// This file is part of the klaytn library.
+//
+// The klaytn library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The klaytn library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the klaytn library. If not, see <http://www.gnu.org/licenses/>.
+
+package rpc
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"math"
+	"sort"
+	"sync"
+	"time"
+)
+
+// ReplayConfig is the configuration of Replay.
+type ReplayConfig struct {
+	// Speed scales the timing of the recorded calls. 1 replays the calls at their
+	// original timing, 2 twice as fast, and 0 as fast as possible.
+	Speed float64
+
+	// Concurrency is the maximum number of the calls in flight.
+	Concurrency int
+}
+
+// MethodReport is the result of the calls of a method replayed.
+type MethodReport struct {
+	Method     string
+	Calls      int           // Number of the calls replayed
+	Errors     int           // Number of the calls failed without a response
+	Mismatches int           // Number of the responses different from the recorded ones
+	P50        time.Duration // Latency percentiles of the calls
+	P90        time.Duration
+	P99        time.Duration
+	Max        time.Duration
+
+	latencies []time.Duration
+}
+
+// ReplayReport is the result of Replay.
+type ReplayReport struct {
+	Duration time.Duration
+	Methods  []*MethodReport // Sorted by the method names
+}
+
+// Replay sends the recorded calls to the client at their recorded timing scaled by the
+// speed, and reports the latencies and the mismatching responses of each method.
+func Replay(ctx context.Context, client *Client, calls []*RecordedCall, config ReplayConfig) (*ReplayReport, error) {
+	if len(calls) == 0 {
+		return &ReplayReport{}, nil
+	}
+	if config.Concurrency <= 0 {
+		config.Concurrency = 1
+	}
+	calls = append([]*RecordedCall(nil), calls...)
+	sort.SliceStable(calls, func(i, j int) bool {
+		return calls[i].Time.Before(calls[j].Time)
+	})
+
+	var (
+		mu      sync.Mutex
+		reports = make(map[string]*MethodReport)
+		wg      sync.WaitGroup
+		sem     = make(chan struct{}, config.Concurrency)
+		start   = time.Now()
+		first   = calls[0].Time
+	)
+	for _, call := range calls {
+		if config.Speed > 0 {
+			at := start.Add(time.Duration(float64(call.Time.Sub(first)) / config.Speed))
+			select {
+			case <-time.After(time.Until(at)):
+			case <-ctx.Done():
+				return nil, ctx.Err()
+			}
+		}
+		select {
+		case sem <- struct{}{}:
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+		wg.Add(1)
+		go func(call *RecordedCall) {
+			defer func() { <-sem; wg.Done() }()
+			latency, failed, mismatched := replayCall(ctx, client, call)
+
+			mu.Lock()
+			defer mu.Unlock()
+			report, ok := reports[call.Method]
+			if !ok {
+				report = &MethodReport{Method: call.Method}
+				reports[call.Method] = report
+			}
+			report.Calls++
+			if failed {
+				report.Errors++
+				return
+			}
+			if mismatched {
+				report.Mismatches++
+			}
+			report.latencies = append(report.latencies, latency)
+		}(call)
+	}
+	wg.Wait()
+
+	result := &ReplayReport{Duration: time.Since(start)}
+	for _, report := range reports {
+		sort.Slice(report.latencies, func(i, j int) bool { return report.latencies[i] < report.latencies[j] })
+		report.P50 = percentile(report.latencies, 0.5)
+		report.P90 = percentile(report.latencies, 0.9)
+		report.P99 = percentile(report.latencies, 0.99)
+		report.Max = percentile(report.latencies, 1)
+		result.Methods = append(result.Methods, report)
+	}
+	sort.Slice(result.Methods, func(i, j int) bool {
+		return result.Methods[i].Method < result.Methods[j].Method
+	})
+	return result, nil
+}
+
+// replayCall sends the recorded call and compares the response with the recorded one.
+// failed is true if the call failed without a response.
+func replayCall(ctx context.Context, client *Client, call *RecordedCall) (latency time.Duration, failed, mismatched bool) {
+	var params []json.RawMessage
+	if len(call.Params) > 0 {
+		if err := json.Unmarshal(call.Params, &params); err != nil {
+			return 0, true, false
+		}
+	}
+	args := make([]interface{}, len(params))
+	for i, param := range params {
+		args[i] = param
+	}
+
+	var result json.RawMessage
+	start := time.Now()
+	err := client.CallContext(ctx, &result, call.Method, args...)
+	latency = time.Since(start)
+
+	var jsonErr *jsonError
+	switch {
+	case err == nil:
+		return latency, false, call.Error != "" || ResultHash(result) != call.ResultHash
+	case errors.As(err, &jsonErr):
+		return latency, false, jsonErr.Message != call.Error
+	default:
+		return latency, true, false
+	}
+}
+
+// percentile returns the percentile of the sorted latencies by the nearest-rank method.
+func percentile(latencies []time.Duration, p float64) time.Duration {
+	if len(latencies) == 0 {
+		return 0
+	}
+	rank := int(math.Ceil(p*float64(len(latencies)))) - 1
+	if rank < 0 {
+		rank = 0
+	}
+	return latencies[rank]
+}
@@ -109,6 +109,11 @@ func (s *RPCService) Modules() map[string]string {
 	return modules
 }
 
+// Discover returns the OpenRPC document of the methods served by the server.
+func (s *RPCService) Discover() *OpenRPCDocument {
+	return s.server.services.openRPC()
+}
+
 func (s *Server) GetServices() map[string]service {
 	return s.services.services
 }
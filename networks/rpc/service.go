@@ -42,6 +42,7 @@ var (
 type serviceRegistry struct {
 	mu       sync.Mutex
 	services map[string]service
+	limits   *limiter // limits of the calls to the services, nil if unlimited
 }
 
 // service represents a registered object.
@@ -28,6 +28,7 @@ import (
 	"fmt"
 	"io"
 	"os"
+	"path/filepath"
 	"strings"
 	"time"
 
@@ -39,6 +40,7 @@ import (
 	"github.com/klaytn/klaytn/networks/rpc"
 	"github.com/klaytn/klaytn/params"
 	"github.com/klaytn/klaytn/rlp"
+	"github.com/klaytn/klaytn/storage/database"
 	"github.com/klaytn/klaytn/storage/statedb"
 	"github.com/klaytn/klaytn/work"
 )
@@ -209,6 +211,51 @@ func (api *PrivateAdminAPI) StateMigrationStatus() map[string]interface{} {
 	}
 }
 
+// StartOnlineDBMigration starts an online migration of the chain database to a new
+// database of dbType at dir while the node keeps running. A relative dir is
+// resolved against the parent directory of the chain database.
+func (api *PrivateAdminAPI) StartOnlineDBMigration(dbType string, dir string) error {
+	dstDBType := database.DBType(dbType).ToValid()
+	if dstDBType == "" {
+		return fmt.Errorf("invalid database type: %v", dbType)
+	}
+
+	chainDB := api.cn.ChainDB()
+	dbc := *chainDB.GetDBConfig()
+	if !filepath.IsAbs(dir) {
+		if dbc.Dir == "" {
+			return errors.New("dir should be an absolute path")
+		}
+		dir = filepath.Join(filepath.Dir(dbc.Dir), dir)
+	}
+	if dstDBType != database.DynamoDB {
+		if _, err := os.Stat(dir); err == nil {
+			return errors.New("location would overwrite an existing database")
+		}
+	}
+
+	dbc.DBType = dstDBType
+	dbc.Dir = dir
+	dbc.ReadOnly = false
+	dstDB := database.NewDBManager(&dbc)
+	if err := chainDB.StartOnlineDBMigration(dstDB); err != nil {
+		dstDB.Close()
+		return err
+	}
+	return nil
+}
+
+// StopOnlineDBMigration stops the online migration of the chain database and
+// returns to the original database.
+func (api *PrivateAdminAPI) StopOnlineDBMigration() error {
+	return api.cn.ChainDB().StopOnlineDBMigration()
+}
+
+// OnlineDBMigrationStatus returns the progress of the online migration of the chain database.
+func (api *PrivateAdminAPI) OnlineDBMigrationStatus() *database.OnlineDBMigrationStatus {
+	return api.cn.ChainDB().OnlineDBMigrationStatus()
+}
+
 func (api *PrivateAdminAPI) SaveTrieNodeCacheToDisk() error {
 	return api.cn.BlockChain().SaveTrieNodeCacheToDisk()
 }
@@ -296,6 +296,11 @@ func (b *CNAPIBackend) SuggestPrice(ctx context.Context) (*big.Int, error) {
 	return b.gpo.SuggestPrice(ctx)
 }
 
+// SuggestFees returns the fees of slow, normal and fast tiers based on the projected base fees.
+func (b *CNAPIBackend) SuggestFees(ctx context.Context) (*klaytn.FeeSuggestion, error) {
+	return b.gpo.SuggestFees(ctx)
+}
+
 func (b *CNAPIBackend) UpperBoundGasPrice(ctx context.Context) *big.Int {
 	bignum := b.CurrentBlock().Number()
 	pset, err := b.cn.governance.EffectiveParams(bignum.Uint64() + 1)
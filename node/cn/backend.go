@@ -395,6 +395,7 @@ func New(ctx *node.ServiceContext, config *Config) (*CN, error) {
 	cn.addComponent(cn.APIs())
 	cn.addComponent(cn.ChainDB())
 	cn.addComponent(cn.engine)
+	cn.addComponent(cn.APIBackend)
 
 	if config.AutoRestartFlag {
 		daemonPath := config.DaemonPathFlag
@@ -490,6 +491,7 @@ func CreateDB(ctx *node.ServiceContext, config *Config, name string) database.DB
 		Dir: name, DBType: config.DBType, ParallelDBWrite: config.ParallelDBWrite, SingleDB: config.SingleDB, NumStateTrieShards: config.NumStateTrieShards,
 		LevelDBCacheSize: config.LevelDBCacheSize, OpenFilesLimit: database.GetOpenFilesLimit(), LevelDBCompression: config.LevelDBCompression,
 		LevelDBBufferPool: config.LevelDBBufferPool, EnableDBPerfMetrics: config.EnableDBPerfMetrics, RocksDBConfig: &config.RocksDBConfig, DynamoDBConfig: &config.DynamoDBConfig,
+		PebbleDBConfig: &config.PebbleDBConfig, ShardMirror: config.ShardMirror, ShardScrubInterval: config.ShardScrubInterval,
 	}
 	return ctx.OpenDatabase(dbc)
 }
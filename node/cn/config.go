@@ -113,12 +113,15 @@ type Config struct {
 	SkipBcVersionCheck   bool `toml:"-"`
 	SingleDB             bool
 	NumStateTrieShards   uint
+	ShardMirror          bool
+	ShardScrubInterval   time.Duration
 	EnableDBPerfMetrics  bool
 	LevelDBCompression   database.LevelDBCompressionType
 	LevelDBBufferPool    bool
 	LevelDBCacheSize     int
 	DynamoDBConfig       database.DynamoDBConfig
 	RocksDBConfig        database.RocksDBConfig
+	PebbleDBConfig       database.PebbleDBConfig
 	TrieCacheSize        int
 	TrieTimeout          time.Duration
 	TrieBlockInterval    uint
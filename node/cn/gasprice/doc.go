@@ -21,6 +21,8 @@ until ChainConfig.UnitPrice is updated with governance.
 
 Source Files
 
+  - feehistory.go : implements FeeHistory function which returns the fee data of the recent blocks
+  - fees.go : implements SuggestFees function which suggests the fees of slow, normal and fast tiers based on the projected KIP-71 base fees
   - gasprice.go : implements Oracle struct which has a function to suggest appropriate gas price
 */
 package gasprice
@@ -0,0 +1,221 @@
+// Copyright 2024 The klaytn Authors
+// This file is part of the klaytn library.
+//
+// The klaytn library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The klaytn library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the klaytn library. If not, see <http://www.gnu.org/licenses/>.
+
+package gasprice
+
+import (
+	"context"
+	"errors"
+	"math/big"
+
+	"github.com/klaytn/klaytn"
+	"github.com/klaytn/klaytn/blockchain/types"
+	"github.com/klaytn/klaytn/consensus/misc"
+	"github.com/klaytn/klaytn/networks/rpc"
+	"github.com/klaytn/klaytn/params"
+)
+
+// FeeProjectionBlocks is the number of the next blocks whose base fees are projected.
+const FeeProjectionBlocks = 10
+
+var errNoHeadBlock = errors.New("head block is not found")
+
+// SuggestFees suggests the fees of slow, normal and fast tiers based on the base fees of
+// the next blocks projected with the KIP-71 parameters.
+//
+// Since the tip is ignored under KIP-71 and transactions are included in arrival order,
+// paying more does not make a transaction be included earlier. A new transaction is
+// expected to be included after the pending transactions are processed, assuming that a
+// block processes up to MaxBlockGasUsedForBaseFee gas and the transactions arriving after
+// the pending ones are as many as the average gas used of the recent blocks. The tiers are
+//   - fast: the base fee at the expected block even if every block until then is full,
+//     which guarantees the inclusion at the expected block.
+//   - normal: the projected base fee at the expected block.
+//   - slow: the lowest projected base fee until the last projected block, which may
+//     delay the inclusion until the base fee goes down.
+//
+// Every tier is at least the base fee of the next block, since the transaction pool
+// rejects transactions whose fee is lower than that.
+//
+// Before the Magma hard fork, every tier is the unit price.
+func (oracle *Oracle) SuggestFees(ctx context.Context) (*klaytn.FeeSuggestion, error) {
+	head, err := oracle.backend.HeaderByNumber(ctx, rpc.LatestBlockNumber)
+	if err != nil {
+		return nil, err
+	}
+	if head == nil {
+		return nil, errNoHeadBlock
+	}
+	config := oracle.backend.ChainConfig()
+	kip71 := params.GetDefaultKIP71Config()
+	if config.Governance != nil && config.Governance.KIP71 != nil {
+		kip71 = config.Governance.KIP71
+	}
+	capacity := kip71.MaxBlockGasUsedForBaseFee
+	if capacity == 0 {
+		capacity = params.DefaultMaxBlockGasUsedForBaseFee
+	}
+
+	pendingGas, err := oracle.pendingGas()
+	if err != nil {
+		return nil, err
+	}
+	recentGas, err := oracle.recentGasUsed(ctx, head)
+	if err != nil {
+		return nil, err
+	}
+
+	// The transactions in the blocks before the expected block are the pending ones.
+	inclusion := int(pendingGas/capacity) + 1
+	if inclusion > FeeProjectionBlocks {
+		inclusion = FeeProjectionBlocks
+	}
+
+	backlog := pendingGas
+	projected := oracle.projectBaseFees(head, kip71, func() uint64 {
+		// The backlog of the pending transactions and the new arrivals is drained by the capacity.
+		backlog += recentGas
+		used := backlog
+		if used > capacity {
+			used = capacity
+		}
+		backlog -= used
+		return used
+	})
+	empty := oracle.projectBaseFees(head, kip71, func() uint64 { return 0 })
+	full := oracle.projectBaseFees(head, kip71, func() uint64 { return capacity })
+
+	suggestion := &klaytn.FeeSuggestion{
+		HeadBlock:   head.Number.Uint64(),
+		NextBaseFee: projected[0],
+		BaseFees:    projected,
+		MinBaseFee:  minBig(empty),
+		MaxBaseFee:  maxBig(full),
+		PendingGas:  pendingGas,
+	}
+	atLeastNext := func(baseFee *big.Int) *big.Int {
+		if baseFee.Cmp(suggestion.NextBaseFee) < 0 {
+			return suggestion.NextBaseFee
+		}
+		return baseFee
+	}
+	suggestion.Fast = newFeeTier(atLeastNext(full[inclusion-1]), head, inclusion)
+	suggestion.Normal = newFeeTier(atLeastNext(projected[inclusion-1]), head, inclusion)
+
+	slowest := inclusion - 1
+	for i := inclusion; i < len(projected); i++ {
+		if atLeastNext(projected[i]).Cmp(atLeastNext(projected[slowest])) < 0 {
+			slowest = i
+		}
+	}
+	suggestion.Slow = newFeeTier(atLeastNext(projected[slowest]), head, slowest+1)
+	return suggestion, nil
+}
+
+// projectBaseFees returns the base fees of the next FeeProjectionBlocks blocks where
+// gasUsed returns the gas used by each of the next blocks in order.
+func (oracle *Oracle) projectBaseFees(head *types.Header, kip71 *params.KIP71Config, gasUsed func() uint64) []*big.Int {
+	config := oracle.backend.ChainConfig()
+	baseFees := make([]*big.Int, FeeProjectionBlocks)
+	parent := &types.Header{Number: new(big.Int).Set(head.Number), BaseFee: head.BaseFee, GasUsed: head.GasUsed}
+	for i := range baseFees {
+		number := new(big.Int).Add(parent.Number, big.NewInt(1))
+		if config.IsMagmaForkEnabled(number) {
+			baseFees[i] = misc.NextMagmaBlockBaseFee(parent, kip71)
+		} else {
+			baseFees[i] = new(big.Int).SetUint64(config.UnitPrice)
+		}
+		parent = &types.Header{Number: number, BaseFee: baseFees[i], GasUsed: gasUsed()}
+	}
+	return baseFees
+}
+
+// pendingGas returns the total gas limit of the pending transactions in the transaction pool.
+func (oracle *Oracle) pendingGas() (uint64, error) {
+	if oracle.txPool == nil {
+		return 0, nil
+	}
+	pending, err := oracle.txPool.Pending()
+	if err != nil {
+		return 0, err
+	}
+	var gas uint64
+	for _, txs := range pending {
+		for _, tx := range txs {
+			gas += tx.Gas()
+		}
+	}
+	return gas, nil
+}
+
+// recentGasUsed returns the average gas used of the recent blocks up to the head.
+func (oracle *Oracle) recentGasUsed(ctx context.Context, head *types.Header) (uint64, error) {
+	var (
+		total uint64
+		count uint64
+	)
+	for number := head.Number.Uint64(); count < uint64(oracle.checkBlocks); number-- {
+		header := head
+		if number != head.Number.Uint64() {
+			var err error
+			if header, err = oracle.backend.HeaderByNumber(ctx, rpc.BlockNumber(number)); err != nil {
+				return 0, err
+			}
+		}
+		if header == nil {
+			break
+		}
+		total += header.GasUsed
+		count++
+		if number == 0 {
+			break
+		}
+	}
+	if count == 0 {
+		return 0, nil
+	}
+	return total / count, nil
+}
+
+func newFeeTier(baseFee *big.Int, head *types.Header, blocks int) klaytn.FeeTier {
+	// The tip is ignored under KIP-71, so it is set to the max fee per gas which is also
+	// the valid gas price of the legacy transactions.
+	return klaytn.FeeTier{
+		MaxFeePerGas:         new(big.Int).Set(baseFee),
+		MaxPriorityFeePerGas: new(big.Int).Set(baseFee),
+		ExpectedBlock:        head.Number.Uint64() + uint64(blocks),
+	}
+}
+
+func minBig(values []*big.Int) *big.Int {
+	min := values[0]
+	for _, v := range values[1:] {
+		if v.Cmp(min) < 0 {
+			min = v
+		}
+	}
+	return new(big.Int).Set(min)
+}
+
+func maxBig(values []*big.Int) *big.Int {
+	max := values[0]
+	for _, v := range values[1:] {
+		if v.Cmp(max) > 0 {
+			max = v
+		}
+	}
+	return new(big.Int).Set(max)
+}
@@ -0,0 +1,223 @@
+// Copyright 2024 The klaytn Authors
+// This file is part of the klaytn library.
+//
+// The klaytn library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The klaytn library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the klaytn library. If not, see <http://www.gnu.org/licenses/>.
+
+package gasprice
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/klaytn/klaytn/blockchain/types"
+	"github.com/klaytn/klaytn/common"
+	"github.com/klaytn/klaytn/consensus/misc"
+	"github.com/klaytn/klaytn/networks/rpc"
+	"github.com/klaytn/klaytn/params"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const feesTestHead = 100
+
+type feesTestBackend struct {
+	config  *params.ChainConfig
+	gasUsed uint64
+	baseFee *big.Int // base fee of the head, the lower bound if nil
+}
+
+func (b *feesTestBackend) HeaderByNumber(ctx context.Context, number rpc.BlockNumber) (*types.Header, error) {
+	if number == rpc.LatestBlockNumber {
+		number = feesTestHead
+	}
+	if number > feesTestHead {
+		return nil, nil
+	}
+	header := &types.Header{Number: big.NewInt(int64(number)), GasUsed: b.gasUsed}
+	if b.config.IsMagmaForkEnabled(header.Number) {
+		header.BaseFee = new(big.Int).SetUint64(b.config.Governance.KIP71.LowerBoundBaseFee)
+		if b.baseFee != nil && number == feesTestHead {
+			header.BaseFee = new(big.Int).Set(b.baseFee)
+		}
+	}
+	return header, nil
+}
+
+func (b *feesTestBackend) BlockByNumber(ctx context.Context, number rpc.BlockNumber) (*types.Block, error) {
+	header, err := b.HeaderByNumber(ctx, number)
+	if header == nil || err != nil {
+		return nil, err
+	}
+	return types.NewBlockWithHeader(header), nil
+}
+
+func (b *feesTestBackend) GetBlockReceipts(ctx context.Context, hash common.Hash) types.Receipts {
+	return nil
+}
+
+func (b *feesTestBackend) ChainConfig() *params.ChainConfig {
+	return b.config
+}
+
+func (b *feesTestBackend) CurrentBlock() *types.Block {
+	block, _ := b.BlockByNumber(context.Background(), rpc.LatestBlockNumber)
+	return block
+}
+
+type feesTestTxPool struct {
+	pending map[common.Address]types.Transactions
+}
+
+func (pool *feesTestTxPool) GasPrice() *big.Int {
+	return big.NewInt(25 * params.Ston)
+}
+
+func (pool *feesTestTxPool) Pending() (map[common.Address]types.Transactions, error) {
+	return pool.pending, nil
+}
+
+func newFeesTestConfig(magma bool) *params.ChainConfig {
+	config := params.TestChainConfig.Copy()
+	config.UnitPrice = 25 * params.Ston
+	config.Governance = &params.GovernanceConfig{
+		KIP71: &params.KIP71Config{
+			LowerBoundBaseFee:         25 * params.Ston,
+			UpperBoundBaseFee:         750 * params.Ston,
+			GasTarget:                 30000000,
+			MaxBlockGasUsedForBaseFee: 60000000,
+			BaseFeeDenominator:        20,
+		},
+	}
+	if magma {
+		config.MagmaCompatibleBlock = big.NewInt(0)
+	}
+	return config
+}
+
+func newFeesTestTxPool(gases ...uint64) *feesTestTxPool {
+	pending := make(map[common.Address]types.Transactions)
+	for i, gas := range gases {
+		addr := common.BigToAddress(big.NewInt(int64(i + 1)))
+		pending[addr] = types.Transactions{types.NewTransaction(0, addr, common.Big0, gas, big.NewInt(25*params.Ston), nil)}
+	}
+	return &feesTestTxPool{pending: pending}
+}
+
+func TestSuggestFees(t *testing.T) {
+	config := newFeesTestConfig(true)
+	kip71 := config.Governance.KIP71
+	backend := &feesTestBackend{config: config}
+	// The pending transactions fill two blocks and a half.
+	oracle := NewOracle(backend, Config{Blocks: 20}, newFeesTestTxPool(60000000, 60000000, 30000000))
+
+	fees, err := oracle.SuggestFees(context.Background())
+	require.NoError(t, err)
+
+	lowerBound := new(big.Int).SetUint64(kip71.LowerBoundBaseFee)
+	assert.Equal(t, uint64(feesTestHead), fees.HeadBlock)
+	assert.Equal(t, uint64(150000000), fees.PendingGas)
+	assert.Equal(t, lowerBound, fees.NextBaseFee)
+	assert.Equal(t, lowerBound, fees.MinBaseFee)
+	assert.Len(t, fees.BaseFees, FeeProjectionBlocks)
+
+	// The base fee increases while the pending transactions are processed in full blocks.
+	next := func(number int64, baseFee *big.Int, gasUsed uint64) *big.Int {
+		return misc.NextMagmaBlockBaseFee(&types.Header{Number: big.NewInt(number), BaseFee: baseFee, GasUsed: gasUsed}, kip71)
+	}
+	baseFee102 := next(feesTestHead+1, lowerBound, 60000000)
+	baseFee103 := next(feesTestHead+2, baseFee102, 60000000)
+	assert.Equal(t, baseFee102, fees.BaseFees[1])
+	assert.Equal(t, baseFee103, fees.BaseFees[2])
+	assert.True(t, baseFee103.Cmp(lowerBound) > 0)
+
+	// The new transaction is expected to be included after the pending ones.
+	assert.Equal(t, uint64(feesTestHead+3), fees.Normal.ExpectedBlock)
+	assert.Equal(t, baseFee103, fees.Normal.MaxFeePerGas)
+	assert.Equal(t, uint64(feesTestHead+3), fees.Fast.ExpectedBlock)
+	assert.True(t, fees.Fast.MaxFeePerGas.Cmp(fees.Normal.MaxFeePerGas) >= 0)
+
+	// The slow tier waits until the base fee goes down after the pending ones are processed.
+	assert.True(t, fees.Slow.ExpectedBlock > fees.Normal.ExpectedBlock)
+	assert.True(t, fees.Slow.MaxFeePerGas.Cmp(fees.Normal.MaxFeePerGas) < 0)
+	assert.True(t, fees.Slow.MaxFeePerGas.Cmp(fees.NextBaseFee) >= 0)
+	assert.Equal(t, fees.BaseFees[fees.Slow.ExpectedBlock-feesTestHead-1], fees.Slow.MaxFeePerGas)
+
+	// The base fee only increases if every block is full.
+	full := lowerBound
+	for i := 1; i < FeeProjectionBlocks; i++ {
+		full = next(int64(feesTestHead+i), full, kip71.MaxBlockGasUsedForBaseFee)
+	}
+	assert.Equal(t, full, fees.MaxBaseFee)
+}
+
+func TestSuggestFees_EmptyPool(t *testing.T) {
+	backend := &feesTestBackend{config: newFeesTestConfig(true), gasUsed: 30000000}
+	oracle := NewOracle(backend, Config{Blocks: 20}, newFeesTestTxPool())
+
+	fees, err := oracle.SuggestFees(context.Background())
+	require.NoError(t, err)
+
+	// The recent blocks used the gas target, so the base fee is expected to stay.
+	for _, baseFee := range fees.BaseFees {
+		assert.Equal(t, big.NewInt(25*params.Ston), baseFee)
+	}
+	for _, tier := range []struct {
+		maxFee        *big.Int
+		expectedBlock uint64
+	}{
+		{fees.Slow.MaxFeePerGas, fees.Slow.ExpectedBlock},
+		{fees.Normal.MaxFeePerGas, fees.Normal.ExpectedBlock},
+		{fees.Fast.MaxFeePerGas, fees.Fast.ExpectedBlock},
+	} {
+		assert.Equal(t, big.NewInt(25*params.Ston), tier.maxFee)
+		assert.Equal(t, uint64(feesTestHead+1), tier.expectedBlock)
+	}
+}
+
+// The base fees projected to decrease are not suggested, since the transaction
+// pool rejects transactions under the base fee of the next block.
+func TestSuggestFees_DecreasingBaseFee(t *testing.T) {
+	backend := &feesTestBackend{config: newFeesTestConfig(true), baseFee: big.NewInt(100 * params.Ston)}
+	oracle := NewOracle(backend, Config{Blocks: 20}, newFeesTestTxPool())
+
+	fees, err := oracle.SuggestFees(context.Background())
+	require.NoError(t, err)
+
+	assert.True(t, fees.NextBaseFee.Cmp(big.NewInt(100*params.Ston)) < 0)
+	assert.True(t, fees.BaseFees[FeeProjectionBlocks-1].Cmp(fees.NextBaseFee) < 0)
+	assert.True(t, fees.MinBaseFee.Cmp(fees.NextBaseFee) < 0)
+
+	assert.Equal(t, fees.NextBaseFee, fees.Slow.MaxFeePerGas)
+	assert.Equal(t, fees.Normal.ExpectedBlock, fees.Slow.ExpectedBlock)
+	for _, tier := range []*big.Int{fees.Slow.MaxFeePerGas, fees.Normal.MaxFeePerGas, fees.Fast.MaxFeePerGas} {
+		assert.True(t, tier.Cmp(fees.NextBaseFee) >= 0)
+	}
+}
+
+func TestSuggestFees_BeforeMagma(t *testing.T) {
+	backend := &feesTestBackend{config: newFeesTestConfig(false)}
+	oracle := NewOracle(backend, Config{Blocks: 20}, newFeesTestTxPool(60000000))
+
+	fees, err := oracle.SuggestFees(context.Background())
+	require.NoError(t, err)
+
+	unitPrice := big.NewInt(25 * params.Ston)
+	assert.Equal(t, unitPrice, fees.MinBaseFee)
+	assert.Equal(t, unitPrice, fees.MaxBaseFee)
+	assert.Equal(t, unitPrice, fees.Fast.MaxFeePerGas)
+	assert.Equal(t, unitPrice, fees.Fast.MaxPriorityFeePerGas)
+	assert.Equal(t, uint64(feesTestHead+2), fees.Normal.ExpectedBlock)
+	assert.Equal(t, uint64(feesTestHead+2), fees.Slow.ExpectedBlock)
+}
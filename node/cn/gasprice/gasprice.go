@@ -53,6 +53,7 @@ type OracleBackend interface {
 
 type TxPool interface {
 	GasPrice() *big.Int
+	Pending() (map[common.Address]types.Transactions, error)
 }
 
 // Oracle recommends gas prices based on the content of recent
@@ -178,14 +178,15 @@ func (cn *CN) stateAtTransaction(block *types.Block, txIndex int, reexec uint64)
 	signer := types.MakeSigner(cn.blockchain.Config(), block.Number())
 	for idx, tx := range block.Transactions() {
 		// Assemble the transaction call message and return if the requested offset
-		msg, err := tx.AsMessageWithAccountKeyPicker(signer, statedb, block.NumberU64())
+		blockContext := blockchain.NewEVMBlockContext(block.Header(), cn.blockchain, nil)
+		picker := blockchain.NewContractKeyPicker(statedb, blockContext, cn.blockchain.Config())
+		msg, err := tx.AsMessageWithAccountKeyPicker(signer, picker, block.NumberU64())
 		if err != nil {
 			logger.Warn("stateAtTransition failed", "hash", tx.Hash(), "block", block.NumberU64(), "err", err)
 			return nil, vm.BlockContext{}, vm.TxContext{}, nil, fmt.Errorf("transaction %#x failed: %v", tx.Hash(), err)
 		}
 
 		txContext := blockchain.NewEVMTxContext(msg, block.Header())
-		blockContext := blockchain.NewEVMBlockContext(block.Header(), cn.blockchain, nil)
 		if idx == txIndex {
 			return msg, blockContext, txContext, statedb, nil
 		}
@@ -299,7 +299,9 @@ func (api *API) traceChain(start, end *types.Block, config *TraceConfig, notifie
 
 				// Trace all the transactions contained within
 				for i, tx := range task.block.Transactions() {
-					msg, err := tx.AsMessageWithAccountKeyPicker(signer, task.statedb, task.block.NumberU64())
+					blockCtx := blockchain.NewEVMBlockContext(task.block.Header(), newChainContext(localctx, api.backend), nil)
+					picker := blockchain.NewContractKeyPicker(task.statedb, blockCtx, api.backend.ChainConfig())
+					msg, err := tx.AsMessageWithAccountKeyPicker(signer, picker, task.block.NumberU64())
 					if err != nil {
 						logger.Warn("Tracing failed", "hash", tx.Hash(), "block", task.block.NumberU64(), "err", err)
 						task.results[i] = &txTraceResult{TxHash: tx.Hash(), Error: err.Error()}
@@ -307,7 +309,6 @@ func (api *API) traceChain(start, end *types.Block, config *TraceConfig, notifie
 					}
 
 					txCtx := blockchain.NewEVMTxContext(msg, task.block.Header())
-					blockCtx := blockchain.NewEVMBlockContext(task.block.Header(), newChainContext(localctx, api.backend), nil)
 
 					res, err := api.traceTx(localctx, msg, blockCtx, txCtx, task.statedb, config)
 					if err != nil {
@@ -624,7 +625,9 @@ func (api *API) traceBlock(ctx context.Context, block *types.Block, config *Trac
 
 			// Fetch and execute the next transaction trace tasks
 			for task := range jobs {
-				msg, err := txs[task.index].AsMessageWithAccountKeyPicker(signer, task.statedb, block.NumberU64())
+				blockCtx := blockchain.NewEVMBlockContext(block.Header(), newChainContext(ctx, api.backend), nil)
+				picker := blockchain.NewContractKeyPicker(task.statedb, blockCtx, api.backend.ChainConfig())
+				msg, err := txs[task.index].AsMessageWithAccountKeyPicker(signer, picker, block.NumberU64())
 				if err != nil {
 					logger.Warn("Tracing failed", "tx idx", task.index, "block", block.NumberU64(), "err", err)
 					results[task.index] = &txTraceResult{TxHash: txs[task.index].Hash(), Error: err.Error()}
@@ -632,7 +635,6 @@ func (api *API) traceBlock(ctx context.Context, block *types.Block, config *Trac
 				}
 
 				txCtx := blockchain.NewEVMTxContext(msg, block.Header())
-				blockCtx := blockchain.NewEVMBlockContext(block.Header(), newChainContext(ctx, api.backend), nil)
 				res, err := api.traceTx(ctx, msg, blockCtx, txCtx, task.statedb, config)
 				if err != nil {
 					results[task.index] = &txTraceResult{TxHash: txs[task.index].Hash(), Error: err.Error()}
@@ -649,7 +651,9 @@ func (api *API) traceBlock(ctx context.Context, block *types.Block, config *Trac
 		jobs <- &txTraceTask{statedb: statedb.Copy(), index: i}
 
 		// Generate the next state snapshot fast without tracing
-		msg, err := tx.AsMessageWithAccountKeyPicker(signer, statedb, block.NumberU64())
+		blockCtx := blockchain.NewEVMBlockContext(block.Header(), newChainContext(ctx, api.backend), nil)
+		picker := blockchain.NewContractKeyPicker(statedb, blockCtx, api.backend.ChainConfig())
+		msg, err := tx.AsMessageWithAccountKeyPicker(signer, picker, block.NumberU64())
 		if err != nil {
 			logger.Warn("Tracing failed", "hash", tx.Hash(), "block", block.NumberU64(), "err", err)
 			failed = err
@@ -657,7 +661,6 @@ func (api *API) traceBlock(ctx context.Context, block *types.Block, config *Trac
 		}
 
 		txCtx := blockchain.NewEVMTxContext(msg, block.Header())
-		blockCtx := blockchain.NewEVMBlockContext(block.Header(), newChainContext(ctx, api.backend), nil)
 		vmenv := vm.NewEVM(blockCtx, txCtx, statedb, api.backend.ChainConfig(), &vm.Config{UseOpcodeComputationCost: true})
 		if _, err = blockchain.ApplyMessage(vmenv, msg); err != nil {
 			failed = err
@@ -721,15 +724,16 @@ func (api *API) standardTraceBlockToFile(ctx context.Context, block *types.Block
 	)
 	for i, tx := range block.Transactions() {
 		// Prepare the transaction for un-traced execution
-		msg, err := tx.AsMessageWithAccountKeyPicker(signer, statedb, block.NumberU64())
+		blockCtx := blockchain.NewEVMBlockContext(block.Header(), newChainContext(ctx, api.backend), nil)
+		picker := blockchain.NewContractKeyPicker(statedb, blockCtx, api.backend.ChainConfig())
+		msg, err := tx.AsMessageWithAccountKeyPicker(signer, picker, block.NumberU64())
 		if err != nil {
 			logger.Warn("Tracing failed", "hash", tx.Hash(), "block", block.NumberU64(), "err", err)
 			return nil, fmt.Errorf("transaction %#x failed: %v", tx.Hash(), err)
 		}
 
 		var (
-			txCtx    = blockchain.NewEVMTxContext(msg, block.Header())
-			blockCtx = blockchain.NewEVMBlockContext(block.Header(), newChainContext(ctx, api.backend), nil)
+			txCtx = blockchain.NewEVMTxContext(msg, block.Header())
 
 			vmConf vm.Config
 			dump   *os.File
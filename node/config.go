@@ -28,8 +28,10 @@ import (
 	"path/filepath"
 	"runtime"
 	"strings"
+	"time"
 
 	"github.com/klaytn/klaytn/accounts"
+	"github.com/klaytn/klaytn/accounts/external"
 	"github.com/klaytn/klaytn/accounts/keystore"
 	"github.com/klaytn/klaytn/common"
 	"github.com/klaytn/klaytn/crypto"
@@ -94,12 +96,19 @@ type Config struct {
 	// scrypt KDF at the expense of security.
 	UseLightweightKDF bool `toml:",omitempty"`
 
+	// ExternalSigner is the endpoint (an IPC path or an HTTP URL) of the external signer.
+	// If set, the accounts of the external signer are available in addition to the keystore.
+	ExternalSigner string `toml:",omitempty"`
+
 	// IPCPath is the requested location to place the IPC endpoint. If the path is
 	// a simple file name, it is placed inside the data directory (or on the root
 	// pipe path on Windows), whereas if it's a resolvable path name (absolute or
 	// relative), then that specific path is enforced. An empty path disables IPC.
 	IPCPath string `toml:",omitempty"`
 
+	// IPCLimits are the limits of the calls served by the IPC RPC interface.
+	IPCLimits rpc.Limits `toml:",omitempty"`
+
 	// HTTP module type is http server module type (fasthttp and http)
 	HTTPServerType string `toml:",omitempty"`
 
@@ -135,6 +144,31 @@ type Config struct {
 	// interface.
 	HTTPTimeouts rpc.HTTPTimeouts
 
+	// HTTPLimits are the limits of the calls served by the HTTP RPC interface.
+	HTTPLimits rpc.Limits `toml:",omitempty"`
+
+	// RPCCacheSize is the maximum size in megabytes of the cache for the RPC responses
+	// which cannot change anymore, such as blocks and receipts below the current head.
+	// Zero disables the cache.
+	RPCCacheSize int `toml:",omitempty"`
+
+	// RPCCacheTTL is the time for which a cached RPC response is served.
+	// Zero keeps the responses until they are evicted by the size limit.
+	RPCCacheTTL time.Duration `toml:",omitempty"`
+
+	// RPCRecordDir is the directory to write the sampled RPC calls to, which can be
+	// replayed against another node by kreplay. Empty disables the recording.
+	RPCRecordDir string `toml:",omitempty"`
+
+	// RPCRecordSampleRate is the fraction of the RPC calls recorded.
+	RPCRecordSampleRate float64 `toml:",omitempty"`
+
+	// RPCRecordFileSize is the size in megabytes of a record file before it is rotated.
+	RPCRecordFileSize int `toml:",omitempty"`
+
+	// RPCRecordFiles is the number of the record files kept. Zero keeps all the files.
+	RPCRecordFiles int `toml:",omitempty"`
+
 	// WSHost is the host interface on which to start the websocket RPC server. If
 	// this field is empty, no websocket API endpoint will be started.
 	WSHost string `toml:",omitempty"`
@@ -161,6 +195,9 @@ type Config struct {
 	// private APIs to untrusted users is a major security risk.
 	WSExposeAll bool `toml:",omitempty"`
 
+	// WSLimits are the limits of the calls served by the websocket RPC interface.
+	WSLimits rpc.Limits `toml:",omitempty"`
+
 	// GRPCHost is the host interface on which to start the gRPC server. If
 	// this field is empty, no gRPC API endpoint will be started.
 	GRPCHost string `toml:",omitempty"`
@@ -462,5 +499,13 @@ func makeAccountManager(conf *Config) (*accounts.Manager, string, error) {
 	backends := []accounts.Backend{
 		keystore.NewKeyStore(keydir, scryptN, scryptP),
 	}
+	if len(conf.ExternalSigner) > 0 {
+		logger.Info("Using external signer", "url", conf.ExternalSigner)
+		extapi, err := external.NewExternalBackend(conf.ExternalSigner)
+		if err != nil {
+			return nil, "", fmt.Errorf("error connecting to external signer: %v", err)
+		}
+		backends = append(backends, extapi)
+	}
 	return accounts.NewManager(backends...), ephemeral, nil
 }
@@ -27,6 +27,7 @@ import (
 	"path/filepath"
 	"runtime"
 	"strings"
+	"time"
 
 	"github.com/klaytn/klaytn/networks/rpc"
 
@@ -45,20 +46,28 @@ const (
 	DefaultGRPCPort               = 8553        // Default TCP port for the gRPC server
 	DefaultP2PPort                = 32323
 	DefaultP2PSubPort             = 32324
-	DefaultMaxPhysicalConnections = 10 // Default the max number of node's physical connections
+	DefaultMaxPhysicalConnections = 10               // Default the max number of node's physical connections
+	DefaultRPCCacheTTL            = 10 * time.Minute // Default time to live of the cached RPC responses
+	DefaultRPCRecordSampleRate    = 0.1              // Default fraction of the RPC calls recorded
+	DefaultRPCRecordFileSize      = 100              // Default size in megabytes of an RPC record file
+	DefaultRPCRecordFiles         = 10               // Default number of the RPC record files kept
 )
 
 // DefaultConfig contains reasonable default settings.
 var DefaultConfig = Config{
-	DBType:           DefaultDBType(),
-	DataDir:          DefaultDataDir(),
-	HTTPPort:         DefaultHTTPPort,
-	HTTPModules:      []string{"net", "web3"},
-	HTTPVirtualHosts: []string{"localhost"},
-	HTTPTimeouts:     rpc.DefaultHTTPTimeouts,
-	WSPort:           DefaultWSPort,
-	WSModules:        []string{"net", "web3"},
-	GRPCPort:         DefaultGRPCPort,
+	DBType:              DefaultDBType(),
+	DataDir:             DefaultDataDir(),
+	HTTPPort:            DefaultHTTPPort,
+	HTTPModules:         []string{"net", "web3"},
+	HTTPVirtualHosts:    []string{"localhost"},
+	HTTPTimeouts:        rpc.DefaultHTTPTimeouts,
+	RPCCacheTTL:         DefaultRPCCacheTTL,
+	RPCRecordSampleRate: DefaultRPCRecordSampleRate,
+	RPCRecordFileSize:   DefaultRPCRecordFileSize,
+	RPCRecordFiles:      DefaultRPCRecordFiles,
+	WSPort:              DefaultWSPort,
+	WSModules:           []string{"net", "web3"},
+	GRPCPort:            DefaultGRPCPort,
 	P2P: p2p.Config{
 		ListenAddr:             fmt.Sprintf(":%d", DefaultP2PPort),
 		MaxPhysicalConnections: DefaultMaxPhysicalConnections,
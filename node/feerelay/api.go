@@ -0,0 +1,78 @@
+// Copyright 2024 The klaytn Authors
+// This file is part of the klaytn library.
+//
+// The klaytn library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The klaytn library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the klaytn library. If not, see <http://www.gnu.org/licenses/>.
+
+package feerelay
+
+import (
+	"context"
+	"math/big"
+
+	"github.com/klaytn/klaytn/blockchain/types"
+	"github.com/klaytn/klaytn/common"
+	"github.com/klaytn/klaytn/common/hexutil"
+)
+
+// PublicFeeRelayAPI provides an API to request the fee relay to pay the fee of transactions.
+type PublicFeeRelayAPI struct {
+	relay *FeeRelay
+}
+
+func NewPublicFeeRelayAPI(relay *FeeRelay) *PublicFeeRelayAPI {
+	return &PublicFeeRelayAPI{relay: relay}
+}
+
+// BudgetResult is the daily budget of a sender. Limit and Remaining are nil if the budget is unlimited.
+type BudgetResult struct {
+	Limit     *hexutil.Big `json:"limit"`
+	Spent     *hexutil.Big `json:"spent"`
+	Remaining *hexutil.Big `json:"remaining"`
+}
+
+// SendRawTransaction co-signs the RLP-encoded fee-delegated transaction signed by the sender
+// as the fee payer and submits it to the transaction pool. The fee payer of the transaction
+// must be the address returned by feerelay_feePayer.
+func (api *PublicFeeRelayAPI) SendRawTransaction(ctx context.Context, rawTx hexutil.Bytes) (common.Hash, error) {
+	tx, err := types.DecodeTxWithoutSigValidation(rawTx)
+	if err != nil {
+		return common.Hash{}, err
+	}
+	return api.relay.Relay(tx)
+}
+
+// FeePayer returns the address of the fee payer of the relay.
+func (api *PublicFeeRelayAPI) FeePayer() common.Address {
+	return api.relay.config.FeePayer
+}
+
+// Policy returns the policy of the relay.
+func (api *PublicFeeRelayAPI) Policy() *Policy {
+	return api.relay.policy
+}
+
+// GetBudget returns the daily budget of the sender.
+func (api *PublicFeeRelayAPI) GetBudget(sender common.Address) *BudgetResult {
+	spent := api.relay.budgets.Spent(sender)
+	result := &BudgetResult{Spent: (*hexutil.Big)(spent)}
+	if limit := api.relay.policy.BudgetOf(sender); limit != nil {
+		remaining := new(big.Int).Sub(limit, spent)
+		if remaining.Sign() < 0 {
+			remaining.SetUint64(0)
+		}
+		result.Limit = (*hexutil.Big)(limit)
+		result.Remaining = (*hexutil.Big)(remaining)
+	}
+	return result
+}
@@ -0,0 +1,182 @@
+// Copyright 2024 The klaytn Authors
+// This file is part of the klaytn library.
+//
+// The klaytn library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The klaytn library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the klaytn library. If not, see <http://www.gnu.org/licenses/>.
+
+package feerelay
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"math/big"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/klaytn/klaytn/common"
+	"github.com/klaytn/klaytn/common/math"
+)
+
+const dayLayout = "2006-01-02"
+
+var errBudgetExceeded = errors.New("the daily budget of the sender is exceeded")
+
+// budgetJournal is the persisted form of the spent budgets.
+type budgetJournal struct {
+	Day   string                                   `json:"day"`
+	Spent map[common.Address]*math.HexOrDecimal256 `json:"spent"`
+}
+
+// budgetStore keeps track of the fees spent for each sender on the current day (UTC).
+// The spent budgets are written to the file whenever they are changed, so that
+// they survive restarts of the node.
+type budgetStore struct {
+	mu    sync.Mutex
+	path  string
+	day   string
+	spent map[common.Address]*big.Int
+	now   func() time.Time
+}
+
+// newBudgetStore loads the spent budgets from the given file if it exists.
+// If path is empty, the spent budgets are kept only in memory.
+func newBudgetStore(path string) (*budgetStore, error) {
+	return loadBudgetStore(path, time.Now)
+}
+
+func loadBudgetStore(path string, now func() time.Time) (*budgetStore, error) {
+	s := &budgetStore{
+		path:  path,
+		spent: make(map[common.Address]*big.Int),
+		now:   now,
+	}
+	s.day = s.today()
+	if path == "" {
+		return s, nil
+	}
+	b, err := ioutil.ReadFile(path)
+	if os.IsNotExist(err) {
+		return s, nil
+	} else if err != nil {
+		return nil, err
+	}
+	var journal budgetJournal
+	if err := json.Unmarshal(b, &journal); err != nil {
+		return nil, fmt.Errorf("invalid budget file %s: %v", path, err)
+	}
+	if journal.Day == s.day {
+		for addr, spent := range journal.Spent {
+			s.spent[addr] = (*big.Int)(spent)
+		}
+	}
+	return s, nil
+}
+
+func (s *budgetStore) today() string {
+	return s.now().UTC().Format(dayLayout)
+}
+
+// rollover resets the spent budgets if the day has changed. The caller must hold the lock.
+func (s *budgetStore) rollover() {
+	if today := s.today(); today != s.day {
+		s.day = today
+		s.spent = make(map[common.Address]*big.Int)
+	}
+}
+
+// Spent returns the fee spent for the sender today.
+func (s *budgetStore) Spent(sender common.Address) *big.Int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.rollover()
+	if spent, ok := s.spent[sender]; ok {
+		return new(big.Int).Set(spent)
+	}
+	return new(big.Int)
+}
+
+// Reserve adds the fee to the spent budget of the sender. It fails if the spent budget
+// would exceed the given limit. A nil limit means that the budget is unlimited.
+func (s *budgetStore) Reserve(sender common.Address, fee, limit *big.Int) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.rollover()
+	spent := new(big.Int).Add(fee, s.spentOf(sender))
+	if limit != nil && spent.Cmp(limit) > 0 {
+		return fmt.Errorf("%w: %v + %v > %v", errBudgetExceeded, s.spentOf(sender), fee, limit)
+	}
+	prev, ok := s.spent[sender]
+	s.spent[sender] = spent
+	if err := s.save(); err != nil {
+		if ok {
+			s.spent[sender] = prev
+		} else {
+			delete(s.spent, sender)
+		}
+		return err
+	}
+	return nil
+}
+
+// Refund subtracts the fee from the spent budget of the sender, e.g., when the
+// transaction could not be submitted.
+func (s *budgetStore) Refund(sender common.Address, fee *big.Int) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.rollover()
+	spent := new(big.Int).Sub(s.spentOf(sender), fee)
+	if spent.Sign() <= 0 {
+		delete(s.spent, sender)
+	} else {
+		s.spent[sender] = spent
+	}
+	return s.save()
+}
+
+func (s *budgetStore) spentOf(sender common.Address) *big.Int {
+	if spent, ok := s.spent[sender]; ok {
+		return spent
+	}
+	return new(big.Int)
+}
+
+// save writes the spent budgets to the file. The caller must hold the lock.
+func (s *budgetStore) save() error {
+	if s.path == "" {
+		return nil
+	}
+	journal := budgetJournal{Day: s.day, Spent: make(map[common.Address]*math.HexOrDecimal256, len(s.spent))}
+	for addr, spent := range s.spent {
+		journal.Spent[addr] = (*math.HexOrDecimal256)(spent)
+	}
+	b, err := json.MarshalIndent(journal, "", "  ")
+	if err != nil {
+		return err
+	}
+	// Write to a temporary file first not to corrupt the journal on a crash.
+	tmp := s.path + ".tmp"
+	if err := os.MkdirAll(filepath.Dir(s.path), 0o700); err != nil {
+		return err
+	}
+	if err := ioutil.WriteFile(tmp, b, 0o600); err != nil {
+		return err
+	}
+	return os.Rename(tmp, s.path)
+}
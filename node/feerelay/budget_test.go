@@ -0,0 +1,64 @@
+// Copyright 2024 The klaytn Authors
+// This file is part of the klaytn library.
+//
+// The klaytn library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The klaytn library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the klaytn library. If not, see <http://www.gnu.org/licenses/>.
+
+package feerelay
+
+import (
+	"errors"
+	"math/big"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBudgetStore(t *testing.T) {
+	path := filepath.Join(t.TempDir(), DefaultBudgetFile)
+	now := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	store, err := loadBudgetStore(path, func() time.Time { return now })
+	require.NoError(t, err)
+
+	limit := big.NewInt(100)
+	require.NoError(t, store.Reserve(testSender, big.NewInt(60), limit))
+	err = store.Reserve(testSender, big.NewInt(41), limit)
+	assert.True(t, errors.Is(err, errBudgetExceeded))
+	assert.Equal(t, big.NewInt(60), store.Spent(testSender))
+
+	require.NoError(t, store.Refund(testSender, big.NewInt(20)))
+	assert.Equal(t, big.NewInt(40), store.Spent(testSender))
+
+	// an unlimited budget is still accounted
+	require.NoError(t, store.Reserve(testContract, big.NewInt(1000), nil))
+	assert.Equal(t, big.NewInt(1000), store.Spent(testContract))
+
+	// the spent budgets are reloaded on the same day
+	reloaded, err := loadBudgetStore(path, store.now)
+	require.NoError(t, err)
+	assert.Equal(t, big.NewInt(40), reloaded.Spent(testSender))
+
+	// the spent budgets are reset on the next day
+	now = now.Add(24 * time.Hour)
+	assert.Equal(t, new(big.Int), store.Spent(testSender))
+	require.NoError(t, store.Reserve(testSender, big.NewInt(100), limit))
+
+	reloaded, err = loadBudgetStore(path, store.now)
+	require.NoError(t, err)
+	assert.Equal(t, big.NewInt(100), reloaded.Spent(testSender))
+	assert.Equal(t, new(big.Int), reloaded.Spent(testContract))
+}
@@ -0,0 +1,44 @@
+// Copyright 2024 The klaytn Authors
+// This file is part of the klaytn library.
+//
+// The klaytn library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The klaytn library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the klaytn library. If not, see <http://www.gnu.org/licenses/>.
+
+package feerelay
+
+import "github.com/klaytn/klaytn/common"
+
+const DefaultBudgetFile = "feerelay_budgets.json"
+
+// Config is the configuration of the fee-delegation relay service.
+type Config struct {
+	EnabledFeeRelay bool
+
+	// FeePayer is the account co-signing the relayed transactions. It must be unlocked.
+	FeePayer common.Address
+
+	// PolicyFile is the path of the JSON file describing the relay policy.
+	// It is required and should have allowed contracts or a daily budget.
+	PolicyFile string
+
+	// BudgetFile is the path of the file persisting the spent budgets.
+	// A relative path is resolved in the data directory.
+	BudgetFile string
+}
+
+func DefaultConfig() *Config {
+	return &Config{
+		EnabledFeeRelay: false,
+		BudgetFile:      DefaultBudgetFile,
+	}
+}
@@ -0,0 +1,52 @@
+// Copyright 2024 The klaytn Authors
+// This file is part of the klaytn library.
+//
+// The klaytn library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The klaytn library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the klaytn library. If not, see <http://www.gnu.org/licenses/>.
+
+/*
+Package feerelay implements the fee-delegation relay service.
+
+The relay accepts fee-delegated transactions signed by senders through feerelay_sendRawTransaction,
+co-signs them with an unlocked fee payer account and submits them to the transaction pool.
+The fee payer field of a transaction must be set to the relay's fee payer before the sender signs it.
+A transaction is relayed only if it is allowed by the policy, which restricts the called contracts and
+their methods, the gas limit, the fee ratio and the daily fee budget of each sender.
+
+The fee payer's share of the maximum fee (gas limit * gas price * fee ratio) is charged to the budget
+of the sender when the transaction is relayed. The spent budgets are persisted in a file so that they
+survive restarts, and they are reset every day at 00:00 UTC.
+
+A policy file is a JSON object like below. An omitted field means no restriction, but either
+allowedContracts or dailyBudget is required, since anyone reaching the API can request the relay.
+
+	{
+	  "allowedContracts": [
+	    {"address": "0x...", "selectors": ["0xa9059cbb"]}
+	  ],
+	  "maxGas": 500000,
+	  "maxFeeRatio": 100,
+	  "dailyBudget": "1000000000000000000",
+	  "senderBudgets": {"0x...": "5000000000000000000"}
+	}
+
+Source Files
+
+  - api.go	: Provides `PublicFeeRelayAPI` serving the feerelay namespace
+  - budget.go	: Provides `budgetStore` which keeps track of the spent budgets and persists them
+  - config.go	: Defines the configuration of the fee relay
+  - metrics.go	: Defines the metrics of the fee relay
+  - policy.go	: Defines `Policy` deciding whether a transaction is relayed or not
+  - relay.go	: Provides `FeeRelay` service co-signing and submitting transactions
+*/
+package feerelay
@@ -0,0 +1,171 @@
+// Copyright 2024 The klaytn Authors
+// This file is part of the klaytn library.
+//
+// The klaytn library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The klaytn library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the klaytn library. If not, see <http://www.gnu.org/licenses/>.
+
+package feerelay
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"math/big"
+
+	"github.com/klaytn/klaytn/blockchain/types"
+	"github.com/klaytn/klaytn/common"
+	"github.com/klaytn/klaytn/common/hexutil"
+	"github.com/klaytn/klaytn/common/math"
+)
+
+var (
+	errContractNotAllowed = errors.New("the recipient is not an allowed contract")
+	errMethodNotAllowed   = errors.New("the method is not allowed")
+	errGasTooLarge        = errors.New("gas limit exceeds the limit")
+	errFeeRatioTooLarge   = errors.New("fee ratio exceeds the limit")
+)
+
+// ContractPolicy allows the calls to a contract. If Selectors is empty, every
+// method of the contract is allowed.
+type ContractPolicy struct {
+	Address   common.Address  `json:"address"`
+	Selectors []hexutil.Bytes `json:"selectors,omitempty"`
+}
+
+// Policy decides which fee-delegated transactions are relayed.
+// An empty list or a zero limit means that there is no restriction on the field,
+// but either AllowedContracts or DailyBudget should be given to enable the relay.
+type Policy struct {
+	// AllowedContracts is the list of contracts which can be called by the relayed transactions.
+	// If it is not empty, value transfers to other accounts and contract deployments are rejected.
+	AllowedContracts []ContractPolicy `json:"allowedContracts"`
+
+	// MaxGas is the maximum gas limit of a relayed transaction.
+	MaxGas uint64 `json:"maxGas"`
+
+	// MaxFeeRatio is the maximum percentage of the fee paid by the fee payer.
+	// Since the fee payer pays the whole fee of a transaction without a ratio, those
+	// transactions are rejected if it is less than 100.
+	MaxFeeRatio uint8 `json:"maxFeeRatio"`
+
+	// DailyBudget is the maximum fee in peb which the fee payer pays for a sender a day (UTC).
+	DailyBudget *math.HexOrDecimal256 `json:"dailyBudget"`
+
+	// SenderBudgets overrides DailyBudget for specific senders.
+	SenderBudgets map[common.Address]*math.HexOrDecimal256 `json:"senderBudgets"`
+}
+
+// LoadPolicy reads the policy from the given JSON file.
+func LoadPolicy(path string) (*Policy, error) {
+	b, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	policy := new(Policy)
+	if err := json.Unmarshal(b, policy); err != nil {
+		return nil, fmt.Errorf("invalid policy file %s: %v", path, err)
+	}
+	if policy.MaxFeeRatio > 100 {
+		return nil, fmt.Errorf("invalid policy file %s: maxFeeRatio must not exceed 100", path)
+	}
+	for _, contract := range policy.AllowedContracts {
+		for _, selector := range contract.Selectors {
+			if len(selector) != 4 {
+				return nil, fmt.Errorf("invalid policy file %s: selector %v is not 4 bytes", path, selector)
+			}
+		}
+	}
+	return policy, nil
+}
+
+// Check returns an error if the transaction is not allowed by the policy.
+func (p *Policy) Check(tx *types.Transaction) error {
+	if len(p.AllowedContracts) > 0 {
+		if tx.To() == nil {
+			return errContractNotAllowed
+		}
+		contract := p.findContract(*tx.To())
+		if contract == nil {
+			return fmt.Errorf("%w: %s", errContractNotAllowed, tx.To().Hex())
+		}
+		if len(contract.Selectors) > 0 && !containsSelector(contract.Selectors, tx.Data()) {
+			return errMethodNotAllowed
+		}
+	}
+	if p.MaxGas != 0 && tx.Gas() > p.MaxGas {
+		return fmt.Errorf("%w: %d > %d", errGasTooLarge, tx.Gas(), p.MaxGas)
+	}
+	if p.MaxFeeRatio != 0 && uint64(feeRatioOf(tx)) > uint64(p.MaxFeeRatio) {
+		return fmt.Errorf("%w: %d > %d", errFeeRatioTooLarge, feeRatioOf(tx), p.MaxFeeRatio)
+	}
+	return nil
+}
+
+// limited returns whether the policy limits the fee paid by the fee payer, either by
+// the contracts called or by the daily budget of every sender.
+func (p *Policy) limited() bool {
+	if len(p.AllowedContracts) > 0 {
+		return true
+	}
+	return p.DailyBudget != nil && (*big.Int)(p.DailyBudget).Sign() > 0
+}
+
+// BudgetOf returns the daily budget of the sender, or nil if there is no limit.
+func (p *Policy) BudgetOf(sender common.Address) *big.Int {
+	if budget, ok := p.SenderBudgets[sender]; ok && budget != nil {
+		return (*big.Int)(budget)
+	}
+	if p.DailyBudget != nil {
+		return (*big.Int)(p.DailyBudget)
+	}
+	return nil
+}
+
+func (p *Policy) findContract(addr common.Address) *ContractPolicy {
+	for i := range p.AllowedContracts {
+		if p.AllowedContracts[i].Address == addr {
+			return &p.AllowedContracts[i]
+		}
+	}
+	return nil
+}
+
+func containsSelector(selectors []hexutil.Bytes, data []byte) bool {
+	if len(data) < 4 {
+		return false
+	}
+	for _, selector := range selectors {
+		if bytes.Equal(selector, data[:4]) {
+			return true
+		}
+	}
+	return false
+}
+
+// feeRatioOf returns the percentage of the fee paid by the fee payer.
+func feeRatioOf(tx *types.Transaction) types.FeeRatio {
+	ratio, _ := tx.FeeRatio()
+	return ratio
+}
+
+// feePayerFee returns the maximum fee which the fee payer pays for the transaction.
+func feePayerFee(tx *types.Transaction) *big.Int {
+	fee := new(big.Int).Mul(tx.GasPrice(), new(big.Int).SetUint64(tx.Gas()))
+	if ratio := feeRatioOf(tx); ratio != types.MaxFeeRatio {
+		fee.Mul(fee, big.NewInt(int64(ratio)))
+		fee.Div(fee, big.NewInt(int64(types.MaxFeeRatio)))
+	}
+	return fee
+}
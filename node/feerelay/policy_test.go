@@ -0,0 +1,139 @@
+// Copyright 2024 The klaytn Authors
+// This file is part of the klaytn library.
+//
+// The klaytn library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The klaytn library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the klaytn library. If not, see <http://www.gnu.org/licenses/>.
+
+package feerelay
+
+import (
+	"errors"
+	"io/ioutil"
+	"math/big"
+	"path/filepath"
+	"testing"
+
+	"github.com/klaytn/klaytn/blockchain/types"
+	"github.com/klaytn/klaytn/common"
+	"github.com/klaytn/klaytn/common/hexutil"
+	"github.com/klaytn/klaytn/common/math"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	testContract = common.HexToAddress("0x000000000000000000000000000000000000c0de")
+	testSender   = common.HexToAddress("0x0000000000000000000000000000000000001234")
+	testFeePayer = common.HexToAddress("0x000000000000000000000000000000000000fee0")
+)
+
+func newTestTx(t *testing.T, to common.Address, data []byte, gas uint64, ratio types.FeeRatio) *types.Transaction {
+	txType := types.TxTypeFeeDelegatedSmartContractExecution
+	values := map[types.TxValueKeyType]interface{}{
+		types.TxValueKeyNonce:    uint64(0),
+		types.TxValueKeyTo:       to,
+		types.TxValueKeyAmount:   new(big.Int),
+		types.TxValueKeyGasLimit: gas,
+		types.TxValueKeyGasPrice: big.NewInt(25000000000),
+		types.TxValueKeyFrom:     testSender,
+		types.TxValueKeyData:     data,
+		types.TxValueKeyFeePayer: testFeePayer,
+	}
+	if ratio != types.MaxFeeRatio {
+		txType = types.TxTypeFeeDelegatedSmartContractExecutionWithRatio
+		values[types.TxValueKeyFeeRatioOfFeePayer] = ratio
+	}
+	tx, err := types.NewTransactionWithMap(txType, values)
+	require.NoError(t, err)
+	return tx
+}
+
+func TestPolicy_Check(t *testing.T) {
+	policy := &Policy{
+		AllowedContracts: []ContractPolicy{
+			{Address: testContract, Selectors: []hexutil.Bytes{{0xa9, 0x05, 0x9c, 0xbb}}},
+		},
+		MaxGas:      100000,
+		MaxFeeRatio: 50,
+	}
+	transfer := common.FromHex("0xa9059cbb0000000000000000000000000000000000000000000000000000000000001234")
+
+	testcases := []struct {
+		tx  *types.Transaction
+		err error
+	}{
+		{newTestTx(t, testContract, transfer, 100000, 50), nil},
+		{newTestTx(t, testSender, transfer, 100000, 50), errContractNotAllowed},
+		{newTestTx(t, testContract, common.FromHex("0x095ea7b3"), 100000, 50), errMethodNotAllowed},
+		{newTestTx(t, testContract, transfer[:3], 100000, 50), errMethodNotAllowed},
+		{newTestTx(t, testContract, transfer, 100001, 50), errGasTooLarge},
+		{newTestTx(t, testContract, transfer, 100000, 51), errFeeRatioTooLarge},
+		// the fee payer pays the whole fee of a transaction without a ratio
+		{newTestTx(t, testContract, transfer, 100000, types.MaxFeeRatio), errFeeRatioTooLarge},
+	}
+	for i, tc := range testcases {
+		err := policy.Check(tc.tx)
+		if tc.err == nil {
+			assert.NoError(t, err, "testcase %d", i)
+		} else {
+			assert.True(t, errors.Is(err, tc.err), "testcase %d: %v", i, err)
+		}
+	}
+
+	// an empty policy allows every transaction
+	assert.NoError(t, new(Policy).Check(newTestTx(t, testSender, nil, 1000000, types.MaxFeeRatio)))
+}
+
+func TestPolicy_BudgetOf(t *testing.T) {
+	policy := &Policy{}
+	assert.Nil(t, policy.BudgetOf(testSender))
+
+	policy.DailyBudget = (*math.HexOrDecimal256)(big.NewInt(100))
+	policy.SenderBudgets = map[common.Address]*math.HexOrDecimal256{
+		testSender: (*math.HexOrDecimal256)(big.NewInt(1000)),
+	}
+	assert.Equal(t, big.NewInt(1000), policy.BudgetOf(testSender))
+	assert.Equal(t, big.NewInt(100), policy.BudgetOf(testContract))
+}
+
+func TestLoadPolicy(t *testing.T) {
+	dir := t.TempDir()
+	write := func(content string) string {
+		path := filepath.Join(dir, "policy.json")
+		require.NoError(t, ioutil.WriteFile(path, []byte(content), 0o600))
+		return path
+	}
+
+	policy, err := LoadPolicy(write(`{
+		"allowedContracts": [{"address": "0x000000000000000000000000000000000000c0de", "selectors": ["0xa9059cbb"]}],
+		"maxGas": 100000,
+		"maxFeeRatio": 100,
+		"dailyBudget": "0xde0b6b3a7640000"
+	}`))
+	require.NoError(t, err)
+	assert.Equal(t, testContract, policy.AllowedContracts[0].Address)
+	assert.Equal(t, uint64(100000), policy.MaxGas)
+	assert.Equal(t, big.NewInt(1e18), policy.BudgetOf(testSender))
+
+	_, err = LoadPolicy(write(`{"maxFeeRatio": 101}`))
+	assert.Error(t, err)
+
+	_, err = LoadPolicy(write(`{"allowedContracts": [{"address": "0x000000000000000000000000000000000000c0de", "selectors": ["0xa9059c"]}]}`))
+	assert.Error(t, err)
+}
+
+func TestFeePayerFee(t *testing.T) {
+	// 25 ston * 100000 gas
+	assert.Equal(t, big.NewInt(2500000000000000), feePayerFee(newTestTx(t, testContract, nil, 100000, types.MaxFeeRatio)))
+	assert.Equal(t, big.NewInt(750000000000000), feePayerFee(newTestTx(t, testContract, nil, 100000, 30)))
+}
@@ -0,0 +1,224 @@
+// Copyright 2024 The klaytn Authors
+// This file is part of the klaytn library.
+//
+// The klaytn library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The klaytn library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the klaytn library. If not, see <http://www.gnu.org/licenses/>.
+
+package feerelay
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"path/filepath"
+
+	"github.com/klaytn/klaytn/accounts"
+	"github.com/klaytn/klaytn/blockchain"
+	"github.com/klaytn/klaytn/blockchain/state"
+	"github.com/klaytn/klaytn/blockchain/types"
+	"github.com/klaytn/klaytn/common"
+	"github.com/klaytn/klaytn/consensus"
+	"github.com/klaytn/klaytn/log"
+	"github.com/klaytn/klaytn/networks/p2p"
+	"github.com/klaytn/klaytn/networks/rpc"
+	"github.com/klaytn/klaytn/node"
+	"github.com/klaytn/klaytn/params"
+)
+
+var logger = log.NewModuleLogger(log.NodeFeeRelay)
+
+var (
+	errNotFeeDelegated  = errors.New("the transaction is not a fee-delegated transaction")
+	errFeePayerMismatch = errors.New("the fee payer of the transaction is not the relay's fee payer")
+	errNotReady         = errors.New("the fee relay is not ready")
+	errPolicyNotLimited = errors.New("the policy of the fee relay must have allowedContracts or a positive dailyBudget")
+)
+
+// BlockChain is the interface of the blockchain used by the fee relay.
+type BlockChain interface {
+	Config() *params.ChainConfig
+	CurrentBlock() *types.Block
+	State() (*state.StateDB, error)
+	Engine() consensus.Engine
+	GetHeader(hash common.Hash, number uint64) *types.Header
+}
+
+// TxPool is the interface of the transaction pool used by the fee relay.
+type TxPool interface {
+	AddRemote(tx *types.Transaction) error
+}
+
+// FeeRelay is a service which co-signs the fee-delegated transactions signed by senders
+// as the fee payer and submits them to the transaction pool, if they are allowed by the policy.
+type FeeRelay struct {
+	config  *Config
+	policy  *Policy
+	budgets *budgetStore
+	am      accounts.AccountManager
+
+	blockchain BlockChain
+	txPool     TxPool
+}
+
+// NewFeeRelay creates a fee relay service with the given configuration.
+func NewFeeRelay(ctx *node.ServiceContext, cfg *Config) (*FeeRelay, error) {
+	budgetFile := cfg.BudgetFile
+	if budgetFile != "" && !filepath.IsAbs(budgetFile) {
+		budgetFile = ctx.ResolvePath(budgetFile)
+	}
+	return newFeeRelay(cfg, ctx.AccountManager, budgetFile)
+}
+
+func newFeeRelay(cfg *Config, am accounts.AccountManager, budgetFile string) (*FeeRelay, error) {
+	if cfg.FeePayer == (common.Address{}) {
+		return nil, errors.New("the fee payer of the fee relay is not specified")
+	}
+	// The API is public, so the fee relay is not enabled without a policy limiting
+	// the fee paid for anyone reaching the API.
+	if cfg.PolicyFile == "" {
+		return nil, errPolicyNotLimited
+	}
+	policy, err := LoadPolicy(cfg.PolicyFile)
+	if err != nil {
+		return nil, err
+	}
+	if !policy.limited() {
+		return nil, errPolicyNotLimited
+	}
+	budgets, err := newBudgetStore(budgetFile)
+	if err != nil {
+		return nil, err
+	}
+	return &FeeRelay{
+		config:  cfg,
+		policy:  policy,
+		budgets: budgets,
+		am:      am,
+	}, nil
+}
+
+func (r *FeeRelay) Protocols() []p2p.Protocol {
+	return []p2p.Protocol{}
+}
+
+func (r *FeeRelay) APIs() []rpc.API {
+	return []rpc.API{
+		{
+			Namespace: "feerelay",
+			Version:   "1.0",
+			Service:   NewPublicFeeRelayAPI(r),
+			Public:    true,
+		},
+	}
+}
+
+func (r *FeeRelay) Start(server p2p.Server) error {
+	if _, err := r.am.Find(accounts.Account{Address: r.config.FeePayer}); err != nil {
+		return fmt.Errorf("the fee payer %s of the fee relay is not found: %v", r.config.FeePayer.Hex(), err)
+	}
+	logger.Info("Fee relay is started", "feePayer", r.config.FeePayer, "policy", r.config.PolicyFile)
+	return nil
+}
+
+func (r *FeeRelay) Stop() error {
+	logger.Info("Fee relay is stopped")
+	return nil
+}
+
+func (r *FeeRelay) Components() []interface{} {
+	return nil
+}
+
+func (r *FeeRelay) SetComponents(components []interface{}) {
+	for _, component := range components {
+		switch v := component.(type) {
+		case *blockchain.BlockChain:
+			r.blockchain = v
+		case *blockchain.TxPool:
+			r.txPool = v
+		}
+	}
+}
+
+// Relay co-signs the sender-signed fee-delegated transaction and submits it to the
+// transaction pool. The fee of the transaction is charged to the daily budget of the
+// sender in advance, and refunded if the transaction is not submitted.
+func (r *FeeRelay) Relay(tx *types.Transaction) (common.Hash, error) {
+	hash, err := r.relay(tx)
+	if err != nil {
+		rejectedTxCounter.Inc(1)
+		logger.Debug("Rejected a transaction to relay", "err", err)
+		return common.Hash{}, err
+	}
+	return hash, nil
+}
+
+func (r *FeeRelay) relay(tx *types.Transaction) (common.Hash, error) {
+	if r.blockchain == nil || r.txPool == nil {
+		return common.Hash{}, errNotReady
+	}
+	if !tx.Type().IsFeeDelegatedTransaction() {
+		return common.Hash{}, errNotFeeDelegated
+	}
+	if feePayer, err := tx.FeePayer(); err != nil || feePayer != r.config.FeePayer {
+		return common.Hash{}, errFeePayerMismatch
+	}
+	if err := r.policy.Check(tx); err != nil {
+		return common.Hash{}, err
+	}
+
+	// Validate the signatures of the sender before charging the budget of the sender.
+	// Like the transaction pool, the validator contracts of AccountKeyContract are executed.
+	chainID := r.blockchain.Config().ChainID
+	signer := types.LatestSignerForChainID(chainID)
+	statedb, err := r.blockchain.State()
+	if err != nil {
+		return common.Hash{}, err
+	}
+	head := r.blockchain.CurrentBlock().Header()
+	blockContext := blockchain.NewEVMBlockContext(head, r.blockchain, &head.Rewardbase)
+	picker := blockchain.NewContractKeyPicker(statedb, blockContext, r.blockchain.Config())
+	if _, err := tx.ValidateSender(signer, picker, head.Number.Uint64()); err != nil {
+		return common.Hash{}, err
+	}
+	sender := tx.ValidatedSender()
+
+	fee := feePayerFee(tx)
+	if err := r.budgets.Reserve(sender, fee, r.policy.BudgetOf(sender)); err != nil {
+		return common.Hash{}, err
+	}
+	signed, err := r.signAsFeePayer(tx, chainID)
+	if err == nil {
+		err = r.txPool.AddRemote(signed)
+	}
+	if err != nil {
+		if refundErr := r.budgets.Refund(sender, fee); refundErr != nil {
+			logger.Error("Failed to refund the budget", "sender", sender, "fee", fee, "err", refundErr)
+		}
+		return common.Hash{}, err
+	}
+
+	relayedTxCounter.Inc(1)
+	spentFeeCounter.Inc(new(big.Int).Div(fee, big.NewInt(params.Ston)).Int64())
+	logger.Info("Relayed a fee-delegated transaction", "hash", signed.Hash(), "sender", sender, "fee", fee)
+	return signed.Hash(), nil
+}
+
+func (r *FeeRelay) signAsFeePayer(tx *types.Transaction, chainID *big.Int) (*types.Transaction, error) {
+	account := accounts.Account{Address: r.config.FeePayer}
+	wallet, err := r.am.Find(account)
+	if err != nil {
+		return nil, err
+	}
+	return wallet.SignTxAsFeePayer(account, tx, chainID)
+}
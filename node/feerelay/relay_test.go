@@ -0,0 +1,213 @@
+// Copyright 2024 The klaytn Authors
+// This file is part of the klaytn library.
+//
+// The klaytn library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The klaytn library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the klaytn library. If not, see <http://www.gnu.org/licenses/>.
+
+package feerelay
+
+import (
+	"crypto/ecdsa"
+	"errors"
+	"io/ioutil"
+	"math/big"
+	"path/filepath"
+	"testing"
+
+	"github.com/klaytn/klaytn/accounts"
+	"github.com/klaytn/klaytn/accounts/keystore"
+	"github.com/klaytn/klaytn/blockchain/state"
+	"github.com/klaytn/klaytn/blockchain/types"
+	"github.com/klaytn/klaytn/blockchain/types/accountkey"
+	"github.com/klaytn/klaytn/common"
+	"github.com/klaytn/klaytn/common/hexutil"
+	"github.com/klaytn/klaytn/consensus"
+	"github.com/klaytn/klaytn/crypto"
+	"github.com/klaytn/klaytn/params"
+	"github.com/klaytn/klaytn/storage/database"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type testBlockChain struct {
+	statedb *state.StateDB // an empty state if nil
+}
+
+func (bc *testBlockChain) Config() *params.ChainConfig { return params.TestChainConfig }
+
+func (bc *testBlockChain) CurrentBlock() *types.Block {
+	return types.NewBlockWithHeader(&types.Header{Number: big.NewInt(0), Time: big.NewInt(0), BlockScore: common.Big1})
+}
+
+func (bc *testBlockChain) State() (*state.StateDB, error) {
+	if bc.statedb != nil {
+		return bc.statedb, nil
+	}
+	return state.New(common.Hash{}, state.NewDatabase(database.NewMemoryDBManager()), nil, nil)
+}
+
+func (bc *testBlockChain) Engine() consensus.Engine { return nil }
+
+func (bc *testBlockChain) GetHeader(hash common.Hash, number uint64) *types.Header { return nil }
+
+type testTxPool struct {
+	txs []*types.Transaction
+	err error
+}
+
+func (pool *testTxPool) AddRemote(tx *types.Transaction) error {
+	if pool.err != nil {
+		return pool.err
+	}
+	pool.txs = append(pool.txs, tx)
+	return nil
+}
+
+// writeTestPolicy writes the policy to a file and returns its path.
+func writeTestPolicy(t *testing.T, policy string) string {
+	path := filepath.Join(t.TempDir(), "policy.json")
+	require.NoError(t, ioutil.WriteFile(path, []byte(policy), 0o600))
+	return path
+}
+
+func TestFeeRelay_Relay(t *testing.T) {
+	senderKey, _ := crypto.GenerateKey()
+	sender := crypto.PubkeyToAddress(senderKey.PublicKey)
+	feePayerKey, _ := crypto.GenerateKey()
+
+	ks := keystore.NewKeyStore(t.TempDir(), keystore.LightScryptN, keystore.LightScryptP)
+	feePayer, err := ks.ImportECDSA(feePayerKey, "")
+	require.NoError(t, err)
+	require.NoError(t, ks.Unlock(feePayer, ""))
+
+	cfg := &Config{EnabledFeeRelay: true, FeePayer: feePayer.Address}
+	cfg.PolicyFile = writeTestPolicy(t, `{"maxGas": 100000, "dailyBudget": "1000000000000000000"}`)
+	relay, err := newFeeRelay(cfg, accounts.NewManager(ks), "")
+	require.NoError(t, err)
+
+	signer := types.LatestSignerForChainID(params.TestChainConfig.ChainID)
+	newTx := func(nonce, gas uint64, feePayer common.Address) *types.Transaction {
+		tx, err := types.NewTransactionWithMap(types.TxTypeFeeDelegatedValueTransfer, map[types.TxValueKeyType]interface{}{
+			types.TxValueKeyNonce:    nonce,
+			types.TxValueKeyTo:       testContract,
+			types.TxValueKeyAmount:   big.NewInt(1),
+			types.TxValueKeyGasLimit: gas,
+			types.TxValueKeyGasPrice: big.NewInt(25000000000),
+			types.TxValueKeyFrom:     sender,
+			types.TxValueKeyFeePayer: feePayer,
+		})
+		require.NoError(t, err)
+		require.NoError(t, tx.SignWithKeys(signer, []*ecdsa.PrivateKey{senderKey}))
+		return tx
+	}
+
+	// not ready before the components are set
+	_, err = relay.Relay(newTx(0, 21000, feePayer.Address))
+	assert.Equal(t, errNotReady, err)
+
+	pool := &testTxPool{}
+	relay.blockchain, relay.txPool = &testBlockChain{}, pool
+
+	hash, err := relay.Relay(newTx(0, 21000, feePayer.Address))
+	require.NoError(t, err)
+	require.Len(t, pool.txs, 1)
+	assert.Equal(t, hash, pool.txs[0].Hash())
+	statedb, _ := relay.blockchain.State()
+	_, err = pool.txs[0].ValidateFeePayer(signer, statedb, 0)
+	require.NoError(t, err)
+	assert.Equal(t, feePayer.Address, pool.txs[0].ValidatedFeePayer())
+	assert.Equal(t, big.NewInt(21000*25000000000), relay.budgets.Spent(sender))
+
+	// the fee payer of the transaction must be the relay's fee payer
+	_, err = relay.Relay(newTx(1, 21000, testFeePayer))
+	assert.Equal(t, errFeePayerMismatch, err)
+
+	// the transaction must be allowed by the policy
+	_, err = relay.Relay(newTx(1, 100001, feePayer.Address))
+	assert.True(t, errors.Is(err, errGasTooLarge))
+
+	// the sender must sign the transaction
+	unsigned := newTx(1, 21000, feePayer.Address)
+	require.NoError(t, unsigned.SignWithKeys(signer, []*ecdsa.PrivateKey{feePayerKey}))
+	_, err = relay.Relay(unsigned)
+	assert.Error(t, err)
+
+	// the budget is refunded if the transaction is not submitted
+	pool.err = errors.New("nonce too low")
+	_, err = relay.Relay(newTx(0, 21000, feePayer.Address))
+	assert.Equal(t, pool.err, err)
+	assert.Equal(t, big.NewInt(21000*25000000000), relay.budgets.Spent(sender))
+	assert.Len(t, pool.txs, 1)
+}
+
+func TestFeeRelay_PolicyNotLimited(t *testing.T) {
+	cfg := &Config{EnabledFeeRelay: true, FeePayer: testFeePayer}
+	_, err := newFeeRelay(cfg, nil, "")
+	assert.Equal(t, errPolicyNotLimited, err)
+
+	for _, policy := range []string{`{}`, `{"maxGas": 100000}`, `{"dailyBudget": "0"}`, `{"senderBudgets": {"0x0000000000000000000000000000000000001234": "1"}}`} {
+		cfg.PolicyFile = writeTestPolicy(t, policy)
+		_, err = newFeeRelay(cfg, nil, "")
+		assert.Equal(t, errPolicyNotLimited, err, policy)
+	}
+	for _, policy := range []string{`{"dailyBudget": "1"}`, `{"allowedContracts": [{"address": "0x000000000000000000000000000000000000c0de"}]}`} {
+		cfg.PolicyFile = writeTestPolicy(t, policy)
+		_, err = newFeeRelay(cfg, nil, "")
+		assert.NoError(t, err, policy)
+	}
+}
+
+// The sender with AccountKeyContract is validated by its validator contract like in the transaction pool.
+func TestFeeRelay_ContractKeySender(t *testing.T) {
+	senderKey, _ := crypto.GenerateKey()
+	sender := crypto.PubkeyToAddress(senderKey.PublicKey)
+	feePayerKey, _ := crypto.GenerateKey()
+
+	ks := keystore.NewKeyStore(t.TempDir(), keystore.LightScryptN, keystore.LightScryptP)
+	feePayer, err := ks.ImportECDSA(feePayerKey, "")
+	require.NoError(t, err)
+	require.NoError(t, ks.Unlock(feePayer, ""))
+
+	cfg := &Config{EnabledFeeRelay: true, FeePayer: feePayer.Address}
+	cfg.PolicyFile = writeTestPolicy(t, `{"dailyBudget": "1000000000000000000"}`)
+	relay, err := newFeeRelay(cfg, accounts.NewManager(ks), "")
+	require.NoError(t, err)
+
+	// The validator returns the EIP-1271 magic value for any input.
+	statedb, err := state.New(common.Hash{}, state.NewDatabase(database.NewMemoryDBManager()), nil, nil)
+	require.NoError(t, err)
+	validator := common.HexToAddress("0x0000000000000000000000000000000000000a01")
+	statedb.CreateSmartContractAccount(validator, params.CodeFormatEVM, params.Rules{IsIstanbul: true})
+	statedb.SetCode(validator, hexutil.MustDecode("0x631626ba7e60e01b60005260206000f3"))
+	statedb.CreateEOA(sender, false, accountkey.NewAccountKeyContractWithValue(validator))
+
+	pool := &testTxPool{}
+	relay.blockchain, relay.txPool = &testBlockChain{statedb: statedb}, pool
+
+	signer := types.LatestSignerForChainID(params.TestChainConfig.ChainID)
+	tx, err := types.NewTransactionWithMap(types.TxTypeFeeDelegatedValueTransfer, map[types.TxValueKeyType]interface{}{
+		types.TxValueKeyNonce:    uint64(0),
+		types.TxValueKeyTo:       testContract,
+		types.TxValueKeyAmount:   big.NewInt(1),
+		types.TxValueKeyGasLimit: uint64(100000),
+		types.TxValueKeyGasPrice: big.NewInt(25000000000),
+		types.TxValueKeyFrom:     sender,
+		types.TxValueKeyFeePayer: feePayer.Address,
+	})
+	require.NoError(t, err)
+	require.NoError(t, tx.SignWithKeys(signer, []*ecdsa.PrivateKey{senderKey}))
+
+	_, err = relay.Relay(tx)
+	require.NoError(t, err)
+	assert.Len(t, pool.txs, 1)
+}
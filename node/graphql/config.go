@@ -0,0 +1,37 @@
+// Copyright 2024 The klaytn Authors
+// This file is part of the klaytn library.
+//
+// The klaytn library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The klaytn library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the klaytn library. If not, see <http://www.gnu.org/licenses/>.
+
+package graphql
+
+// Config is the configuration of the GraphQL service.
+type Config struct {
+	EnabledGraphQL bool
+
+	// MaxQueryCost is the maximum cost of a query. The cost of a query is the number of
+	// the fields to be resolved, estimated with the sizes of the requested lists.
+	MaxQueryCost int
+
+	// MaxQueryDepth is the maximum depth of the selections of a query.
+	MaxQueryDepth int
+}
+
+func DefaultConfig() *Config {
+	return &Config{
+		EnabledGraphQL: false,
+		MaxQueryCost:   50000,
+		MaxQueryDepth:  10,
+	}
+}
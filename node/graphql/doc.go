@@ -0,0 +1,47 @@
+// Copyright 2024 The klaytn Authors
+// This file is part of the klaytn library.
+//
+// The klaytn library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The klaytn library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the klaytn library. If not, see <http://www.gnu.org/licenses/>.
+
+/*
+Package graphql implements the GraphQL service of Klaytn.
+
+The service serves GraphQL queries at /graphql of the HTTP RPC endpoint, and its schema at /graphql/schema.
+A query is given by the query, variables and operationName parameters of a GET request, or by the JSON
+body of a POST request. The schema covers blocks, transactions, receipts, logs and accounts, including
+the Klaytn specifics such as the transaction types, the fee payer and the fee ratio of fee delegated
+transactions, account keys, anchored data, and the committee and the council of Istanbul.
+The queries are resolved through the API backend of the CN, so that they return the same data as
+the JSON-RPC APIs.
+
+Since a query can request a large amount of data at once, it is rejected before execution if its depth
+or its estimated cost exceeds the configured limits. The cost of a query is the number of the fields to
+be resolved, where the fields of a list are multiplied by the size of the list. The size of a list of
+blocks is given by its block range, and the size of other lists is assumed to be 100.
+
+Only queries are supported. Mutations and subscriptions are not supported, and the introspection queries
+are replaced by the schema served at /graphql/schema.
+
+Source Files
+
+  - config.go	: Defines the configuration of the GraphQL service
+  - exec.go	: Validates and executes queries against the schema
+  - graphql.go	: Provides `GraphQL` service serving the GraphQL HTTP handlers
+  - metrics.go	: Defines the metrics of the GraphQL service
+  - query.go	: Provides the lexer and the parser of queries
+  - resolver.go	: Provides the resolvers of the Klaytn types through the API backend
+  - schema.go	: Provides the parser of the schema definition
+  - sdl.go	: Defines the schema of the GraphQL service
+*/
+package graphql
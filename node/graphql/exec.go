@@ -0,0 +1,642 @@
+// Copyright 2024 The klaytn Authors
+// This file is part of the klaytn library.
+//
+// The klaytn library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The klaytn library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the klaytn library. If not, see <http://www.gnu.org/licenses/>.
+
+package graphql
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"math/big"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"github.com/klaytn/klaytn/common"
+	"github.com/klaytn/klaytn/common/hexutil"
+)
+
+// defaultListSize is the expected length of a list field used to estimate the cost of a query,
+// unless the length is given by a block range.
+const defaultListSize = 100
+
+var (
+	errNoOperation        = errors.New("no operation is selected")
+	errAmbiguousOperation = errors.New("operation name is required for a document with multiple operations")
+)
+
+// object is a value of an object type of the schema, which resolves its fields.
+type object interface {
+	resolve(ctx context.Context, field string, args map[string]interface{}) (interface{}, error)
+}
+
+// request is a GraphQL request sent over HTTP.
+type request struct {
+	Query         string                 `json:"query"`
+	OperationName string                 `json:"operationName"`
+	Variables     map[string]interface{} `json:"variables"`
+}
+
+type queryError struct {
+	Message string        `json:"message"`
+	Path    []interface{} `json:"path,omitempty"`
+}
+
+// response is the result of a GraphQL request. Data is not set if the request is not executed.
+type response struct {
+	Data   *orderedObject `json:"data,omitempty"`
+	Errors []*queryError  `json:"errors,omitempty"`
+}
+
+func errorResponse(err error) *response {
+	return &response{Errors: []*queryError{{Message: err.Error()}}}
+}
+
+// orderedObject is a JSON object which keeps the order of the fields in the query.
+type orderedObject struct {
+	keys   []string
+	values []interface{}
+}
+
+func (o *orderedObject) add(key string, value interface{}) {
+	o.keys = append(o.keys, key)
+	o.values = append(o.values, value)
+}
+
+func (o *orderedObject) get(key string) interface{} {
+	for i, k := range o.keys {
+		if k == key {
+			return o.values[i]
+		}
+	}
+	return nil
+}
+
+func (o *orderedObject) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	buf.WriteByte('{')
+	for i, key := range o.keys {
+		if i > 0 {
+			buf.WriteByte(',')
+		}
+		k, _ := json.Marshal(key)
+		v, err := json.Marshal(o.values[i])
+		if err != nil {
+			return nil, err
+		}
+		buf.Write(k)
+		buf.WriteByte(':')
+		buf.Write(v)
+	}
+	buf.WriteByte('}')
+	return buf.Bytes(), nil
+}
+
+// limits are the limits of the queries checked before they are executed.
+type limits struct {
+	maxCost  int64
+	maxDepth int
+	head     uint64 // the latest block number, used for the block ranges without an end
+}
+
+// execute validates the request against the schema and the limits, and executes the selected
+// operation on the root object.
+func (s *schema) execute(ctx context.Context, root object, req *request, lim limits) *response {
+	doc, err := parseQuery(req.Query)
+	if err != nil {
+		return errorResponse(err)
+	}
+	op, err := doc.operation(req.OperationName)
+	if err != nil {
+		return errorResponse(err)
+	}
+	vars, err := op.variableValues(req.Variables)
+	if err != nil {
+		return errorResponse(err)
+	}
+
+	v := &validator{schema: s, doc: doc, vars: vars, limits: lim}
+	cost, err := v.selectionSet(s.query, op.sels, 1, map[string]bool{})
+	if err != nil {
+		return errorResponse(err)
+	}
+	if cost > lim.maxCost {
+		rejectedQueryCounter.Inc(1)
+		return errorResponse(fmt.Errorf("query cost %d exceeds the limit %d", cost, lim.maxCost))
+	}
+	queryCostHistogram.Update(cost)
+
+	e := &execution{ctx: ctx, schema: s, doc: doc, vars: vars}
+	data := e.selectObject(root, s.query, op.sels, nil)
+	return &response{Data: data, Errors: e.errors}
+}
+
+// operation returns the operation to be executed.
+func (doc *document) operation(name string) (*operation, error) {
+	if name == "" {
+		if len(doc.operations) > 1 {
+			return nil, errAmbiguousOperation
+		}
+		return doc.operations[0], nil
+	}
+	for _, op := range doc.operations {
+		if op.name == name {
+			return op, nil
+		}
+	}
+	return nil, errNoOperation
+}
+
+// variableValues returns the values of the variables of the operation. The values are coerced
+// when they are used as arguments.
+func (op *operation) variableValues(given map[string]interface{}) (map[string]interface{}, error) {
+	vars := make(map[string]interface{}, len(op.vars))
+	for _, def := range op.vars {
+		value, ok := given[def.name]
+		switch {
+		case ok:
+			vars[def.name] = value
+		case def.hasDef:
+			vars[def.name] = def.def
+		case def.typ.nonNull:
+			return nil, fmt.Errorf("variable $%s of required type %s is not provided", def.name, def.typ)
+		default:
+			vars[def.name] = nil
+		}
+	}
+	return vars, nil
+}
+
+// validator checks the selections of an operation against the schema, and estimates the cost of it.
+type validator struct {
+	schema *schema
+	doc    *document
+	vars   map[string]interface{}
+	limits
+}
+
+func (v *validator) selectionSet(t *typeDef, sels []*selection, depth int, visiting map[string]bool) (int64, error) {
+	if depth > v.maxDepth {
+		rejectedQueryCounter.Inc(1)
+		return 0, fmt.Errorf("query depth exceeds the limit %d", v.maxDepth)
+	}
+	var cost int64
+	for _, sel := range sels {
+		if ok, err := v.schema.included(sel.dirs, v.vars); err != nil {
+			return 0, err
+		} else if !ok {
+			continue
+		}
+
+		var (
+			c   int64
+			err error
+		)
+		switch {
+		case sel.spread != "":
+			f, ok := v.doc.fragments[sel.spread]
+			if !ok {
+				return 0, fmt.Errorf("fragment %q is not defined", sel.spread)
+			}
+			if f.on != t.name {
+				return 0, fmt.Errorf("fragment %q on %q can not be spread on %q", f.name, f.on, t.name)
+			}
+			if visiting[f.name] {
+				return 0, fmt.Errorf("fragment %q spreads itself", f.name)
+			}
+			visiting[f.name] = true
+			c, err = v.selectionSet(t, f.sels, depth, visiting)
+			delete(visiting, f.name)
+		case sel.inline:
+			if sel.on != "" && sel.on != t.name {
+				return 0, fmt.Errorf("inline fragment on %q can not be spread on %q", sel.on, t.name)
+			}
+			c, err = v.selectionSet(t, sel.sels, depth, visiting)
+		default:
+			c, err = v.field(t, sel, depth, visiting)
+		}
+		if err != nil {
+			return 0, err
+		}
+		cost = addCost(cost, c)
+	}
+	return cost, nil
+}
+
+func (v *validator) field(t *typeDef, sel *selection, depth int, visiting map[string]bool) (int64, error) {
+	if sel.name == "__typename" {
+		if sel.sels != nil {
+			return 0, fmt.Errorf("field \"__typename\" must not have a selection")
+		}
+		return 0, nil
+	}
+	f, ok := t.fields[sel.name]
+	if !ok {
+		return 0, fmt.Errorf("cannot query field %q on type %q", sel.name, t.name)
+	}
+	args, err := v.schema.coerceArgs(f, sel.args, v.vars)
+	if err != nil {
+		return 0, err
+	}
+	ft := v.schema.types[f.typ.namedType()]
+	if ft.kind != kindObject {
+		if sel.sels != nil {
+			return 0, fmt.Errorf("field %q of type %q must not have a selection", sel.name, f.typ)
+		}
+		return 1, nil
+	}
+	if sel.sels == nil {
+		return 0, fmt.Errorf("field %q of type %q must have a selection", sel.name, f.typ)
+	}
+	cost, err := v.selectionSet(ft, sel.sels, depth+1, visiting)
+	if err != nil {
+		return 0, err
+	}
+	if f.typ.elem != nil {
+		cost = mulCost(cost, v.listSize(args))
+	}
+	return addCost(1, cost), nil
+}
+
+// listSize returns the expected length of a list field. The length of a list of blocks is
+// given by the block range, which ends at the latest block by default.
+func (v *validator) listSize(args map[string]interface{}) int64 {
+	from, ok := args["from"].(int64)
+	if !ok {
+		return defaultListSize
+	}
+	to := int64(v.head)
+	if end, ok := args["to"].(int64); ok {
+		to = end
+	}
+	if to < from {
+		return 1
+	}
+	return to - from + 1
+}
+
+func addCost(a, b int64) int64 {
+	if a > math.MaxInt64-b {
+		return math.MaxInt64
+	}
+	return a + b
+}
+
+func mulCost(a, b int64) int64 {
+	if b != 0 && a > math.MaxInt64/b {
+		return math.MaxInt64
+	}
+	return a * b
+}
+
+// included evaluates the @skip and @include directives.
+func (s *schema) included(dirs []*directive, vars map[string]interface{}) (bool, error) {
+	for _, d := range dirs {
+		if d.name != "skip" && d.name != "include" {
+			return false, fmt.Errorf("directive @%s is not supported", d.name)
+		}
+		if len(d.args) != 1 || d.args[0].name != "if" {
+			return false, fmt.Errorf("directive @%s requires the argument \"if\"", d.name)
+		}
+		cond, err := s.coerceValue(&typeRef{name: "Boolean", nonNull: true}, d.args[0].value, vars)
+		if err != nil {
+			return false, err
+		}
+		if cond.(bool) == (d.name == "skip") {
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
+// coerceArgs returns the values of the arguments of the field coerced to their types.
+func (s *schema) coerceArgs(f *fieldDef, args []*argument, vars map[string]interface{}) (map[string]interface{}, error) {
+	values := make(map[string]interface{}, len(f.args))
+	for _, arg := range args {
+		def, ok := f.args[arg.name]
+		if !ok {
+			return nil, fmt.Errorf("unknown argument %q of field %q", arg.name, f.name)
+		}
+		if _, exist := values[arg.name]; exist {
+			return nil, fmt.Errorf("argument %q of field %q is given more than once", arg.name, f.name)
+		}
+		value, err := s.coerceValue(def.typ, arg.value, vars)
+		if err != nil {
+			return nil, fmt.Errorf("argument %q of field %q: %w", arg.name, f.name, err)
+		}
+		values[arg.name] = value
+	}
+	for name, def := range f.args {
+		if _, ok := values[name]; ok {
+			continue
+		}
+		if def.hasDef {
+			value, err := s.coerceValue(def.typ, def.def, vars)
+			if err != nil {
+				return nil, err
+			}
+			values[name] = value
+		} else if def.typ.nonNull {
+			return nil, fmt.Errorf("argument %q of field %q of required type %s is not provided", name, f.name, def.typ)
+		}
+	}
+	return values, nil
+}
+
+// coerceValue coerces a literal or a JSON value to the input type.
+func (s *schema) coerceValue(t *typeRef, v interface{}, vars map[string]interface{}) (interface{}, error) {
+	if name, ok := v.(variable); ok {
+		value, ok := vars[string(name)]
+		if !ok {
+			return nil, fmt.Errorf("variable $%s is not defined", name)
+		}
+		v = value
+	}
+	if v == nil {
+		if t.nonNull {
+			return nil, fmt.Errorf("expected %s, found null", t)
+		}
+		return nil, nil
+	}
+	if t.elem != nil {
+		list, ok := v.([]interface{})
+		if !ok {
+			item, err := s.coerceValue(t.elem, v, vars)
+			if err != nil {
+				return nil, err
+			}
+			return []interface{}{item}, nil
+		}
+		values := make([]interface{}, len(list))
+		for i, item := range list {
+			value, err := s.coerceValue(t.elem, item, vars)
+			if err != nil {
+				return nil, err
+			}
+			values[i] = value
+		}
+		return values, nil
+	}
+
+	td := s.types[t.name]
+	switch td.kind {
+	case kindEnum:
+		var value string
+		switch e := v.(type) {
+		case enumValue:
+			value = string(e)
+		case string:
+			value = e
+		}
+		if !td.values[value] {
+			return nil, fmt.Errorf("invalid %s value %v", td.name, v)
+		}
+		return value, nil
+	case kindInput:
+		obj, ok := v.(map[string]interface{})
+		if !ok {
+			return nil, fmt.Errorf("expected %s, found %v", td.name, v)
+		}
+		for name := range obj {
+			if _, ok := td.fields[name]; !ok {
+				return nil, fmt.Errorf("unknown field %q of input type %q", name, td.name)
+			}
+		}
+		values := make(map[string]interface{}, len(td.fields))
+		for name, f := range td.fields {
+			value, ok := obj[name]
+			if !ok {
+				if !f.hasDef {
+					if f.typ.nonNull {
+						return nil, fmt.Errorf("field %q of input type %q is not provided", name, td.name)
+					}
+					continue
+				}
+				value = f.def
+			}
+			coerced, err := s.coerceValue(f.typ, value, vars)
+			if err != nil {
+				return nil, fmt.Errorf("field %q of input type %q: %w", name, td.name, err)
+			}
+			values[name] = coerced
+		}
+		return values, nil
+	}
+	return coerceScalar(td.name, v)
+}
+
+// coerceScalar coerces a literal or a JSON value to the scalar type.
+// JSON numbers are decoded into json.Number or float64.
+func coerceScalar(name string, v interface{}) (interface{}, error) {
+	if f, ok := v.(float64); ok {
+		v = json.Number(strconv.FormatFloat(f, 'f', -1, 64))
+	}
+	invalid := fmt.Errorf("invalid %s value %v", name, v)
+	switch name {
+	case "Int":
+		if n, ok := v.(json.Number); ok {
+			if i, err := strconv.ParseInt(string(n), 10, 32); err == nil {
+				return int(i), nil
+			}
+		}
+	case "Float":
+		if n, ok := v.(json.Number); ok {
+			if f, err := n.Float64(); err == nil {
+				return f, nil
+			}
+		}
+	case "String", "ID":
+		if str, ok := v.(string); ok {
+			return str, nil
+		}
+	case "Boolean":
+		if b, ok := v.(bool); ok {
+			return b, nil
+		}
+	case "Long":
+		switch n := v.(type) {
+		case json.Number:
+			if i, err := strconv.ParseInt(string(n), 10, 64); err == nil {
+				return i, nil
+			}
+		case string:
+			if strings.HasPrefix(n, "0x") {
+				if i, err := strconv.ParseInt(n[2:], 16, 64); err == nil {
+					return i, nil
+				}
+			} else if i, err := strconv.ParseInt(n, 10, 64); err == nil {
+				return i, nil
+			}
+		}
+	case "BigInt":
+		var str string
+		switch n := v.(type) {
+		case json.Number:
+			str = string(n)
+		case string:
+			str = n
+		}
+		base := 10
+		if strings.HasPrefix(str, "0x") {
+			str, base = str[2:], 16
+		}
+		if i, ok := new(big.Int).SetString(str, base); ok {
+			return i, nil
+		}
+	case "Bytes":
+		if str, ok := v.(string); ok {
+			if b, err := hexutil.Decode(str); err == nil {
+				return b, nil
+			}
+		}
+	case "Bytes32":
+		if str, ok := v.(string); ok {
+			if b, err := hexutil.Decode(str); err == nil && len(b) == common.HashLength {
+				return common.BytesToHash(b), nil
+			}
+		}
+	case "Address":
+		if str, ok := v.(string); ok && common.IsHexAddress(str) && strings.HasPrefix(str, "0x") {
+			return common.HexToAddress(str), nil
+		}
+	default:
+		return nil, fmt.Errorf("unknown scalar type %q", name)
+	}
+	return nil, invalid
+}
+
+// execution executes the selections of an operation on the resolvers.
+type execution struct {
+	ctx    context.Context
+	schema *schema
+	doc    *document
+	vars   map[string]interface{}
+	errors []*queryError
+}
+
+func (e *execution) addError(err error, path []interface{}) {
+	e.errors = append(e.errors, &queryError{Message: err.Error(), Path: path})
+}
+
+// collectFields groups the fields of the selections by their response keys in order.
+func (e *execution) collectFields(sels []*selection, keys *[]string, groups map[string][]*selection) {
+	for _, sel := range sels {
+		if ok, _ := e.schema.included(sel.dirs, e.vars); !ok {
+			continue
+		}
+		switch {
+		case sel.spread != "":
+			e.collectFields(e.doc.fragments[sel.spread].sels, keys, groups)
+		case sel.inline:
+			e.collectFields(sel.sels, keys, groups)
+		default:
+			key := sel.key()
+			if _, ok := groups[key]; !ok {
+				*keys = append(*keys, key)
+			}
+			groups[key] = append(groups[key], sel)
+		}
+	}
+}
+
+func (e *execution) selectObject(obj object, t *typeDef, sels []*selection, path []interface{}) *orderedObject {
+	var keys []string
+	groups := make(map[string][]*selection)
+	e.collectFields(sels, &keys, groups)
+
+	result := new(orderedObject)
+	for _, key := range keys {
+		fields := groups[key]
+		sel := fields[0]
+		fieldPath := append(append([]interface{}{}, path...), key)
+
+		if sel.name == "__typename" {
+			result.add(key, t.name)
+			continue
+		}
+		if err := e.ctx.Err(); err != nil {
+			e.addError(err, fieldPath)
+			result.add(key, nil)
+			continue
+		}
+		f := t.fields[sel.name]
+		args, err := e.schema.coerceArgs(f, sel.args, e.vars)
+		if err != nil {
+			e.addError(err, fieldPath)
+			result.add(key, nil)
+			continue
+		}
+		value, err := obj.resolve(e.ctx, sel.name, args)
+		if err != nil {
+			e.addError(err, fieldPath)
+			result.add(key, nil)
+			continue
+		}
+		var subSels []*selection
+		for _, field := range fields {
+			subSels = append(subSels, field.sels...)
+		}
+		result.add(key, e.complete(f.typ, value, subSels, fieldPath))
+	}
+	return result
+}
+
+// complete completes the resolved value of a field. The values of the object types are resolved
+// by their selections, and the values of the scalar types are marshalled as they are.
+func (e *execution) complete(t *typeRef, value interface{}, sels []*selection, path []interface{}) interface{} {
+	if isNil(value) {
+		return nil
+	}
+	td := e.schema.types[t.namedType()]
+	if td.kind != kindObject {
+		return value
+	}
+	if t.elem != nil {
+		items, ok := value.([]object)
+		if !ok {
+			e.addError(fmt.Errorf("unexpected value of type %q", t), path)
+			return nil
+		}
+		results := make([]interface{}, len(items))
+		for i, item := range items {
+			results[i] = e.complete(t.elem, item, sels, append(append([]interface{}{}, path...), i))
+		}
+		return results
+	}
+	obj, ok := value.(object)
+	if !ok {
+		e.addError(fmt.Errorf("unexpected value of type %q", t), path)
+		return nil
+	}
+	return e.selectObject(obj, td, sels, path)
+}
+
+func isNil(value interface{}) bool {
+	if value == nil {
+		return true
+	}
+	switch v := reflect.ValueOf(value); v.Kind() {
+	case reflect.Ptr, reflect.Map, reflect.Interface:
+		return v.IsNil()
+	case reflect.Slice:
+		_, isObjects := value.([]object)
+		return isObjects && v.IsNil()
+	}
+	return false
+}
@@ -0,0 +1,177 @@
+// Copyright 2024 The klaytn Authors
+// This file is part of the klaytn library.
+//
+// The klaytn library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The klaytn library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the klaytn library. If not, see <http://www.gnu.org/licenses/>.
+
+package graphql
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testSDL = `
+schema { query: Query }
+
+scalar Long
+
+type Query {
+    item(id: Int!): Item
+    items(from: Long!, to: Long): [Item!]!
+    tags: [String!]!
+}
+
+"""Item is a test object."""
+type Item {
+    id: Int!
+    name: String!
+    child: Item
+}
+`
+
+type testRoot struct{}
+
+func (r *testRoot) resolve(ctx context.Context, field string, args map[string]interface{}) (interface{}, error) {
+	switch field {
+	case "item":
+		id := args["id"].(int)
+		if id < 0 {
+			return nil, fmt.Errorf("invalid id %d", id)
+		}
+		return &testItem{id: id}, nil
+	case "items":
+		var items []object
+		for id := args["from"].(int64); id <= args["to"].(int64); id++ {
+			items = append(items, &testItem{id: int(id)})
+		}
+		return items, nil
+	case "tags":
+		return []string{"a", "b"}, nil
+	}
+	return nil, fmt.Errorf("unknown field %q", field)
+}
+
+type testItem struct {
+	id int
+}
+
+func (i *testItem) resolve(ctx context.Context, field string, args map[string]interface{}) (interface{}, error) {
+	switch field {
+	case "id":
+		return i.id, nil
+	case "name":
+		return fmt.Sprintf("item%d", i.id), nil
+	case "child":
+		if i.id == 0 {
+			return (*testItem)(nil), nil
+		}
+		return &testItem{id: i.id - 1}, nil
+	}
+	return nil, fmt.Errorf("unknown field %q", field)
+}
+
+func executeTest(t *testing.T, query string, vars map[string]interface{}, lim limits) string {
+	s, err := parseSchema(testSDL)
+	require.NoError(t, err)
+
+	res := s.execute(context.Background(), &testRoot{}, &request{Query: query, Variables: vars}, lim)
+	encoded, err := json.Marshal(res)
+	require.NoError(t, err)
+	return string(encoded)
+}
+
+var testLimits = limits{maxCost: 1000, maxDepth: 5, head: 10}
+
+func TestExecute(t *testing.T) {
+	testCases := []struct {
+		query    string
+		vars     map[string]interface{}
+		expected string
+	}{
+		{
+			`{ item(id: 2) { id name child { id child { id child { id } } } } }`,
+			nil,
+			`{"data":{"item":{"id":2,"name":"item2","child":{"id":1,"child":{"id":0,"child":null}}}}}`,
+		},
+		{
+			`query Q($id: Int!) { first: item(id: $id) { ...parts } second: item(id: 1) { ... on Item { id } } }
+			fragment parts on Item { id name }`,
+			map[string]interface{}{"id": json.Number("3")},
+			`{"data":{"first":{"id":3,"name":"item3"},"second":{"id":1}}}`,
+		},
+		{
+			`query($skip: Boolean = true) { item(id: 1) { id name @skip(if: $skip) __typename } tags }`,
+			nil,
+			`{"data":{"item":{"id":1,"__typename":"Item"},"tags":["a","b"]}}`,
+		},
+		{
+			`{ items(from: 1, to: "0x3") { id } }`,
+			nil,
+			`{"data":{"items":[{"id":1},{"id":2},{"id":3}]}}`,
+		},
+		{
+			`{ item(id: -1) { id } tags }`,
+			nil,
+			`{"data":{"item":null,"tags":["a","b"]},"errors":[{"message":"invalid id -1","path":["item"]}]}`,
+		},
+	}
+	for _, tc := range testCases {
+		assert.Equal(t, tc.expected, executeTest(t, tc.query, tc.vars, testLimits), tc.query)
+	}
+}
+
+func TestExecute_Invalid(t *testing.T) {
+	testCases := []struct {
+		query   string
+		vars    map[string]interface{}
+		maxCost int64
+		err     string
+	}{
+		{`{ item(id: 1) { unknown } }`, nil, 0, `cannot query field \"unknown\" on type \"Item\"`},
+		{`{ item(id: 1) }`, nil, 0, `field \"item\" of type \"Item\" must have a selection`},
+		{`{ tags { id } }`, nil, 0, `field \"tags\" of type \"[String!]!\" must not have a selection`},
+		{`{ item(id: "1") { id } }`, nil, 0, `Int`},
+		{`query($id: Int!) { item(id: $id) { id } }`, nil, 0, `variable $id of required type Int! is not provided`},
+		{`{ item(id: 1) { ...f } } fragment f on Item { child { ...f } }`, nil, 0, `fragment \"f\" spreads itself`},
+		{`mutation { item(id: 1) { id } }`, nil, 0, `mutation`},
+		{`{ item(id: 1) { child { child { child { child { child { id } } } } } } }`, nil, 0, `query depth exceeds the limit 5`},
+		{`{ items(from: 0) { id name } }`, nil, 20, `query cost 23 exceeds the limit 20`},
+	}
+	for _, tc := range testCases {
+		lim := testLimits
+		if tc.maxCost != 0 {
+			lim.maxCost = tc.maxCost
+		}
+		res := executeTest(t, tc.query, tc.vars, lim)
+		assert.Contains(t, res, `"errors":[{"message":"`, tc.query)
+		assert.Contains(t, res, tc.err, tc.query)
+		assert.NotContains(t, res, `"data"`, tc.query)
+	}
+}
+
+func TestSchemaSDL(t *testing.T) {
+	s, err := parseSchema(SchemaSDL)
+	require.NoError(t, err)
+
+	for _, name := range []string{"Block", "Transaction", "Log", "Account", "AccountKey", "AnchoringData"} {
+		assert.Equal(t, kindObject, s.types[name].kind, name)
+	}
+	assert.Contains(t, s.types["Transaction"].fields, "feePayer")
+	assert.Contains(t, s.types["Block"].fields, "committee")
+}
@@ -0,0 +1,165 @@
+// Copyright 2024 The klaytn Authors
+// This file is part of the klaytn library.
+//
+// The klaytn library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The klaytn library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the klaytn library. If not, see <http://www.gnu.org/licenses/>.
+
+package graphql
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"net/http"
+
+	"github.com/klaytn/klaytn/common"
+	"github.com/klaytn/klaytn/log"
+	"github.com/klaytn/klaytn/networks/p2p"
+	"github.com/klaytn/klaytn/networks/rpc"
+	"github.com/klaytn/klaytn/node"
+)
+
+var logger = log.NewModuleLogger(log.NodeGraphQL)
+
+// GraphQL is a service serving the GraphQL endpoint at /graphql of the HTTP RPC endpoint.
+// The queries are resolved through the API backend of the CN.
+type GraphQL struct {
+	config *Config
+	schema *schema
+
+	backend  Backend
+	istanbul istanbulAPI
+}
+
+// NewGraphQL creates a GraphQL service with the given configuration.
+func NewGraphQL(ctx *node.ServiceContext, cfg *Config) (*GraphQL, error) {
+	return newGraphQL(cfg)
+}
+
+func newGraphQL(cfg *Config) (*GraphQL, error) {
+	s, err := parseSchema(SchemaSDL)
+	if err != nil {
+		return nil, err
+	}
+	return &GraphQL{config: cfg, schema: s}, nil
+}
+
+func (g *GraphQL) Protocols() []p2p.Protocol {
+	return []p2p.Protocol{}
+}
+
+func (g *GraphQL) APIs() []rpc.API {
+	return []rpc.API{}
+}
+
+func (g *GraphQL) Start(server p2p.Server) error {
+	logger.Info("GraphQL service is started", "maxCost", g.config.MaxQueryCost, "maxDepth", g.config.MaxQueryDepth)
+	return nil
+}
+
+func (g *GraphQL) Stop() error {
+	logger.Info("GraphQL service is stopped")
+	return nil
+}
+
+func (g *GraphQL) Components() []interface{} {
+	return nil
+}
+
+func (g *GraphQL) SetComponents(components []interface{}) {
+	for _, component := range components {
+		switch c := component.(type) {
+		case Backend:
+			g.backend = c
+		case []rpc.API:
+			for _, api := range c {
+				if istanbul, ok := api.Service.(istanbulAPI); ok {
+					g.istanbul = istanbul
+				}
+			}
+		}
+	}
+}
+
+// HTTPHandlers returns the GraphQL handler and the handler serving the schema.
+func (g *GraphQL) HTTPHandlers() map[string]http.Handler {
+	return map[string]http.Handler{
+		"/graphql":        http.HandlerFunc(g.serveQuery),
+		"/graphql/schema": http.HandlerFunc(g.serveSchema),
+	}
+}
+
+func (g *GraphQL) serveSchema(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+	io.WriteString(w, SchemaSDL)
+}
+
+// serveQuery serves a query given by the parameters of a GET request or by the JSON body of a POST request.
+func (g *GraphQL) serveQuery(w http.ResponseWriter, r *http.Request) {
+	var req request
+	switch r.Method {
+	case http.MethodGet:
+		params := r.URL.Query()
+		req.Query = params.Get("query")
+		req.OperationName = params.Get("operationName")
+		if vars := params.Get("variables"); vars != "" {
+			if err := decodeJSON([]byte(vars), &req.Variables); err != nil {
+				writeResponse(w, http.StatusBadRequest, errorResponse(err))
+				return
+			}
+		}
+	case http.MethodPost:
+		body, err := io.ReadAll(io.LimitReader(r.Body, int64(common.MaxRequestContentLength)))
+		if err != nil {
+			writeResponse(w, http.StatusBadRequest, errorResponse(err))
+			return
+		}
+		if err := decodeJSON(body, &req); err != nil {
+			writeResponse(w, http.StatusBadRequest, errorResponse(err))
+			return
+		}
+	default:
+		w.Header().Set("Allow", "GET, POST")
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	if g.backend == nil {
+		http.Error(w, "the GraphQL service is not ready", http.StatusServiceUnavailable)
+		return
+	}
+
+	queryCounter.Inc(1)
+	lim := limits{
+		maxCost:  int64(g.config.MaxQueryCost),
+		maxDepth: g.config.MaxQueryDepth,
+		head:     g.backend.CurrentBlock().NumberU64(),
+	}
+	res := g.schema.execute(r.Context(), &resolver{backend: g.backend, istanbul: g.istanbul}, &req, lim)
+	writeResponse(w, http.StatusOK, res)
+}
+
+// decodeJSON decodes the JSON keeping the numbers as json.Number, so that Long and BigInt
+// variables are not rounded.
+func decodeJSON(data []byte, v interface{}) error {
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+	return dec.Decode(v)
+}
+
+func writeResponse(w http.ResponseWriter, status int, res *response) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	if err := json.NewEncoder(w).Encode(res); err != nil {
+		logger.Debug("Failed to write a GraphQL response", "err", err)
+	}
+}
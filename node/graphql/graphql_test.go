@@ -0,0 +1,115 @@
+// Copyright 2024 The klaytn Authors
+// This file is part of the klaytn library.
+//
+// The klaytn library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The klaytn library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the klaytn library. If not, see <http://www.gnu.org/licenses/>.
+
+package graphql
+
+import (
+	"context"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/klaytn/klaytn/blockchain/types"
+	"github.com/klaytn/klaytn/blockchain/types/accountkey"
+	"github.com/klaytn/klaytn/common"
+	"github.com/klaytn/klaytn/common/hexutil"
+	"github.com/klaytn/klaytn/crypto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGraphQL_HTTPHandlers(t *testing.T) {
+	g, err := newGraphQL(DefaultConfig())
+	require.NoError(t, err)
+	handlers := g.HTTPHandlers()
+
+	rec := httptest.NewRecorder()
+	handlers["/graphql/schema"].ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/graphql/schema", nil))
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, SchemaSDL, rec.Body.String())
+
+	// The queries are not served until the backend is set.
+	rec = httptest.NewRecorder()
+	handlers["/graphql"].ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/graphql", strings.NewReader(`{"query":"{ gasPrice }"}`)))
+	assert.Equal(t, http.StatusServiceUnavailable, rec.Code)
+
+	rec = httptest.NewRecorder()
+	handlers["/graphql"].ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/graphql", strings.NewReader(`{"query":`)))
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+
+	rec = httptest.NewRecorder()
+	handlers["/graphql"].ServeHTTP(rec, httptest.NewRequest(http.MethodPut, "/graphql", nil))
+	assert.Equal(t, http.StatusMethodNotAllowed, rec.Code)
+}
+
+func TestAccountKey_Resolve(t *testing.T) {
+	key1, _ := crypto.GenerateKey()
+	key2, _ := crypto.GenerateKey()
+	multisig := accountkey.NewAccountKeyWeightedMultiSigWithValues(2, accountkey.WeightedPublicKeys{
+		accountkey.NewWeightedPublicKey(1, (*accountkey.PublicKeySerializable)(&key1.PublicKey)),
+		accountkey.NewWeightedPublicKey(1, (*accountkey.PublicKeySerializable)(&key2.PublicKey)),
+	})
+	roleBased := accountkey.NewAccountKeyRoleBasedWithValues(accountkey.AccountKeyRoleBased{
+		accountkey.NewAccountKeyPublicWithValue(&key1.PublicKey),
+		multisig,
+		accountkey.NewAccountKeyFail(),
+	})
+
+	k := &AccountKey{key: multisig}
+	typ, _ := k.resolve(context.Background(), "type", nil)
+	assert.Equal(t, "AccountKeyWeightedMultiSig", typ)
+	threshold, _ := k.resolve(context.Background(), "threshold", nil)
+	assert.Equal(t, 2, threshold)
+	weights, _ := k.resolve(context.Background(), "weights", nil)
+	assert.Equal(t, []int{1, 1}, weights)
+	keys, _ := k.resolve(context.Background(), "publicKeys", nil)
+	assert.Equal(t, []hexutil.Bytes{crypto.CompressPubkey(&key1.PublicKey), crypto.CompressPubkey(&key2.PublicKey)}, keys)
+
+	k = &AccountKey{key: roleBased}
+	threshold, _ = k.resolve(context.Background(), "threshold", nil)
+	assert.Nil(t, threshold)
+	roles, _ := k.resolve(context.Background(), "roles", nil)
+	require.Len(t, roles, 3)
+	for i, expected := range []string{"AccountKeyPublic", "AccountKeyWeightedMultiSig", "AccountKeyFail"} {
+		typ, _ := roles.([]object)[i].resolve(context.Background(), "type", nil)
+		assert.Equal(t, expected, typ)
+	}
+}
+
+func TestNewAnchoringData(t *testing.T) {
+	data := newAnchoringData(&types.AnchoringDataInternalType0{
+		BlockHash:   common.HexToHash("0x01"),
+		TxHash:      common.HexToHash("0x02"),
+		BlockNumber: big.NewInt(10),
+		BlockCount:  big.NewInt(1),
+		TxCount:     big.NewInt(3),
+	})
+	assert.Equal(t, "type0", data.fields["type"])
+	assert.Equal(t, common.HexToHash("0x02"), data.fields["transactionsRoot"])
+	assert.Equal(t, (*hexutil.Big)(big.NewInt(3)), data.fields["txCount"])
+
+	merkle := newAnchoringData(&types.AnchoringDataMerkle{
+		Kind:            types.AnchoringDataMerkleKind,
+		MerkleRoot:      common.HexToHash("0x03"),
+		FromBlockNumber: big.NewInt(1),
+		BlockNumber:     big.NewInt(10),
+	})
+	assert.Equal(t, "merkle", merkle.fields["type"])
+	assert.Equal(t, common.HexToHash("0x03"), merkle.fields["merkleRoot"])
+	assert.Nil(t, merkle.fields["stateRoot"])
+}
@@ -0,0 +1,25 @@
+// Copyright 2024 The klaytn Authors
+// This file is part of the klaytn library.
+//
+// The klaytn library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The klaytn library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the klaytn library. If not, see <http://www.gnu.org/licenses/>.
+
+package graphql
+
+import "github.com/rcrowley/go-metrics"
+
+var (
+	queryCounter         = metrics.NewRegisteredCounter("graphql/query", nil)
+	rejectedQueryCounter = metrics.NewRegisteredCounter("graphql/query/rejected", nil)
+	queryCostHistogram   = metrics.NewRegisteredHistogram("graphql/query/cost", nil, metrics.NewExpDecaySample(1028, 0.015))
+)
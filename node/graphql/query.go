@@ -0,0 +1,663 @@
+// Copyright 2024 The klaytn Authors
+// This file is part of the klaytn library.
+//
+// The klaytn library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The klaytn library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the klaytn library. If not, see <http://www.gnu.org/licenses/>.
+
+package graphql
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+type tokenKind int
+
+const (
+	tokEOF tokenKind = iota
+	tokPunct
+	tokName
+	tokInt
+	tokFloat
+	tokString
+)
+
+type token struct {
+	kind  tokenKind
+	value string
+	pos   int
+}
+
+// lexer splits a GraphQL document into tokens. Whitespace, commas and comments are ignored.
+type lexer struct {
+	src string
+	pos int
+	tok token
+}
+
+func newLexer(src string) (*lexer, error) {
+	l := &lexer{src: src}
+	if err := l.next(); err != nil {
+		return nil, err
+	}
+	return l, nil
+}
+
+func (l *lexer) errorf(format string, args ...interface{}) error {
+	return fmt.Errorf("syntax error at %d: %s", l.tok.pos, fmt.Sprintf(format, args...))
+}
+
+// next reads the next token into l.tok.
+func (l *lexer) next() error {
+	for l.pos < len(l.src) {
+		c := l.src[l.pos]
+		if c == ' ' || c == '\t' || c == '\n' || c == '\r' || c == ',' {
+			l.pos++
+		} else if c == '#' {
+			for l.pos < len(l.src) && l.src[l.pos] != '\n' {
+				l.pos++
+			}
+		} else if strings.HasPrefix(l.src[l.pos:], "\ufeff") {
+			l.pos += len("\ufeff")
+		} else {
+			break
+		}
+	}
+	start := l.pos
+	if l.pos >= len(l.src) {
+		l.tok = token{kind: tokEOF, pos: start}
+		return nil
+	}
+
+	c := l.src[l.pos]
+	switch {
+	case strings.HasPrefix(l.src[l.pos:], "..."):
+		l.pos += 3
+		l.tok = token{tokPunct, "...", start}
+	case strings.IndexByte("!$&()/:=@[]{}|", c) >= 0:
+		l.pos++
+		l.tok = token{tokPunct, string(c), start}
+	case c == '_' || isLetter(c):
+		for l.pos < len(l.src) && (l.src[l.pos] == '_' || isLetter(l.src[l.pos]) || isDigit(l.src[l.pos])) {
+			l.pos++
+		}
+		l.tok = token{tokName, l.src[start:l.pos], start}
+	case c == '-' || isDigit(c):
+		return l.readNumber()
+	case c == '"':
+		return l.readString()
+	default:
+		l.tok = token{pos: start}
+		return l.errorf("unexpected character %q", c)
+	}
+	return nil
+}
+
+func (l *lexer) readNumber() error {
+	start := l.pos
+	kind := tokInt
+	if l.src[l.pos] == '-' {
+		l.pos++
+	}
+	digits := func() int {
+		n := 0
+		for l.pos < len(l.src) && isDigit(l.src[l.pos]) {
+			l.pos++
+			n++
+		}
+		return n
+	}
+	if digits() == 0 {
+		l.tok = token{pos: start}
+		return l.errorf("invalid number")
+	}
+	if l.pos < len(l.src) && l.src[l.pos] == '.' {
+		kind = tokFloat
+		l.pos++
+		if digits() == 0 {
+			l.tok = token{pos: start}
+			return l.errorf("invalid number")
+		}
+	}
+	if l.pos < len(l.src) && (l.src[l.pos] == 'e' || l.src[l.pos] == 'E') {
+		kind = tokFloat
+		l.pos++
+		if l.pos < len(l.src) && (l.src[l.pos] == '+' || l.src[l.pos] == '-') {
+			l.pos++
+		}
+		if digits() == 0 {
+			l.tok = token{pos: start}
+			return l.errorf("invalid number")
+		}
+	}
+	l.tok = token{kind, l.src[start:l.pos], start}
+	return nil
+}
+
+func (l *lexer) readString() error {
+	start := l.pos
+	if strings.HasPrefix(l.src[l.pos:], `"""`) {
+		end := strings.Index(l.src[l.pos+3:], `"""`)
+		if end < 0 {
+			l.tok = token{pos: start}
+			return l.errorf("unterminated string")
+		}
+		l.tok = token{tokString, strings.TrimSpace(l.src[l.pos+3 : l.pos+3+end]), start}
+		l.pos += end + 6
+		return nil
+	}
+
+	var sb strings.Builder
+	l.pos++
+	for {
+		if l.pos >= len(l.src) || l.src[l.pos] == '\n' {
+			l.tok = token{pos: start}
+			return l.errorf("unterminated string")
+		}
+		c := l.src[l.pos]
+		if c == '"' {
+			l.pos++
+			break
+		}
+		if c != '\\' {
+			r, size := utf8.DecodeRuneInString(l.src[l.pos:])
+			sb.WriteRune(r)
+			l.pos += size
+			continue
+		}
+		if l.pos+1 >= len(l.src) {
+			l.tok = token{pos: start}
+			return l.errorf("unterminated string")
+		}
+		switch esc := l.src[l.pos+1]; esc {
+		case '"', '\\', '/':
+			sb.WriteByte(esc)
+		case 'b':
+			sb.WriteByte('\b')
+		case 'f':
+			sb.WriteByte('\f')
+		case 'n':
+			sb.WriteByte('\n')
+		case 'r':
+			sb.WriteByte('\r')
+		case 't':
+			sb.WriteByte('\t')
+		case 'u':
+			if l.pos+6 > len(l.src) {
+				l.tok = token{pos: start}
+				return l.errorf("invalid unicode escape")
+			}
+			r, err := strconv.ParseUint(l.src[l.pos+2:l.pos+6], 16, 32)
+			if err != nil {
+				l.tok = token{pos: start}
+				return l.errorf("invalid unicode escape")
+			}
+			sb.WriteRune(rune(r))
+			l.pos += 4
+		default:
+			l.tok = token{pos: start}
+			return l.errorf("invalid escape \\%c", esc)
+		}
+		l.pos += 2
+	}
+	l.tok = token{tokString, sb.String(), start}
+	return nil
+}
+
+func isLetter(c byte) bool { return (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z') }
+func isDigit(c byte) bool  { return c >= '0' && c <= '9' }
+
+// peek returns true if the current token is the given punctuator or keyword.
+func (l *lexer) peek(value string) bool {
+	return (l.tok.kind == tokPunct || l.tok.kind == tokName) && l.tok.value == value
+}
+
+// skip consumes the current token if it is the given punctuator or keyword.
+func (l *lexer) skip(value string) (bool, error) {
+	if !l.peek(value) {
+		return false, nil
+	}
+	return true, l.next()
+}
+
+func (l *lexer) expect(value string) error {
+	if !l.peek(value) {
+		return l.errorf("expected %q, found %q", value, l.tok.value)
+	}
+	return l.next()
+}
+
+func (l *lexer) expectName() (string, error) {
+	if l.tok.kind != tokName {
+		return "", l.errorf("expected name, found %q", l.tok.value)
+	}
+	name := l.tok.value
+	return name, l.next()
+}
+
+// typeRef is a reference to a named type, a list type or a non-null type.
+type typeRef struct {
+	name    string
+	elem    *typeRef // the element type of a list type
+	nonNull bool
+}
+
+func (t *typeRef) String() string {
+	s := t.name
+	if t.elem != nil {
+		s = "[" + t.elem.String() + "]"
+	}
+	if t.nonNull {
+		s += "!"
+	}
+	return s
+}
+
+// namedType returns the named type of the type, unwrapping lists.
+func (t *typeRef) namedType() string {
+	for t.elem != nil {
+		t = t.elem
+	}
+	return t.name
+}
+
+func (l *lexer) parseType() (*typeRef, error) {
+	t := new(typeRef)
+	if ok, err := l.skip("["); err != nil {
+		return nil, err
+	} else if ok {
+		elem, err := l.parseType()
+		if err != nil {
+			return nil, err
+		}
+		if err := l.expect("]"); err != nil {
+			return nil, err
+		}
+		t.elem = elem
+	} else {
+		name, err := l.expectName()
+		if err != nil {
+			return nil, err
+		}
+		t.name = name
+	}
+	nonNull, err := l.skip("!")
+	t.nonNull = nonNull
+	return t, err
+}
+
+// Values in documents are parsed into json.Number, string, bool, nil, enumValue, variable,
+// []interface{} and map[string]interface{}.
+type (
+	enumValue string
+	variable  string
+)
+
+func (l *lexer) parseValue(constant bool) (interface{}, error) {
+	tok := l.tok
+	switch tok.kind {
+	case tokInt, tokFloat:
+		return json.Number(tok.value), l.next()
+	case tokString:
+		return tok.value, l.next()
+	case tokName:
+		var v interface{}
+		switch tok.value {
+		case "true":
+			v = true
+		case "false":
+			v = false
+		case "null":
+			v = nil
+		default:
+			v = enumValue(tok.value)
+		}
+		return v, l.next()
+	}
+
+	switch tok.value {
+	case "$":
+		if constant {
+			return nil, l.errorf("unexpected variable")
+		}
+		if err := l.next(); err != nil {
+			return nil, err
+		}
+		name, err := l.expectName()
+		return variable(name), err
+	case "[":
+		if err := l.next(); err != nil {
+			return nil, err
+		}
+		list := []interface{}{}
+		for !l.peek("]") {
+			if l.tok.kind == tokEOF {
+				return nil, l.errorf("unterminated list")
+			}
+			v, err := l.parseValue(constant)
+			if err != nil {
+				return nil, err
+			}
+			list = append(list, v)
+		}
+		return list, l.next()
+	case "{":
+		if err := l.next(); err != nil {
+			return nil, err
+		}
+		obj := map[string]interface{}{}
+		for !l.peek("}") {
+			name, err := l.expectName()
+			if err != nil {
+				return nil, err
+			}
+			if err := l.expect(":"); err != nil {
+				return nil, err
+			}
+			if obj[name], err = l.parseValue(constant); err != nil {
+				return nil, err
+			}
+		}
+		return obj, l.next()
+	}
+	return nil, l.errorf("unexpected %q", tok.value)
+}
+
+// document is a parsed GraphQL query document.
+type document struct {
+	operations []*operation
+	fragments  map[string]*fragment
+}
+
+type operation struct {
+	name string
+	vars []*varDef
+	sels []*selection
+}
+
+type varDef struct {
+	name   string
+	typ    *typeRef
+	def    interface{}
+	hasDef bool
+}
+
+type fragment struct {
+	name string
+	on   string
+	sels []*selection
+}
+
+type argument struct {
+	name  string
+	value interface{}
+}
+
+type directive struct {
+	name string
+	args []*argument
+}
+
+// selection is a field, a fragment spread or an inline fragment of a selection set.
+type selection struct {
+	alias string
+	name  string
+	args  []*argument
+	dirs  []*directive
+	sels  []*selection
+
+	spread string // the name of the spread fragment
+	inline bool   // whether it is an inline fragment
+	on     string // the type condition of the inline fragment
+}
+
+// key returns the response key of the field.
+func (s *selection) key() string {
+	if s.alias != "" {
+		return s.alias
+	}
+	return s.name
+}
+
+// parseQuery parses a GraphQL query document. Only query operations are supported.
+func parseQuery(src string) (*document, error) {
+	l, err := newLexer(src)
+	if err != nil {
+		return nil, err
+	}
+	doc := &document{fragments: map[string]*fragment{}}
+	for l.tok.kind != tokEOF {
+		switch {
+		case l.peek("{"):
+			sels, err := l.parseSelectionSet()
+			if err != nil {
+				return nil, err
+			}
+			doc.operations = append(doc.operations, &operation{sels: sels})
+		case l.peek("query"):
+			op, err := l.parseOperation()
+			if err != nil {
+				return nil, err
+			}
+			doc.operations = append(doc.operations, op)
+		case l.peek("fragment"):
+			f, err := l.parseFragment()
+			if err != nil {
+				return nil, err
+			}
+			if _, exist := doc.fragments[f.name]; exist {
+				return nil, fmt.Errorf("fragment %q is defined more than once", f.name)
+			}
+			doc.fragments[f.name] = f
+		case l.peek("mutation"), l.peek("subscription"):
+			return nil, fmt.Errorf("%s operations are not supported", l.tok.value)
+		default:
+			return nil, l.errorf("unexpected %q", l.tok.value)
+		}
+	}
+	if len(doc.operations) == 0 {
+		return nil, fmt.Errorf("no operation is defined")
+	}
+	return doc, nil
+}
+
+func (l *lexer) parseOperation() (*operation, error) {
+	if err := l.expect("query"); err != nil {
+		return nil, err
+	}
+	op := new(operation)
+	if l.tok.kind == tokName {
+		op.name = l.tok.value
+		if err := l.next(); err != nil {
+			return nil, err
+		}
+	}
+	if ok, err := l.skip("("); err != nil {
+		return nil, err
+	} else if ok {
+		for !l.peek(")") {
+			if err := l.expect("$"); err != nil {
+				return nil, err
+			}
+			name, err := l.expectName()
+			if err != nil {
+				return nil, err
+			}
+			if err := l.expect(":"); err != nil {
+				return nil, err
+			}
+			typ, err := l.parseType()
+			if err != nil {
+				return nil, err
+			}
+			def := &varDef{name: name, typ: typ}
+			if ok, err := l.skip("="); err != nil {
+				return nil, err
+			} else if ok {
+				if def.def, err = l.parseValue(true); err != nil {
+					return nil, err
+				}
+				def.hasDef = true
+			}
+			op.vars = append(op.vars, def)
+		}
+		if err := l.next(); err != nil {
+			return nil, err
+		}
+	}
+	if _, err := l.parseDirectives(); err != nil {
+		return nil, err
+	}
+	sels, err := l.parseSelectionSet()
+	op.sels = sels
+	return op, err
+}
+
+func (l *lexer) parseFragment() (*fragment, error) {
+	if err := l.expect("fragment"); err != nil {
+		return nil, err
+	}
+	name, err := l.expectName()
+	if err != nil {
+		return nil, err
+	}
+	if err := l.expect("on"); err != nil {
+		return nil, err
+	}
+	on, err := l.expectName()
+	if err != nil {
+		return nil, err
+	}
+	if _, err := l.parseDirectives(); err != nil {
+		return nil, err
+	}
+	sels, err := l.parseSelectionSet()
+	return &fragment{name: name, on: on, sels: sels}, err
+}
+
+func (l *lexer) parseArguments() ([]*argument, error) {
+	if ok, err := l.skip("("); err != nil || !ok {
+		return nil, err
+	}
+	var args []*argument
+	for !l.peek(")") {
+		name, err := l.expectName()
+		if err != nil {
+			return nil, err
+		}
+		if err := l.expect(":"); err != nil {
+			return nil, err
+		}
+		value, err := l.parseValue(false)
+		if err != nil {
+			return nil, err
+		}
+		args = append(args, &argument{name, value})
+	}
+	return args, l.next()
+}
+
+func (l *lexer) parseDirectives() ([]*directive, error) {
+	var dirs []*directive
+	for l.peek("@") {
+		if err := l.next(); err != nil {
+			return nil, err
+		}
+		name, err := l.expectName()
+		if err != nil {
+			return nil, err
+		}
+		args, err := l.parseArguments()
+		if err != nil {
+			return nil, err
+		}
+		dirs = append(dirs, &directive{name, args})
+	}
+	return dirs, nil
+}
+
+func (l *lexer) parseSelectionSet() ([]*selection, error) {
+	if err := l.expect("{"); err != nil {
+		return nil, err
+	}
+	var sels []*selection
+	for !l.peek("}") {
+		if l.tok.kind == tokEOF {
+			return nil, l.errorf("unterminated selection set")
+		}
+		sel, err := l.parseSelection()
+		if err != nil {
+			return nil, err
+		}
+		sels = append(sels, sel)
+	}
+	if len(sels) == 0 {
+		return nil, l.errorf("empty selection set")
+	}
+	return sels, l.next()
+}
+
+func (l *lexer) parseSelection() (*selection, error) {
+	var err error
+	sel := new(selection)
+	if ok, err := l.skip("..."); err != nil {
+		return nil, err
+	} else if ok {
+		if l.tok.kind == tokName && l.tok.value != "on" {
+			sel.spread = l.tok.value
+			if err := l.next(); err != nil {
+				return nil, err
+			}
+			sel.dirs, err = l.parseDirectives()
+			return sel, err
+		}
+		sel.inline = true
+		if ok, err := l.skip("on"); err != nil {
+			return nil, err
+		} else if ok {
+			if sel.on, err = l.expectName(); err != nil {
+				return nil, err
+			}
+		}
+		if sel.dirs, err = l.parseDirectives(); err != nil {
+			return nil, err
+		}
+		sel.sels, err = l.parseSelectionSet()
+		return sel, err
+	}
+
+	if sel.name, err = l.expectName(); err != nil {
+		return nil, err
+	}
+	if ok, err := l.skip(":"); err != nil {
+		return nil, err
+	} else if ok {
+		sel.alias = sel.name
+		if sel.name, err = l.expectName(); err != nil {
+			return nil, err
+		}
+	}
+	if sel.args, err = l.parseArguments(); err != nil {
+		return nil, err
+	}
+	if sel.dirs, err = l.parseDirectives(); err != nil {
+		return nil, err
+	}
+	if l.peek("{") {
+		sel.sels, err = l.parseSelectionSet()
+	}
+	return sel, err
+}
@@ -0,0 +1,582 @@
+// Copyright 2024 The klaytn Authors
+// This file is part of the klaytn library.
+//
+// The klaytn library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The klaytn library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the klaytn library. If not, see <http://www.gnu.org/licenses/>.
+
+package graphql
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"errors"
+	"fmt"
+
+	"github.com/klaytn/klaytn/api"
+	"github.com/klaytn/klaytn/blockchain/state"
+	"github.com/klaytn/klaytn/blockchain/types"
+	"github.com/klaytn/klaytn/blockchain/types/accountkey"
+	"github.com/klaytn/klaytn/common"
+	"github.com/klaytn/klaytn/common/hexutil"
+	"github.com/klaytn/klaytn/crypto"
+	"github.com/klaytn/klaytn/networks/rpc"
+	"github.com/klaytn/klaytn/node/cn/filters"
+	"github.com/klaytn/klaytn/rlp"
+)
+
+var (
+	errBlockNotFound       = errors.New("block not found")
+	errTransactionNotFound = errors.New("transaction not found")
+	errInvalidBlockRange   = errors.New("invalid block range")
+)
+
+// Backend is the Klaytn backend resolving the queries.
+type Backend interface {
+	api.Backend
+	filters.Backend
+}
+
+// istanbulAPI is the Istanbul API extension providing the validators of the blocks.
+type istanbulAPI interface {
+	GetCommittee(number *rpc.BlockNumber) ([]common.Address, error)
+	GetCouncil(number *rpc.BlockNumber) ([]common.Address, error)
+}
+
+var accountKeyTypeNames = map[accountkey.AccountKeyType]string{
+	accountkey.AccountKeyTypeNil:              "AccountKeyNil",
+	accountkey.AccountKeyTypeLegacy:           "AccountKeyLegacy",
+	accountkey.AccountKeyTypePublic:           "AccountKeyPublic",
+	accountkey.AccountKeyTypeFail:             "AccountKeyFail",
+	accountkey.AccountKeyTypeWeightedMultiSig: "AccountKeyWeightedMultiSig",
+	accountkey.AccountKeyTypeRoleBased:        "AccountKeyRoleBased",
+	accountkey.AccountKeyTypeContract:         "AccountKeyContract",
+	accountkey.AccountKeyTypeP256:             "AccountKeyP256",
+}
+
+// resolver resolves the fields of Query.
+type resolver struct {
+	backend  Backend
+	istanbul istanbulAPI
+}
+
+func (r *resolver) resolve(ctx context.Context, field string, args map[string]interface{}) (interface{}, error) {
+	switch field {
+	case "block":
+		var (
+			block *types.Block
+			err   error
+		)
+		if hash, ok := args["hash"].(common.Hash); ok {
+			block, err = r.backend.BlockByHash(ctx, hash)
+		} else if number, ok := args["number"].(int64); ok {
+			block, err = r.backend.BlockByNumber(ctx, rpc.BlockNumber(number))
+		} else {
+			block, err = r.backend.BlockByNumber(ctx, rpc.LatestBlockNumber)
+		}
+		if err != nil || block == nil {
+			return nil, err
+		}
+		return &Block{r: r, block: block}, nil
+	case "blocks":
+		from, head := args["from"].(int64), int64(r.backend.CurrentBlock().NumberU64())
+		to := head
+		if end, ok := args["to"].(int64); ok && end < head {
+			to = end
+		}
+		if from < 0 {
+			return nil, errInvalidBlockRange
+		}
+		blocks := []object{}
+		for number := from; number <= to; number++ {
+			block, err := r.backend.BlockByNumber(ctx, rpc.BlockNumber(number))
+			if err != nil {
+				return nil, err
+			}
+			if block == nil {
+				break
+			}
+			blocks = append(blocks, &Block{r: r, block: block})
+		}
+		return blocks, nil
+	case "transaction":
+		return r.transaction(ctx, args["hash"].(common.Hash))
+	case "logs":
+		filter := args["filter"].(map[string]interface{})
+		begin, end := rpc.LatestBlockNumber.Int64(), rpc.LatestBlockNumber.Int64()
+		if from, ok := filter["fromBlock"].(int64); ok {
+			begin = from
+		}
+		if to, ok := filter["toBlock"].(int64); ok {
+			end = to
+		}
+		addresses, topics := filterCriteria(filter)
+		return r.logs(ctx, filters.NewRangeFilter(r.backend, begin, end, addresses, topics))
+	case "account":
+		number := rpc.LatestBlockNumber
+		if block, ok := args["block"].(int64); ok {
+			number = rpc.BlockNumber(block)
+		}
+		return &Account{r: r, address: args["address"].(common.Address), number: number}, nil
+	case "gasPrice":
+		price, err := r.backend.SuggestPrice(ctx)
+		return (*hexutil.Big)(price), err
+	case "chainID":
+		return (*hexutil.Big)(r.backend.ChainConfig().ChainID), nil
+	}
+	return nil, fmt.Errorf("unknown field %q", field)
+}
+
+// transaction returns the transaction of the hash, finding it in the pending transactions too.
+func (r *resolver) transaction(ctx context.Context, hash common.Hash) (*Transaction, error) {
+	tx, blockHash, _, index := r.backend.GetTxAndLookupInfo(hash)
+	if tx != nil {
+		return &Transaction{r: r, tx: tx, blockHash: blockHash, index: index}, nil
+	}
+	if tx = r.backend.GetPoolTransaction(hash); tx != nil {
+		return &Transaction{r: r, tx: tx}, nil
+	}
+	return nil, nil
+}
+
+func (r *resolver) logs(ctx context.Context, filter *filters.Filter) ([]object, error) {
+	ctx, cancel := context.WithTimeout(ctx, filters.GetLogsDeadline)
+	defer cancel()
+
+	logs, err := filter.Logs(ctx)
+	if err != nil {
+		return nil, err
+	}
+	results := make([]object, len(logs))
+	for i, log := range logs {
+		results[i] = &Log{r: r, log: log}
+	}
+	return results, nil
+}
+
+// filterCriteria returns the addresses and the topics of a coerced filter criteria.
+func filterCriteria(filter map[string]interface{}) ([]common.Address, [][]common.Hash) {
+	var (
+		addresses []common.Address
+		topics    [][]common.Hash
+	)
+	if list, ok := filter["addresses"].([]interface{}); ok {
+		for _, address := range list {
+			addresses = append(addresses, address.(common.Address))
+		}
+	}
+	if list, ok := filter["topics"].([]interface{}); ok {
+		for _, alternatives := range list {
+			var position []common.Hash
+			for _, topic := range alternatives.([]interface{}) {
+				position = append(position, topic.(common.Hash))
+			}
+			topics = append(topics, position)
+		}
+	}
+	return addresses, topics
+}
+
+// Block resolves the fields of Block.
+type Block struct {
+	r     *resolver
+	block *types.Block
+}
+
+func (b *Block) resolve(ctx context.Context, field string, args map[string]interface{}) (interface{}, error) {
+	header := b.block.Header()
+	switch field {
+	case "number":
+		return hexutil.Uint64(b.block.NumberU64()), nil
+	case "hash":
+		return b.block.Hash(), nil
+	case "parent":
+		if b.block.NumberU64() == 0 {
+			return nil, nil
+		}
+		parent, err := b.r.backend.BlockByHash(ctx, header.ParentHash)
+		if err != nil || parent == nil {
+			return nil, err
+		}
+		return &Block{r: b.r, block: parent}, nil
+	case "parentHash":
+		return header.ParentHash, nil
+	case "timestamp":
+		return hexutil.Uint64(header.Time.Uint64()), nil
+	case "timestampFoS":
+		return int(header.TimeFoS), nil
+	case "extraData":
+		return hexutil.Bytes(header.Extra), nil
+	case "gasUsed":
+		return hexutil.Uint64(header.GasUsed), nil
+	case "blockScore":
+		return (*hexutil.Big)(header.BlockScore), nil
+	case "rewardbase":
+		return header.Rewardbase, nil
+	case "stateRoot":
+		return header.Root, nil
+	case "transactionsRoot":
+		return header.TxHash, nil
+	case "receiptsRoot":
+		return header.ReceiptHash, nil
+	case "logsBloom":
+		return hexutil.Bytes(header.Bloom.Bytes()), nil
+	case "baseFeePerGas":
+		return (*hexutil.Big)(header.BaseFee), nil
+	case "governanceData":
+		return hexutil.Bytes(header.Governance), nil
+	case "voteData":
+		return hexutil.Bytes(header.Vote), nil
+	case "transactionCount":
+		return len(b.block.Transactions()), nil
+	case "transactions":
+		txs := b.block.Transactions()
+		results := make([]object, len(txs))
+		for i, tx := range txs {
+			results[i] = &Transaction{r: b.r, tx: tx, block: b.block, blockHash: b.block.Hash(), index: uint64(i)}
+		}
+		return results, nil
+	case "transactionAt":
+		txs, index := b.block.Transactions(), args["index"].(int)
+		if index < 0 || index >= len(txs) {
+			return nil, nil
+		}
+		return &Transaction{r: b.r, tx: txs[index], block: b.block, blockHash: b.block.Hash(), index: uint64(index)}, nil
+	case "logs":
+		addresses, topics := filterCriteria(args["filter"].(map[string]interface{}))
+		return b.r.logs(ctx, filters.NewBlockFilter(b.r.backend, b.block.Hash(), addresses, topics))
+	case "account":
+		return &Account{r: b.r, address: args["address"].(common.Address), number: rpc.BlockNumber(b.block.NumberU64())}, nil
+	case "committee", "council":
+		if b.r.istanbul == nil {
+			return nil, nil
+		}
+		number := rpc.BlockNumber(b.block.NumberU64())
+		if field == "committee" {
+			return b.r.istanbul.GetCommittee(&number)
+		}
+		return b.r.istanbul.GetCouncil(&number)
+	}
+	return nil, fmt.Errorf("unknown field %q", field)
+}
+
+// Transaction resolves the fields of Transaction. The block hash is empty for a pending transaction.
+type Transaction struct {
+	r         *resolver
+	tx        *types.Transaction
+	block     *types.Block
+	blockHash common.Hash
+	index     uint64
+}
+
+func (t *Transaction) pending() bool {
+	return t.blockHash == (common.Hash{})
+}
+
+func (t *Transaction) getBlock(ctx context.Context) (*types.Block, error) {
+	if t.block == nil && !t.pending() {
+		block, err := t.r.backend.BlockByHash(ctx, t.blockHash)
+		if err != nil {
+			return nil, err
+		}
+		if block == nil {
+			return nil, errBlockNotFound
+		}
+		t.block = block
+	}
+	return t.block, nil
+}
+
+func (t *Transaction) getReceipt(ctx context.Context) *types.Receipt {
+	if t.pending() {
+		return nil
+	}
+	receipts := t.r.backend.GetBlockReceipts(ctx, t.blockHash)
+	if t.index >= uint64(len(receipts)) {
+		return nil
+	}
+	return receipts[t.index]
+}
+
+func (t *Transaction) resolve(ctx context.Context, field string, args map[string]interface{}) (interface{}, error) {
+	tx := t.tx
+	switch field {
+	case "hash":
+		return tx.Hash(), nil
+	case "type":
+		return tx.Type().String(), nil
+	case "typeInt":
+		return int(tx.Type()), nil
+	case "nonce":
+		return hexutil.Uint64(tx.Nonce()), nil
+	case "index":
+		if t.pending() {
+			return nil, nil
+		}
+		return int(t.index), nil
+	case "from":
+		if tx.IsEthereumTransaction() {
+			return types.Sender(types.LatestSignerForChainID(tx.ChainId()), tx)
+		}
+		return tx.From()
+	case "to":
+		return tx.To(), nil
+	case "value":
+		return (*hexutil.Big)(tx.Value()), nil
+	case "gasPrice":
+		if tx.Type() != types.TxTypeEthereumDynamicFee {
+			return (*hexutil.Big)(tx.GasPrice()), nil
+		}
+		block, err := t.getBlock(ctx)
+		if err != nil {
+			return nil, err
+		}
+		var header *types.Header
+		if block != nil {
+			header = block.Header()
+		}
+		return (*hexutil.Big)(tx.EffectiveGasPrice(header)), nil
+	case "gas":
+		return hexutil.Uint64(tx.Gas()), nil
+	case "input":
+		return hexutil.Bytes(tx.Data()), nil
+	case "feePayer":
+		if !tx.IsFeeDelegatedTransaction() {
+			return nil, nil
+		}
+		return tx.FeePayer()
+	case "feeRatio":
+		if ratio, ok := tx.FeeRatio(); ok {
+			return int(ratio), nil
+		}
+		return nil, nil
+	case "senderTxHash":
+		return tx.SenderTxHashAll(), nil
+	case "block":
+		block, err := t.getBlock(ctx)
+		if err != nil || block == nil {
+			return nil, err
+		}
+		return &Block{r: t.r, block: block}, nil
+	case "status", "gasUsed", "contractAddress", "logs":
+		receipt := t.getReceipt(ctx)
+		if receipt == nil {
+			return nil, nil
+		}
+		switch field {
+		case "status":
+			return hexutil.Uint64(receipt.Status), nil
+		case "gasUsed":
+			return hexutil.Uint64(receipt.GasUsed), nil
+		case "contractAddress":
+			if receipt.ContractAddress == (common.Address{}) {
+				return nil, nil
+			}
+			return receipt.ContractAddress, nil
+		}
+		logs := make([]object, len(receipt.Logs))
+		for i, log := range receipt.Logs {
+			logs[i] = &Log{r: t.r, log: log}
+		}
+		return logs, nil
+	case "key":
+		if !tx.Type().IsAccountUpdate() {
+			return nil, nil
+		}
+		encoded, ok := tx.MakeRPCOutput()["key"].(hexutil.Bytes)
+		if !ok {
+			return nil, nil
+		}
+		serializer := accountkey.NewAccountKeySerializer()
+		if err := rlp.DecodeBytes(encoded, serializer); err != nil {
+			return nil, err
+		}
+		return &AccountKey{key: serializer.GetKey()}, nil
+	case "anchoredData":
+		if !tx.Type().IsChainDataAnchoring() {
+			return nil, nil
+		}
+		encoded, err := tx.AnchoredData()
+		if err != nil {
+			return nil, err
+		}
+		data, err := types.DecodeAnchoringData(encoded)
+		if err != nil {
+			return nil, err
+		}
+		return newAnchoringData(data), nil
+	}
+	return nil, fmt.Errorf("unknown field %q", field)
+}
+
+// Log resolves the fields of Log.
+type Log struct {
+	r   *resolver
+	log *types.Log
+}
+
+func (l *Log) resolve(ctx context.Context, field string, args map[string]interface{}) (interface{}, error) {
+	switch field {
+	case "index":
+		return int(l.log.Index), nil
+	case "account":
+		return l.log.Address, nil
+	case "topics":
+		return append([]common.Hash{}, l.log.Topics...), nil
+	case "data":
+		return hexutil.Bytes(l.log.Data), nil
+	case "blockNumber":
+		return hexutil.Uint64(l.log.BlockNumber), nil
+	case "transaction":
+		tx, err := l.r.transaction(ctx, l.log.TxHash)
+		if err == nil && tx == nil {
+			err = errTransactionNotFound
+		}
+		return tx, err
+	}
+	return nil, fmt.Errorf("unknown field %q", field)
+}
+
+// Account resolves the fields of Account at the block.
+type Account struct {
+	r       *resolver
+	address common.Address
+	number  rpc.BlockNumber
+}
+
+func (a *Account) state(ctx context.Context) (*state.StateDB, error) {
+	statedb, _, err := a.r.backend.StateAndHeaderByNumber(ctx, a.number)
+	if err == nil && statedb == nil {
+		err = errBlockNotFound
+	}
+	return statedb, err
+}
+
+func (a *Account) resolve(ctx context.Context, field string, args map[string]interface{}) (interface{}, error) {
+	if field == "address" {
+		return a.address, nil
+	}
+	statedb, err := a.state(ctx)
+	if err != nil {
+		return nil, err
+	}
+	switch field {
+	case "balance":
+		return (*hexutil.Big)(statedb.GetBalance(a.address)), nil
+	case "nonce":
+		return hexutil.Uint64(statedb.GetNonce(a.address)), nil
+	case "code":
+		return hexutil.Bytes(statedb.GetCode(a.address)), nil
+	case "storage":
+		return statedb.GetState(a.address, args["slot"].(common.Hash)), nil
+	case "type":
+		acc := statedb.GetAccount(a.address)
+		if acc == nil {
+			return nil, nil
+		}
+		return acc.Type().String(), nil
+	case "key":
+		return &AccountKey{key: statedb.GetKey(a.address)}, nil
+	}
+	return nil, fmt.Errorf("unknown field %q", field)
+}
+
+// AccountKey resolves the fields of AccountKey.
+type AccountKey struct {
+	key accountkey.AccountKey
+}
+
+func (k *AccountKey) resolve(ctx context.Context, field string, args map[string]interface{}) (interface{}, error) {
+	switch field {
+	case "type":
+		return accountKeyTypeNames[k.key.Type()], nil
+	case "keyType":
+		return int(k.key.Type()), nil
+	case "publicKeys":
+		keys := []hexutil.Bytes{}
+		switch key := k.key.(type) {
+		case *accountkey.AccountKeyPublic:
+			keys = append(keys, crypto.CompressPubkey((*ecdsa.PublicKey)(key.PublicKeySerializable)))
+		case *accountkey.AccountKeyWeightedMultiSig:
+			for _, weighted := range key.Keys {
+				keys = append(keys, crypto.CompressPubkey((*ecdsa.PublicKey)(weighted.Key)))
+			}
+		case *accountkey.AccountKeyP256:
+			keys = append(keys, elliptic.MarshalCompressed(elliptic.P256(), key.X, key.Y))
+		}
+		return keys, nil
+	case "threshold", "weights":
+		key, ok := k.key.(*accountkey.AccountKeyWeightedMultiSig)
+		if !ok {
+			return nil, nil
+		}
+		if field == "threshold" {
+			return int(key.Threshold), nil
+		}
+		weights := make([]int, len(key.Keys))
+		for i, weighted := range key.Keys {
+			weights[i] = int(weighted.Weight)
+		}
+		return weights, nil
+	case "roles":
+		key, ok := k.key.(*accountkey.AccountKeyRoleBased)
+		if !ok {
+			return nil, nil
+		}
+		roles := make([]object, len(*key))
+		for i, role := range *key {
+			roles[i] = &AccountKey{key: role}
+		}
+		return roles, nil
+	}
+	return nil, fmt.Errorf("unknown field %q", field)
+}
+
+// AnchoringData resolves the fields of AnchoringData.
+type AnchoringData struct {
+	fields map[string]interface{}
+}
+
+func newAnchoringData(data types.AnchoringDataInternal) *AnchoringData {
+	fields := map[string]interface{}{
+		"blockHash":   data.GetBlockHash(),
+		"blockNumber": (*hexutil.Big)(data.GetBlockNumber()),
+	}
+	switch d := data.(type) {
+	case *types.AnchoringDataLegacy:
+		fields["type"] = "legacy"
+		fields["parentHash"] = d.ParentHash
+		fields["transactionsRoot"] = d.TxHash
+		fields["receiptsRoot"] = d.ReceiptHash
+		fields["stateRoot"] = d.StateRootHash
+	case *types.AnchoringDataInternalType0:
+		fields["type"] = "type0"
+		fields["parentHash"] = d.ParentHash
+		fields["transactionsRoot"] = d.TxHash
+		fields["receiptsRoot"] = d.ReceiptHash
+		fields["stateRoot"] = d.StateRootHash
+		fields["blockCount"] = (*hexutil.Big)(d.BlockCount)
+		fields["txCount"] = (*hexutil.Big)(d.TxCount)
+	case *types.AnchoringDataMerkle:
+		fields["type"] = types.AnchoringDataMerkleKind
+		fields["merkleRoot"] = d.MerkleRoot
+		fields["fromBlockNumber"] = (*hexutil.Big)(d.FromBlockNumber)
+		fields["blockCount"] = (*hexutil.Big)(d.BlockCount)
+		fields["txCount"] = (*hexutil.Big)(d.TxCount)
+	}
+	return &AnchoringData{fields: fields}
+}
+
+func (d *AnchoringData) resolve(ctx context.Context, field string, args map[string]interface{}) (interface{}, error) {
+	return d.fields[field], nil
+}
@@ -0,0 +1,247 @@
+// Copyright 2024 The klaytn Authors
+// This file is part of the klaytn library.
+//
+// The klaytn library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The klaytn library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the klaytn library. If not, see <http://www.gnu.org/licenses/>.
+
+package graphql
+
+import (
+	"fmt"
+)
+
+type typeKind int
+
+const (
+	kindScalar typeKind = iota
+	kindObject
+	kindInput
+	kindEnum
+)
+
+// typeDef is a type defined in the schema.
+type typeDef struct {
+	name   string
+	kind   typeKind
+	fields map[string]*fieldDef // the fields of an object or an input type
+	values map[string]bool      // the values of an enum type
+}
+
+// fieldDef is a field of an object type, an input field of an input type or an argument of a field.
+type fieldDef struct {
+	name   string
+	args   map[string]*fieldDef
+	typ    *typeRef
+	def    interface{}
+	hasDef bool
+}
+
+// schema is a parsed GraphQL schema.
+type schema struct {
+	query *typeDef
+	types map[string]*typeDef
+}
+
+// parseSchema parses a schema written in the GraphQL schema definition language.
+// Directives and interfaces are not supported.
+func parseSchema(sdl string) (*schema, error) {
+	l, err := newLexer(sdl)
+	if err != nil {
+		return nil, err
+	}
+	s := &schema{types: map[string]*typeDef{}}
+	for _, name := range []string{"Int", "Float", "String", "Boolean", "ID"} {
+		s.types[name] = &typeDef{name: name, kind: kindScalar}
+	}
+	queryType := "Query"
+	for l.tok.kind != tokEOF {
+		if l.tok.kind == tokString {
+			if err := l.next(); err != nil { // skip the description
+				return nil, err
+			}
+			continue
+		}
+		keyword, err := l.expectName()
+		if err != nil {
+			return nil, err
+		}
+		if keyword == "schema" {
+			if err := l.expect("{"); err != nil {
+				return nil, err
+			}
+			for !l.peek("}") {
+				op, err := l.expectName()
+				if err != nil {
+					return nil, err
+				}
+				if err := l.expect(":"); err != nil {
+					return nil, err
+				}
+				name, err := l.expectName()
+				if err != nil {
+					return nil, err
+				}
+				if op != "query" {
+					return nil, fmt.Errorf("%s operations are not supported", op)
+				}
+				queryType = name
+			}
+			if err := l.next(); err != nil {
+				return nil, err
+			}
+			continue
+		}
+
+		name, err := l.expectName()
+		if err != nil {
+			return nil, err
+		}
+		if _, exist := s.types[name]; exist {
+			return nil, fmt.Errorf("type %q is defined more than once", name)
+		}
+		t := &typeDef{name: name}
+		switch keyword {
+		case "scalar":
+			t.kind = kindScalar
+		case "enum":
+			t.kind, t.values = kindEnum, map[string]bool{}
+			if err := l.expect("{"); err != nil {
+				return nil, err
+			}
+			for !l.peek("}") {
+				if l.tok.kind == tokString {
+					if err := l.next(); err != nil {
+						return nil, err
+					}
+					continue
+				}
+				value, err := l.expectName()
+				if err != nil {
+					return nil, err
+				}
+				t.values[value] = true
+			}
+			if err := l.next(); err != nil {
+				return nil, err
+			}
+		case "type", "input":
+			t.kind = kindObject
+			if keyword == "input" {
+				t.kind = kindInput
+			}
+			if t.fields, err = l.parseFieldDefs(t.kind == kindObject); err != nil {
+				return nil, err
+			}
+		default:
+			return nil, fmt.Errorf("unsupported definition %q", keyword)
+		}
+		s.types[name] = t
+	}
+
+	s.query = s.types[queryType]
+	if s.query == nil || s.query.kind != kindObject {
+		return nil, fmt.Errorf("query type %q is not defined", queryType)
+	}
+	// Check that all referenced types are defined, and that arguments and input fields are input types.
+	for _, t := range s.types {
+		for _, f := range t.fields {
+			ft, ok := s.types[f.typ.namedType()]
+			if !ok {
+				return nil, fmt.Errorf("type %q of %s.%s is not defined", f.typ.namedType(), t.name, f.name)
+			}
+			if t.kind == kindInput && ft.kind == kindObject {
+				return nil, fmt.Errorf("input field %s.%s has an object type", t.name, f.name)
+			}
+			for _, arg := range f.args {
+				at, ok := s.types[arg.typ.namedType()]
+				if !ok {
+					return nil, fmt.Errorf("type %q of the argument %s.%s(%s) is not defined", arg.typ.namedType(), t.name, f.name, arg.name)
+				}
+				if at.kind == kindObject {
+					return nil, fmt.Errorf("argument %s.%s(%s) has an object type", t.name, f.name, arg.name)
+				}
+			}
+		}
+	}
+	return s, nil
+}
+
+// parseFieldDefs parses the fields of an object type or an input type.
+func (l *lexer) parseFieldDefs(withArgs bool) (map[string]*fieldDef, error) {
+	if err := l.expect("{"); err != nil {
+		return nil, err
+	}
+	fields := map[string]*fieldDef{}
+	for !l.peek("}") {
+		if l.tok.kind == tokString {
+			if err := l.next(); err != nil {
+				return nil, err
+			}
+			continue
+		}
+		f, err := l.parseInputValueDef()
+		if err != nil {
+			return nil, err
+		}
+		if f.args != nil && !withArgs {
+			return nil, l.errorf("input field %q can not have arguments", f.name)
+		}
+		fields[f.name] = f
+	}
+	return fields, l.next()
+}
+
+// parseInputValueDef parses a field definition with its arguments and its default value.
+func (l *lexer) parseInputValueDef() (*fieldDef, error) {
+	name, err := l.expectName()
+	if err != nil {
+		return nil, err
+	}
+	f := &fieldDef{name: name}
+	if ok, err := l.skip("("); err != nil {
+		return nil, err
+	} else if ok {
+		f.args = map[string]*fieldDef{}
+		for !l.peek(")") {
+			if l.tok.kind == tokString {
+				if err := l.next(); err != nil {
+					return nil, err
+				}
+				continue
+			}
+			arg, err := l.parseInputValueDef()
+			if err != nil {
+				return nil, err
+			}
+			f.args[arg.name] = arg
+		}
+		if err := l.next(); err != nil {
+			return nil, err
+		}
+	}
+	if err := l.expect(":"); err != nil {
+		return nil, err
+	}
+	if f.typ, err = l.parseType(); err != nil {
+		return nil, err
+	}
+	if ok, err := l.skip("="); err != nil {
+		return nil, err
+	} else if ok {
+		if f.def, err = l.parseValue(true); err != nil {
+			return nil, err
+		}
+		f.hasDef = true
+	}
+	return f, nil
+}
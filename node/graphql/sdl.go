@@ -0,0 +1,193 @@
+// Copyright 2024 The klaytn Authors
+// This file is part of the klaytn library.
+//
+// The klaytn library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The klaytn library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the klaytn library. If not, see <http://www.gnu.org/licenses/>.
+
+package graphql
+
+// SchemaSDL is the GraphQL schema of Klaytn written in the schema definition language.
+const SchemaSDL = `
+"""Bytes32 is a 32 byte binary string, represented as 0x-prefixed hexadecimal."""
+scalar Bytes32
+"""Address is a 20 byte Klaytn address, represented as 0x-prefixed hexadecimal."""
+scalar Address
+"""Bytes is an arbitrary length binary string, represented as 0x-prefixed hexadecimal."""
+scalar Bytes
+"""
+BigInt is a large integer. Input is accepted as either a JSON number or as a string.
+Strings may be either decimal or 0x-prefixed hexadecimal. Output values are all
+0x-prefixed hexadecimal.
+"""
+scalar BigInt
+"""
+Long is a 64 bit integer. Input is accepted as either a JSON number or as a string.
+Output values are all 0x-prefixed hexadecimal.
+"""
+scalar Long
+
+schema {
+    query: Query
+}
+
+type Query {
+    """Block fetches a block by number or by hash. If neither is given, the latest block is returned."""
+    block(number: Long, hash: Bytes32): Block
+    """Blocks returns the blocks in the range from 'from' to 'to' (inclusive). 'to' is the latest block by default."""
+    blocks(from: Long!, to: Long): [Block!]!
+    """Transaction returns a transaction by its hash. It also finds the pending transactions."""
+    transaction(hash: Bytes32!): Transaction
+    """Logs returns the logs matching the filter criteria."""
+    logs(filter: FilterCriteria!): [Log!]!
+    """Account returns the account at the block. The latest block is used by default."""
+    account(address: Address!, block: Long): Account!
+    """GasPrice returns the unit price of gas."""
+    gasPrice: BigInt!
+    """ChainID returns the chain ID of the network."""
+    chainID: BigInt!
+}
+
+"""FilterCriteria encapsulates the log filter parameters of Query.logs."""
+input FilterCriteria {
+    """FromBlock is the first block of the range. The latest block is used by default."""
+    fromBlock: Long
+    """ToBlock is the last block of the range. The latest block is used by default."""
+    toBlock: Long
+    """Addresses is the list of the contracts emitting the logs. Any contract matches if empty."""
+    addresses: [Address!]
+    """Topics is the list of the topic alternatives of each position. An empty list matches any topic."""
+    topics: [[Bytes32!]!]
+}
+
+"""BlockFilterCriteria encapsulates the log filter parameters of Block.logs."""
+input BlockFilterCriteria {
+    addresses: [Address!]
+    topics: [[Bytes32!]!]
+}
+
+type Block {
+    number: Long!
+    hash: Bytes32!
+    parent: Block
+    parentHash: Bytes32!
+    """Timestamp is the unix time of the block in seconds."""
+    timestamp: Long!
+    """TimestampFoS is the fraction of a second of the timestamp."""
+    timestampFoS: Int!
+    extraData: Bytes!
+    gasUsed: Long!
+    blockScore: BigInt!
+    rewardbase: Address!
+    stateRoot: Bytes32!
+    transactionsRoot: Bytes32!
+    receiptsRoot: Bytes32!
+    logsBloom: Bytes!
+    """BaseFeePerGas is the base fee of the block. It is null before the Magma hard fork."""
+    baseFeePerGas: BigInt
+    governanceData: Bytes!
+    voteData: Bytes!
+    transactionCount: Int!
+    transactions: [Transaction!]!
+    transactionAt(index: Int!): Transaction
+    logs(filter: BlockFilterCriteria!): [Log!]!
+    """Account returns the account at the block."""
+    account(address: Address!): Account!
+    """Committee is the list of the validators which signed the block."""
+    committee: [Address!]
+    """Council is the list of the validators of the block."""
+    council: [Address!]
+}
+
+type Transaction {
+    hash: Bytes32!
+    """Type is the name of the transaction type, e.g. TxTypeFeeDelegatedValueTransfer."""
+    type: String!
+    """TypeInt is the number of the transaction type."""
+    typeInt: Int!
+    nonce: Long!
+    """Index is the index of the transaction in the block. It is null for a pending transaction."""
+    index: Int
+    from: Address!
+    to: Address
+    value: BigInt!
+    gasPrice: BigInt!
+    gas: Long!
+    input: Bytes!
+    """FeePayer is the address paying the fee of a fee-delegated transaction."""
+    feePayer: Address
+    """FeeRatio is the percentage of the fee paid by the fee payer. It is null unless the fee is partially delegated."""
+    feeRatio: Int
+    senderTxHash: Bytes32!
+    """Block is the block including the transaction. It is null for a pending transaction."""
+    block: Block
+    status: Long
+    gasUsed: Long
+    contractAddress: Address
+    logs: [Log!]
+    """Key is the new account key of an account update transaction."""
+    key: AccountKey
+    """AnchoredData is the anchored data of a chain data anchoring transaction."""
+    anchoredData: AnchoringData
+}
+
+type Log {
+    index: Int!
+    account: Address!
+    topics: [Bytes32!]!
+    data: Bytes!
+    blockNumber: Long!
+    transaction: Transaction!
+}
+
+type Account {
+    address: Address!
+    balance: BigInt!
+    nonce: Long!
+    code: Bytes!
+    storage(slot: Bytes32!): Bytes32!
+    """Type is the type of the account, e.g. ExternallyOwnedAccount. It is null if the account does not exist."""
+    type: String
+    """Key is the account key of the account."""
+    key: AccountKey!
+}
+
+type AccountKey {
+    """Type is the name of the account key type, e.g. AccountKeyWeightedMultiSig."""
+    type: String!
+    keyType: Int!
+    """PublicKeys is the list of the compressed public keys."""
+    publicKeys: [Bytes!]!
+    """Threshold is the threshold of a weighted multisig key."""
+    threshold: Int
+    """Weights is the list of the weights of the public keys of a weighted multisig key."""
+    weights: [Int!]
+    """Roles is the list of the keys of the transaction, account update and fee payer roles."""
+    roles: [AccountKey!]
+}
+
+type AnchoringData {
+    """Type is the type of the anchoring data: legacy, type0 or merkle."""
+    type: String!
+    blockHash: Bytes32!
+    blockNumber: BigInt!
+    blockCount: BigInt
+    txCount: BigInt
+    parentHash: Bytes32
+    transactionsRoot: Bytes32
+    receiptsRoot: Bytes32
+    stateRoot: Bytes32
+    """MerkleRoot is the Merkle root of the block hashes from fromBlockNumber to blockNumber."""
+    merkleRoot: Bytes32
+    fromBlockNumber: BigInt
+}
+`
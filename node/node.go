@@ -24,6 +24,7 @@ import (
 	"errors"
 	"fmt"
 	"net"
+	"net/http"
 	"os"
 	"path/filepath"
 	"reflect"
@@ -36,6 +37,8 @@ import (
 	"github.com/bt51/ntpclient"
 	"github.com/klaytn/klaytn/accounts"
 	"github.com/klaytn/klaytn/api/debug"
+	"github.com/klaytn/klaytn/blockchain"
+	"github.com/klaytn/klaytn/blockchain/types"
 	"github.com/klaytn/klaytn/event"
 	"github.com/klaytn/klaytn/log"
 	metricutils "github.com/klaytn/klaytn/metrics/utils"
@@ -68,16 +71,19 @@ type Node struct {
 	services    map[reflect.Type]Service // Currently running services
 
 	rpcAPIs       []rpc.API
-	inprocHandler *rpc.Server // In-process RPC request handler to process the API requests
+	rpcCacheSub   event.Subscription // Subscription of the SetHead events purging the RPC response cache
+	rpcRecorder   *rpc.Recorder      // Recorder of the sampled RPC calls (nil = recording disabled)
+	inprocHandler *rpc.Server        // In-process RPC request handler to process the API requests
 
 	ipcEndpoint string       // IPC endpoint to listen at (empty = IPC disabled)
 	ipcListener net.Listener // IPC RPC listener socket to serve API requests
 	ipcHandler  *rpc.Server  // IPC RPC request handler to process the API requests
 
-	httpEndpoint  string       // HTTP endpoint (interface + port) to listen at (empty = HTTP disabled)
-	httpWhitelist []string     // HTTP RPC modules to allow through this endpoint
-	httpListener  net.Listener // HTTP RPC listener socket to server API requests
-	httpHandler   *rpc.Server  // HTTP RPC request handler to process the API requests
+	httpEndpoint  string                  // HTTP endpoint (interface + port) to listen at (empty = HTTP disabled)
+	httpWhitelist []string                // HTTP RPC modules to allow through this endpoint
+	httpListener  net.Listener            // HTTP RPC listener socket to server API requests
+	httpHandler   *rpc.Server             // HTTP RPC request handler to process the API requests
+	httpHandlers  map[string]http.Handler // HTTP handlers of the services served at their paths
 
 	wsEndpoint string       // Websocket endpoint (interface + port) to listen at (empty = websocket disabled)
 	wsListener net.Listener // Websocket RPC listener socket to server API requests
@@ -334,43 +340,142 @@ func (n *Node) openDataDir() error {
 // assumptions about the state of the node.
 func (n *Node) startRPC(services map[reflect.Type]Service) error {
 	apis := n.apis()
+	n.httpHandlers = make(map[string]http.Handler)
 	for _, service := range services {
 		apis = append(apis, service.APIs()...)
+		if hs, ok := service.(HTTPHandlerService); ok {
+			for path, handler := range hs.HTTPHandlers() {
+				n.httpHandlers[path] = handler
+			}
+		}
 	}
+	if err := n.startRPCRecorder(); err != nil {
+		return err
+	}
+
 	// Start the various API endpoints, terminating all in case of errors
 	if err := n.startInProc(apis); err != nil {
+		n.stopRPCRecorder()
 		return err
 	}
 	if err := n.startIPC(apis); err != nil {
 		n.stopInProc()
+		n.stopRPCRecorder()
 		return err
 	}
 
 	if err := n.startHTTP(n.httpEndpoint, apis, n.config.HTTPModules, n.config.HTTPCors, n.config.HTTPVirtualHosts, n.config.HTTPTimeouts); err != nil {
 		n.stopIPC()
 		n.stopInProc()
+		n.stopRPCRecorder()
 		return err
 	}
 	if err := n.startWS(n.wsEndpoint, apis, n.config.WSModules, n.config.WSOrigins, n.config.WSExposeAll); err != nil {
 		n.stopHTTP()
 		n.stopIPC()
 		n.stopInProc()
+		n.stopRPCRecorder()
 		return err
 	}
 
 	// start gRPC server
-	if err := n.startgRPC(apis); err != nil {
+	if err := n.startgRPC(apis, services); err != nil {
 		n.stopHTTP()
 		n.stopIPC()
 		n.stopInProc()
+		n.stopRPCRecorder()
 		return err
 	}
 	// All API endpoints started successfully
+	n.startRPCCache(services)
 	n.rpcAPIs = apis
 
 	return nil
 }
 
+// rpcCacheChain is the chain whose head decides which RPC responses are immutable.
+type rpcCacheChain interface {
+	CurrentBlock() *types.Block
+	SubscribeSetHeadEvent(ch chan<- blockchain.SetHeadEvent) event.Subscription
+}
+
+// startRPCCache enables the cache of the immutable RPC responses if it is configured
+// and one of the services provides the chain. The cache is purged whenever the head
+// of the chain is rewound.
+func (n *Node) startRPCCache(services map[reflect.Type]Service) {
+	if n.config.RPCCacheSize <= 0 {
+		return
+	}
+	for _, service := range services {
+		for _, component := range service.Components() {
+			chain, ok := component.(rpcCacheChain)
+			if !ok {
+				continue
+			}
+			cache := rpc.NewResponseCache(n.config.RPCCacheSize*1024*1024, n.config.RPCCacheTTL, func() uint64 {
+				return chain.CurrentBlock().NumberU64()
+			})
+			setHeadCh := make(chan blockchain.SetHeadEvent, 1)
+			n.rpcCacheSub = chain.SubscribeSetHeadEvent(setHeadCh)
+			go func(sub event.Subscription) {
+				for {
+					select {
+					case ev := <-setHeadCh:
+						cache.Purge()
+						n.logger.Info("Purged the RPC response cache", "head", ev.Block.NumberU64())
+					case <-sub.Err():
+						return
+					}
+				}
+			}(n.rpcCacheSub)
+			rpc.SetResponseCache(cache)
+			n.logger.Info("RPC response cache enabled", "size(MB)", n.config.RPCCacheSize, "ttl", n.config.RPCCacheTTL)
+			return
+		}
+	}
+	n.logger.Warn("RPC response cache is not enabled since no service provides the chain")
+}
+
+// stopRPCCache disables the cache of the immutable RPC responses.
+func (n *Node) stopRPCCache() {
+	if n.rpcCacheSub != nil {
+		n.rpcCacheSub.Unsubscribe()
+		n.rpcCacheSub = nil
+		rpc.SetResponseCache(nil)
+	}
+}
+
+// startRPCRecorder starts recording the sampled RPC calls if it is configured.
+func (n *Node) startRPCRecorder() error {
+	if n.config.RPCRecordDir == "" {
+		return nil
+	}
+	recorder, err := rpc.NewRecorder(rpc.RecorderConfig{
+		Dir:         n.config.ResolvePath(n.config.RPCRecordDir),
+		SampleRate:  n.config.RPCRecordSampleRate,
+		MaxFileSize: int64(n.config.RPCRecordFileSize) * 1024 * 1024,
+		MaxFiles:    n.config.RPCRecordFiles,
+	})
+	if err != nil {
+		return err
+	}
+	n.rpcRecorder = recorder
+	rpc.SetRecorder(recorder)
+	n.logger.Info("RPC recording enabled", "dir", n.config.RPCRecordDir, "samplerate", n.config.RPCRecordSampleRate)
+	return nil
+}
+
+// stopRPCRecorder stops recording the RPC calls.
+func (n *Node) stopRPCRecorder() {
+	if n.rpcRecorder != nil {
+		rpc.SetRecorder(nil)
+		if err := n.rpcRecorder.Close(); err != nil {
+			n.logger.Error("Failed to close the RPC recorder", "err", err)
+		}
+		n.rpcRecorder = nil
+	}
+}
+
 // startInProc initializes an in-process RPC endpoint.
 func (n *Node) startInProc(apis []rpc.API) error {
 	// Register all the APIs exposed by the services
@@ -398,7 +503,7 @@ func (n *Node) startIPC(apis []rpc.API) error {
 	if n.ipcEndpoint == "" {
 		return nil // IPC disabled.
 	}
-	listener, handler, err := rpc.StartIPCEndpoint(n.ipcEndpoint, apis)
+	listener, handler, err := rpc.StartIPCEndpoint(n.ipcEndpoint, apis, n.config.IPCLimits)
 	if err != nil {
 		return err
 	}
@@ -423,7 +528,7 @@ func (n *Node) stopIPC() {
 }
 
 // startgRPC initializes and starts the gRPC endpoint.
-func (n *Node) startgRPC(apis []rpc.API) error {
+func (n *Node) startgRPC(apis []rpc.API, services map[reflect.Type]Service) error {
 	if n.grpcEndpoint == "" {
 		return nil
 	}
@@ -442,6 +547,14 @@ func (n *Node) startgRPC(apis []rpc.API) error {
 	n.grpcHandler = handler
 	n.grpcListener = listener
 	listener.SetRPCServer(handler)
+	// Serve the typed API if a service provides its backend
+	for _, service := range services {
+		for _, component := range service.Components() {
+			if backend, ok := component.(grpc.Backend); ok {
+				listener.SetBackend(backend)
+			}
+		}
+	}
 
 	go listener.Start()
 	n.logger.Info("gRPC endpoint opened", "url", n.grpcEndpoint)
@@ -454,11 +567,14 @@ func (n *Node) startHTTP(endpoint string, apis []rpc.API, modules []string, cors
 	if endpoint == "" {
 		return nil
 	}
-	listener, handler, err := rpc.StartHTTPEndpoint(endpoint, apis, modules, cors, vhosts, timeouts)
+	listener, handler, err := rpc.StartHTTPEndpointWithHandlers(endpoint, apis, modules, cors, vhosts, timeouts, n.httpHandlers, n.config.HTTPLimits)
 	if err != nil {
 		return err
 	}
 	n.logger.Info("HTTP endpoint opened", "url", fmt.Sprintf("http://%s", endpoint), "cors", strings.Join(cors, ","), "vhosts", strings.Join(vhosts, ","))
+	for path := range n.httpHandlers {
+		n.logger.Info("HTTP handler registered", "url", fmt.Sprintf("http://%s%s", endpoint, path))
+	}
 	// All listeners booted successfully
 	n.httpEndpoint = endpoint
 	n.httpListener = listener
@@ -473,7 +589,7 @@ func (n *Node) startFastHTTP(endpoint string, apis []rpc.API, modules []string,
 	if endpoint == "" {
 		return nil
 	}
-	listener, handler, err := rpc.StartFastHTTPEndpoint(endpoint, apis, modules, cors, vhosts, timeouts)
+	listener, handler, err := rpc.StartFastHTTPEndpoint(endpoint, apis, modules, cors, vhosts, timeouts, n.config.HTTPLimits)
 	if err != nil {
 		return err
 	}
@@ -506,7 +622,7 @@ func (n *Node) startWS(endpoint string, apis []rpc.API, modules []string, wsOrig
 	if endpoint == "" {
 		return nil
 	}
-	listener, handler, err := rpc.StartWSEndpoint(endpoint, apis, modules, wsOrigins, exposeAll)
+	listener, handler, err := rpc.StartWSEndpoint(endpoint, apis, modules, wsOrigins, exposeAll, n.config.WSLimits)
 	if err != nil {
 		return err
 	}
@@ -525,7 +641,7 @@ func (n *Node) startFastWS(endpoint string, apis []rpc.API, modules []string, ws
 	if endpoint == "" {
 		return nil
 	}
-	listener, handler, err := rpc.StartFastWSEndpoint(endpoint, apis, modules, wsOrigins, exposeAll)
+	listener, handler, err := rpc.StartFastWSEndpoint(endpoint, apis, modules, wsOrigins, exposeAll, n.config.WSLimits)
 	if err != nil {
 		return err
 	}
@@ -582,6 +698,8 @@ func (n *Node) Stop() error {
 	n.stopHTTP()
 	n.stopIPC()
 	n.stopgRPC()
+	n.stopRPCCache()
+	n.stopRPCRecorder()
 	n.rpcAPIs = nil
 	failure := &StopError{
 		Services: make(map[reflect.Type]error),
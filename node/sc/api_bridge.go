@@ -120,6 +120,12 @@ func (sb *SubBridgeAPI) GetAnchoringTxHashByBlockNumber(bn uint64) common.Hash {
 	return receipt.TxHash
 }
 
+// GetAnchoringProof returns the inclusion proof of the block in the Merkle root anchored to
+// the parent chain. It returns nil if the block is not anchored by the Merkle anchoring.
+func (sb *SubBridgeAPI) GetAnchoringProof(bn uint64) *types.AnchoringProof {
+	return sb.subBridge.handler.GetAnchoringProof(bn)
+}
+
 func (sb *SubBridgeAPI) RegisterOperator(bridgeAddr, operatorAddr common.Address) (common.Hash, error) {
 	return sb.subBridge.bridgeManager.RegisterOperator(bridgeAddr, operatorAddr)
 }
@@ -25,7 +25,11 @@ import (
 	"testing"
 	"time"
 
+	"github.com/klaytn/klaytn/accounts"
 	"github.com/klaytn/klaytn/accounts/keystore"
+	"github.com/klaytn/klaytn/blockchain/types"
+	"github.com/klaytn/klaytn/common"
+	"github.com/klaytn/klaytn/crypto"
 	"github.com/klaytn/klaytn/storage/database"
 	"github.com/stretchr/testify/assert"
 )
@@ -198,3 +202,58 @@ func TestBridgeAccountInformation(t *testing.T) {
 	assert.Equal(t, cRes["isNonceSynced"], bAcc.cAccount.isNonceSynced)
 	assert.Equal(t, cRes["isUnlocked"], bAcc.cAccount.IsUnlockedAccount())
 }
+
+// TestBridgeAccountExternalOperator checks the operator account of the account manager is used
+// instead of the bridge account key.
+func TestBridgeAccountExternalOperator(t *testing.T) {
+	tempDir, err := ioutil.TempDir(os.TempDir(), "sc")
+	assert.NoError(t, err)
+	defer os.RemoveAll(tempDir)
+
+	// The keystore of the account manager stands for the external signer.
+	ks := keystore.NewKeyStore(path.Join(tempDir, "signer"), keystore.LightScryptN, keystore.LightScryptP)
+	operator, err := ks.NewAccount("pwd")
+	assert.NoError(t, err)
+	assert.NoError(t, ks.Unlock(operator, "pwd"))
+	am := accounts.NewManager(ks)
+
+	db := database.NewDBManager(&database.DBConfig{DBType: database.MemoryDB})
+	_, err = NewBridgeAccountsWithOperators(am, tempDir, db, DefaultBridgeTxGasLimit, DefaultBridgeTxGasLimit, common.HexToAddress("0x1"), common.Address{})
+	assert.Equal(t, accounts.ErrUnknownAccount, err)
+
+	bAcc, err := NewBridgeAccountsWithOperators(am, tempDir, db, DefaultBridgeTxGasLimit, DefaultBridgeTxGasLimit, operator.Address, common.Address{})
+	assert.NoError(t, err)
+	assert.Equal(t, operator.Address, bAcc.pAccount.address)
+	assert.NoDirExists(t, path.Join(tempDir, ParentBridgeAccountName))
+	assert.DirExists(t, path.Join(tempDir, ChildBridgeAccountName))
+
+	pAcc := bAcc.pAccount
+	pAcc.SetChainID(big.NewInt(1000))
+	assert.True(t, pAcc.IsUnlockedAccount())
+	assert.Equal(t, errExternalOperator, pAcc.LockAccount())
+	assert.Equal(t, errExternalOperator, pAcc.UnLockAccount("pwd", nil))
+
+	// The transactions and the hashes are signed by the operator.
+	tx := types.NewTransaction(0, common.HexToAddress("0x2"), big.NewInt(1), 21000, big.NewInt(25), nil)
+	opts := pAcc.GenerateTransactOpts()
+	signer := types.LatestSignerForChainID(pAcc.chainID)
+	signed, err := opts.Signer(signer, operator.Address, tx)
+	assert.NoError(t, err)
+	from, err := types.Sender(signer, signed)
+	assert.NoError(t, err)
+	assert.Equal(t, operator.Address, from)
+
+	signed, err = pAcc.SignTx(tx)
+	assert.NoError(t, err)
+	from, err = types.Sender(signer, signed)
+	assert.NoError(t, err)
+	assert.Equal(t, operator.Address, from)
+
+	hash := common.HexToHash("0x1234")
+	sig, err := pAcc.SignHash(hash)
+	assert.NoError(t, err)
+	sig[crypto.RecoveryIDOffset] -= 27
+	pub, err := crypto.SigToPub(hash.Bytes(), sig)
+	assert.NoError(t, err)
+	assert.Equal(t, operator.Address, crypto.PubkeyToAddress(*pub))
+}
@@ -32,6 +32,7 @@ import (
 	"github.com/klaytn/klaytn/blockchain/types"
 	"github.com/klaytn/klaytn/cmd/homi/setup"
 	"github.com/klaytn/klaytn/common"
+	"github.com/klaytn/klaytn/crypto"
 	"github.com/klaytn/klaytn/params"
 )
 
@@ -42,7 +43,10 @@ const (
 	ChildBridgeAccountName  = "child_bridge_account"
 )
 
-var errUnlockDurationTooLarge = errors.New("unlock duration too large")
+var (
+	errUnlockDurationTooLarge = errors.New("unlock duration too large")
+	errExternalOperator       = errors.New("the operator account is not in the bridge account keystore")
+)
 
 type feePayerDB interface {
 	WriteParentOperatorFeePayer(feePayer common.Address)
@@ -55,6 +59,7 @@ type feePayerDB interface {
 type accountInfo struct {
 	am          *accounts.Manager  // the account manager of the node for the fee payer.
 	keystore    *keystore.KeyStore // the keystore of the operator.
+	wallet      accounts.Wallet    // the wallet of the operator instead of the keystore, e.g., of the external signer.
 	address     common.Address
 	nonce       uint64
 	chainID     *big.Int
@@ -147,29 +152,29 @@ func (ba *BridgeAccounts) GetParentKIP71Config() params.KIP71Config {
 
 // NewBridgeAccounts returns bridgeAccounts created by main/service bridge account keys.
 func NewBridgeAccounts(am *accounts.Manager, dataDir string, db feePayerDB, parentOperatorGaslimit, childOperatorGaslimit uint64) (*BridgeAccounts, error) {
-	pKS, pAccAddr, isLock, err := InitializeBridgeAccountKeystore(path.Join(dataDir, ParentBridgeAccountName))
+	return NewBridgeAccountsWithOperators(am, dataDir, db, parentOperatorGaslimit, childOperatorGaslimit, common.Address{}, common.Address{})
+}
+
+// NewBridgeAccountsWithOperators returns bridgeAccounts whose operators are the given accounts of the
+// account manager, e.g., the accounts of the external signer. The bridge account key of an operator
+// is used if its address is not given.
+func NewBridgeAccountsWithOperators(am *accounts.Manager, dataDir string, db feePayerDB, parentOperatorGaslimit, childOperatorGaslimit uint64, parentOperator, childOperator common.Address) (*BridgeAccounts, error) {
+	pKS, pWallet, pAccAddr, err := initializeOperatorAccount(am, path.Join(dataDir, ParentBridgeAccountName), parentOperator)
 	if err != nil {
 		return nil, err
 	}
-
-	if isLock {
-		logger.Warn("parent bridge account is locked. Please unlock the account manually for Service Chain", "name", ParentBridgeAccountName)
-	}
-
-	cKS, cAccAddr, isLock, err := InitializeBridgeAccountKeystore(path.Join(dataDir, ChildBridgeAccountName))
+	cKS, cWallet, cAccAddr, err := initializeOperatorAccount(am, path.Join(dataDir, ChildBridgeAccountName), childOperator)
 	if err != nil {
 		return nil, err
 	}
 
-	if isLock {
-		logger.Warn("child bridge account is locked. Please unlock the account manually for Service Chain", "name", ChildBridgeAccountName)
-	}
-
-	logger.Info("bridge account is loaded", "parent", pAccAddr.String(), "child", cAccAddr.String())
+	logger.Info("bridge account is loaded", "parent", pAccAddr.String(), "child", cAccAddr.String(),
+		"externalParent", pWallet != nil, "externalChild", cWallet != nil)
 
 	pAccInfo := &accountInfo{
 		am:       am,
 		keystore: pKS,
+		wallet:   pWallet,
 		address:  pAccAddr,
 		nonce:    0,
 		chainID:  nil,
@@ -181,6 +186,7 @@ func NewBridgeAccounts(am *accounts.Manager, dataDir string, db feePayerDB, pare
 	cAccInfo := &accountInfo{
 		am:       am,
 		keystore: cKS,
+		wallet:   cWallet,
 		address:  cAccAddr,
 		nonce:    0,
 		chainID:  nil,
@@ -196,6 +202,30 @@ func NewBridgeAccounts(am *accounts.Manager, dataDir string, db feePayerDB, pare
 	}, nil
 }
 
+// initializeOperatorAccount returns the wallet of the operator from the account manager if the operator
+// is given, or the bridge account keystore at the path otherwise.
+func initializeOperatorAccount(am *accounts.Manager, keystorePath string, operator common.Address) (*keystore.KeyStore, accounts.Wallet, common.Address, error) {
+	if operator != (common.Address{}) {
+		if am == nil {
+			return nil, nil, common.Address{}, accounts.ErrUnknownAccount
+		}
+		wallet, err := am.Find(accounts.Account{Address: operator})
+		if err != nil {
+			return nil, nil, common.Address{}, err
+		}
+		return nil, wallet, operator, nil
+	}
+
+	ks, addr, isLock, err := InitializeBridgeAccountKeystore(keystorePath)
+	if err != nil {
+		return nil, nil, common.Address{}, err
+	}
+	if isLock {
+		logger.Warn("bridge account is locked. Please unlock the account manually for Service Chain", "name", path.Base(keystorePath))
+	}
+	return ks, nil, addr, nil
+}
+
 // InitializeBridgeAccountKeystore initializes a keystore, imports existing keys, and tries to unlock the bridge account.
 // This returns the 1st account of the wallet, its address, the lock status and the error.
 func InitializeBridgeAccountKeystore(keystorePath string) (*keystore.KeyStore, common.Address, bool, error) {
@@ -265,12 +295,37 @@ func (acc *accountInfo) GenerateTransactOpts() *bind.TransactOpts {
 		gasPrice = new(big.Int).SetUint64(acc.kip71Config.UpperBoundBaseFee)
 	}
 
+	if acc.wallet != nil {
+		return &bind.TransactOpts{
+			From: acc.address,
+			Signer: func(signer types.Signer, address common.Address, tx *types.Transaction) (*types.Transaction, error) {
+				if address != acc.address {
+					return nil, errors.New("not authorized to sign this account")
+				}
+				return acc.wallet.SignTx(accounts.Account{Address: address}, tx, acc.chainID)
+			},
+			Nonce:    nonce,
+			GasLimit: acc.gasLimit,
+			GasPrice: gasPrice,
+		}
+	}
 	return bind.MakeTransactOptsWithKeystore(acc.keystore, acc.address, nonce, acc.chainID, acc.gasLimit, gasPrice)
 }
 
+// signer returns the wallet or the keystore signing with the account.
+func (acc *accountInfo) signer() interface {
+	SignTx(account accounts.Account, tx *types.Transaction, chainID *big.Int) (*types.Transaction, error)
+	SignHash(account accounts.Account, hash []byte) ([]byte, error)
+} {
+	if acc.wallet != nil {
+		return acc.wallet
+	}
+	return acc.keystore
+}
+
 // SignTx signs a transaction with the accountInfo.
 func (acc *accountInfo) SignTx(tx *types.Transaction) (*types.Transaction, error) {
-	tx, err := acc.keystore.SignTx(accounts.Account{Address: acc.address}, tx, acc.chainID)
+	tx, err := acc.signer().SignTx(accounts.Account{Address: acc.address}, tx, acc.chainID)
 	if err != nil {
 		return nil, err
 	}
@@ -289,6 +344,16 @@ func (acc *accountInfo) SignTx(tx *types.Transaction) (*types.Transaction, error
 	return tx, nil
 }
 
+// SignHash signs the hash with the accountInfo. The V of the signature is 27 or 28.
+func (acc *accountInfo) SignHash(hash common.Hash) ([]byte, error) {
+	sig, err := acc.signer().SignHash(accounts.Account{Address: acc.address}, hash.Bytes())
+	if err != nil {
+		return nil, err
+	}
+	sig[crypto.RecoveryIDOffset] += 27
+	return sig, nil
+}
+
 // SetChainID sets the chain ID of the chain of the account.
 func (acc *accountInfo) SetChainID(cID *big.Int) {
 	acc.chainID = cID
@@ -331,6 +396,10 @@ func (acc *accountInfo) LockAccount() error {
 	acc.mu.Lock()
 	defer acc.mu.Unlock()
 
+	if acc.keystore == nil {
+		return errExternalOperator
+	}
+
 	if err := acc.keystore.Lock(acc.address); err != nil {
 		logger.Error("Failed to lock the account", "account", acc.address)
 		return err
@@ -353,6 +422,9 @@ func (acc *accountInfo) UnLockAccount(passphrase string, duration *uint64) error
 	} else {
 		d = time.Duration(*duration) * time.Second
 	}
+	if acc.keystore == nil {
+		return errExternalOperator
+	}
 
 	if err := acc.keystore.TimedUnlock(acc.keystore.Accounts()[0], passphrase, d); err != nil {
 		logger.Error("Failed to unlock the account", "account", acc.address)
@@ -363,8 +435,12 @@ func (acc *accountInfo) UnLockAccount(passphrase string, duration *uint64) error
 }
 
 // IsUnlockedAccount can return if the account is unlocked or not.
+// The account of an operator not in the keystore is regarded as unlocked.
 func (acc *accountInfo) IsUnlockedAccount() bool {
 	acc.mu.Lock()
 	defer acc.mu.Unlock()
+	if acc.keystore == nil {
+		return true
+	}
 	return acc.keystore.IsUnlocked(acc.address)
 }
@@ -38,6 +38,7 @@ import (
 const (
 	TokenEventChanSize  = 10000
 	BridgeAddrJournal   = "bridge_addrs.rlp"
+	VTSignatureJournal  = "bridge_vt_signatures.rlp"
 	maxPendingNonceDiff = 1000 // TODO-Klaytn-ServiceChain: update this limitation. Currently, 2 * 500 TPS.
 
 	maxHandledEventSize = 10000000
@@ -316,6 +317,10 @@ func (bi *BridgeInfo) handleRequestValueTransferEvent(ev IRequestValueTransferEv
 		logger.Info("Register counter part token address.", "addr", ctpartTokenAddr.Hex(), "cpAddr", ctTokenAddr.Hex())
 	}
 
+	if bi.subBridge != nil && bi.subBridge.vtSignatures != nil {
+		return bi.signValueTransfer(ev, ctpartTokenAddr)
+	}
+
 	bridgeAcc := bi.account
 
 	bridgeAcc.Lock()
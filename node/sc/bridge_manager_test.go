@@ -1810,6 +1810,108 @@ func TestAnchoringStart(t *testing.T) {
 	}
 }
 
+// TestMerkleAnchoring tests the following:
+// 1. set anchoring period 4 with the Merkle anchoring
+// 2. check if the Merkle root of the block hashes in the period is anchored
+// 3. check if the inclusion proofs of the blocks are stored only after the anchoring tx is executed
+func TestMerkleAnchoring(t *testing.T) {
+	tempDir, err := ioutil.TempDir(os.TempDir(), "merkleAnchoring")
+	assert.NoError(t, err)
+	defer func() {
+		if err := os.RemoveAll(tempDir); err != nil {
+			t.Fatalf("fail to delete file %v", err)
+		}
+	}()
+
+	config := &SCConfig{AnchoringPeriod: 4, MerkleAnchoring: true}
+	config.DataDir = tempDir
+
+	bAcc, _ := NewBridgeAccounts(nil, tempDir, database.NewDBManager(&database.DBConfig{DBType: database.MemoryDB}), DefaultBridgeTxGasLimit, DefaultBridgeTxGasLimit)
+	bAcc.pAccount.chainID = big.NewInt(0)
+	bAcc.cAccount.chainID = big.NewInt(0)
+
+	sim := backends.NewSimulatedBackend(blockchain.GenesisAlloc{})
+	defer sim.Close()
+
+	sc := &SubBridge{
+		config:         config,
+		peers:          newBridgePeerSet(),
+		localBackend:   sim,
+		remoteBackend:  sim,
+		bridgeAccounts: bAcc,
+		chainDB:        database.NewMemoryDBManager(),
+	}
+	sc.blockchain = sim.BlockChain()
+
+	sc.handler, err = NewSubBridgeHandler(sc)
+	assert.NoError(t, err)
+	sc.bridgeTxPool = bridgepool.NewBridgeTxPool(bridgepool.BridgeTxPoolConfig{
+		Journal:     path.Join(tempDir, "bridge_transactions.rlp"),
+		GlobalQueue: 1024,
+	})
+
+	sim.Commit() // start with arbitrary block number.
+
+	// The anchoring period starts from block 2.
+	auth := bAcc.pAccount.GenerateTransactOpts()
+	_, _, _, err = bridge.DeployBridge(auth, sim, true) // dummy tx
+	assert.NoError(t, err)
+	sim.Commit()
+	var blocks []*types.Block
+	for i := 0; i < 3; i++ {
+		curBlk := sim.BlockChain().CurrentBlock()
+		blocks = append(blocks, curBlk)
+		assert.NoError(t, sc.handler.blockAnchoringManager(curBlk))
+		sim.Commit()
+	}
+	assert.Equal(t, uint64(4), blocks[2].NumberU64())
+
+	pending := sc.GetBridgeTxPool().Pending()
+	assert.Equal(t, 1, len(pending))
+	var tx *types.Transaction
+	for _, v := range pending {
+		assert.Equal(t, 1, len(v))
+		tx = v[0]
+	}
+
+	data, err := tx.AnchoredData()
+	assert.NoError(t, err)
+	decodedData, err := types.DecodeAnchoringData(data)
+	assert.NoError(t, err)
+	merkle, ok := decodedData.(*types.AnchoringDataMerkle)
+	assert.True(t, ok)
+	assert.Equal(t, blocks[2].Hash(), merkle.BlockHash)
+	assert.Equal(t, big.NewInt(2).String(), merkle.FromBlockNumber.String())
+	assert.Equal(t, big.NewInt(3).String(), merkle.BlockCount.String())
+	assert.Equal(t, big.NewInt(1).String(), merkle.TxCount.String())
+
+	// The proofs are not stored until the receipt of the anchoring tx is received.
+	for _, block := range blocks {
+		assert.Nil(t, sc.handler.GetAnchoringProof(block.NumberU64()))
+	}
+
+	// The proofs are not stored if the anchored Merkle root does not match the local blocks.
+	mismatched := *merkle
+	mismatched.MerkleRoot = common.Hash{}
+	assert.ErrorIs(t, sc.handler.writeAnchoringProofs(&mismatched, tx.Hash()), ErrMerkleRootMismatch)
+	assert.Nil(t, sc.handler.GetAnchoringProof(blocks[0].NumberU64()))
+
+	receipt := &types.Receipt{Status: types.ReceiptStatusSuccessful, TxHash: tx.Hash(), Logs: []*types.Log{}}
+	sc.handler.writeServiceChainTxReceipts(sc.blockchain, []*types.ReceiptForStorage{(*types.ReceiptForStorage)(receipt)})
+	assert.Nil(t, sc.GetBridgeTxPool().Get(tx.Hash()))
+
+	for _, block := range blocks {
+		proof := sc.handler.GetAnchoringProof(block.NumberU64())
+		if assert.NotNil(t, proof) {
+			assert.Equal(t, block.Hash(), proof.BlockHash)
+			assert.Equal(t, merkle.MerkleRoot, proof.MerkleRoot)
+			assert.Equal(t, tx.Hash(), proof.AnchoringTxHash)
+			assert.True(t, proof.Verify())
+		}
+	}
+	assert.Nil(t, sc.handler.GetAnchoringProof(1))
+}
+
 // TestAnchoringPeriod tests the following:
 // 1. set anchoring period 4
 // 2. accumulate tx counts
@@ -134,6 +134,9 @@ type BridgePeer interface {
 
 	// SendServiceChainInvalidTxResponse sends a response that contains list of invalid tx and error from parent chain.
 	SendServiceChainInvalidTxResponse(invalidTxs []InvalidParentChainTx) error
+
+	// SendValueTransferSignatures sends the signatures of the bridge operators on value transfers.
+	SendValueTransferSignatures(sigs []*valueTransferSignature) error
 }
 
 // baseBridgePeer is a common data structure used by implementation of Peer.
@@ -259,6 +262,10 @@ func (p *baseBridgePeer) SendServiceChainInvalidTxResponse(invalidTxs []InvalidP
 	return p2p.Send(p.rw, ServiceChainInvalidTxResponseMsg, invalidTxs)
 }
 
+func (p *baseBridgePeer) SendValueTransferSignatures(sigs []*valueTransferSignature) error {
+	return p2p.Send(p.rw, ServiceChainVTSignatureMsg, sigs)
+}
+
 // Handshake executes the Klaytn protocol handshake, negotiating version number,
 // network IDs, difficulties, head and genesis blocks.
 func (p *baseBridgePeer) Handshake(network uint64, chainID, td *big.Int, head common.Hash) error {
@@ -371,6 +371,20 @@ func (mr *MockBridgePeerMockRecorder) SendServiceChainTxs(arg0 interface{}) *gom
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendServiceChainTxs", reflect.TypeOf((*MockBridgePeer)(nil).SendServiceChainTxs), arg0)
 }
 
+// SendValueTransferSignatures mocks base method.
+func (m *MockBridgePeer) SendValueTransferSignatures(arg0 []*valueTransferSignature) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SendValueTransferSignatures", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendValueTransferSignatures indicates an expected call of SendValueTransferSignatures.
+func (mr *MockBridgePeerMockRecorder) SendValueTransferSignatures(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendValueTransferSignatures", reflect.TypeOf((*MockBridgePeer)(nil).SendValueTransferSignatures), arg0)
+}
+
 // SetAddr mocks base method.
 func (m *MockBridgePeer) SetAddr(arg0 common.Address) {
 	m.ctrl.T.Helper()
@@ -95,10 +95,17 @@ type SCConfig struct {
 	ParentChainID                      uint64
 	VTRecovery                         bool
 	VTRecoveryInterval                 uint64
+	VTThresholdSigning                 bool
 	Anchoring                          bool
+	MerkleAnchoring                    bool
 	ServiceChainParentOperatorGasLimit uint64
 	ServiceChainChildOperatorGasLimit  uint64
 
+	// The operator accounts of the account manager, e.g., of the external signer, used instead of
+	// the bridge account keys if set.
+	ServiceChainParentOperator common.Address `toml:",omitempty"`
+	ServiceChainChildOperator  common.Address `toml:",omitempty"`
+
 	// KAS
 	KASAnchor               bool
 	KASAnchorUrl            string
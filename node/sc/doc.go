@@ -34,6 +34,8 @@ They are directly connected on the network layer and communicate with each other
 MainBridge is configured on the node of a parent chain and SubBridge is configured on the node of a child chain.
 Both of a Klaytn chain and a Service Chain can be a parent chain, but only a Service Chain can be a child chain.
 The block data of a child chain can be anchored to the bridge contract of MainBridge with the chain data anchoring transaction.
+With the Merkle anchoring, a single anchoring transaction anchors the Merkle root of the block hashes in an anchoring period,
+and SubBridge keeps the inclusion proof of each block which can be retrieved with subbridge_getAnchoringProof.
 
 Unlike the block data anchoring, user data transfer is bi-directional.
 For example, users can transfer KLAY of Klaytn main chain to an address of a Service Chain or vice versa.
@@ -69,5 +71,7 @@ Functions and variables related to Service Chain are defined in the files listed
   - sub_event_handler.go : implements a event handler of SubBridge.
   - subbridge.go : implements SubBridge of the child chain node.
   - vt_recovery.go : provides recovery from the service failure for inter-chain value transfer.
+  - vt_signature.go : collects the signatures of the bridge operators and handles a value transfer with a threshold of them in a single transaction.
+  - vt_signature_journal.go : provides a journal mechanism for the signatures of the bridge operators on value transfers.
 */
 package sc
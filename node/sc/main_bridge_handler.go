@@ -75,6 +75,11 @@ func (mbh *MainBridgeHandler) HandleSubMsg(p BridgePeer, msg p2p.Msg) error {
 		if err := mbh.handleServiceChainReceiptRequestMsg(p, msg); err != nil {
 			return err
 		}
+	case ServiceChainVTSignatureMsg:
+		logger.Trace("received ServiceChainVTSignatureMsg")
+		if err := mbh.handleValueTransferSignatureMsg(p, msg); err != nil {
+			return err
+		}
 	default:
 		return errResp(ErrInvalidMsgCode, "%v", msg.Code)
 	}
@@ -196,3 +201,37 @@ func (mbh *MainBridgeHandler) handleServiceChainReceiptRequestMsg(p BridgePeer,
 	}
 	return p.SendServiceChainReceiptResponse(receiptsForStorage)
 }
+
+// handleValueTransferSignatureMsg relays the signatures of a bridge operator on value transfers
+// to the other child chain peers, since the operators are connected through the main bridge.
+// Only the signatures made by the operators of the bridges on this chain are relayed.
+func (mbh *MainBridgeHandler) handleValueTransferSignatureMsg(p BridgePeer, msg p2p.Msg) error {
+	var received []*valueTransferSignature
+	if err := msg.Decode(&received); err != nil {
+		return errResp(ErrDecode, "msg %v: %v", msg, err)
+	}
+	caller := mbh.mainbridge.bridgeCaller
+	if caller == nil {
+		return nil
+	}
+	sigs := make([]*valueTransferSignature, 0, len(received))
+	for _, sig := range received {
+		if err := validateVTSignatureOnParentChain(caller, sig); err != nil {
+			logger.Debug("Dropped an invalid value transfer signature", "peer", p.GetID(), "bridge", sig.Bridge.String(), "nonce", sig.RequestNonce, "err", err)
+			continue
+		}
+		sigs = append(sigs, sig)
+	}
+	if len(sigs) == 0 {
+		return nil
+	}
+	for _, peer := range mbh.mainbridge.BridgePeerSet().Peers() {
+		if peer.GetID() == p.GetID() || peer.GetVersion() < SCProtocol3 {
+			continue
+		}
+		if err := peer.SendValueTransferSignatures(sigs); err != nil {
+			logger.Warn("Failed to relay value transfer signatures", "peer", peer.GetID(), "err", err)
+		}
+	}
+	return nil
+}
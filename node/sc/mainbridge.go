@@ -30,6 +30,8 @@ import (
 	"time"
 
 	"github.com/klaytn/klaytn/accounts"
+	"github.com/klaytn/klaytn/accounts/abi/bind"
+	"github.com/klaytn/klaytn/accounts/abi/bind/backends"
 	"github.com/klaytn/klaytn/api"
 	"github.com/klaytn/klaytn/blockchain"
 	"github.com/klaytn/klaytn/blockchain/types"
@@ -96,6 +98,8 @@ type MainBridge struct {
 	blockchain *blockchain.BlockChain
 	txPool     *blockchain.TxPool
 
+	bridgeCaller bind.ContractCaller // calls the bridge contracts on this chain
+
 	chainHeadCh  chan blockchain.ChainHeadEvent
 	chainHeadSub event.Subscription
 	logsCh       chan []*types.Log
@@ -227,6 +231,7 @@ func (mb *MainBridge) SetComponents(components []interface{}) {
 		switch v := component.(type) {
 		case *blockchain.BlockChain:
 			mb.blockchain = v
+			mb.bridgeCaller = backends.NewBlockchainContractBackend(v, nil, nil)
 			// event from core-service
 			mb.chainHeadSub = mb.blockchain.SubscribeChainHeadEvent(mb.chainHeadCh)
 			mb.logsSub = mb.blockchain.SubscribeLogsEvent(mb.logsCh)
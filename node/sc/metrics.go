@@ -43,6 +43,9 @@ var (
 	vtRequestEventMeter = metrics.NewRegisteredMeter("klay/bridge/vt/event/request", nil)
 	vtHandleEventMeter  = metrics.NewRegisteredMeter("klay/bridge/vt/event/handle", nil)
 
+	vtSignatureInMeter    = metrics.NewRegisteredMeter("klay/bridge/vt/signature/in", nil)
+	vtSignedHandleTxMeter = metrics.NewRegisteredMeter("klay/bridge/vt/signature/handletx", nil)
+
 	vtRecoveredRequestEventMeter = metrics.NewRegisteredMeter("klay/bridge/vt/event/recovery/request", nil)
 	vtPendingRequestEventCounter = metrics.NewRegisteredCounter("klay/bridge/vt/event/pend/request", nil)
 
@@ -44,12 +44,20 @@ const (
 	ServiceChainNotify   = 0x08
 
 	ServiceChainInvalidTxResponseMsg = 0x09
+
+	// Protocol messages belonging to servicechain/3
+	ServiceChainVTSignatureMsg = 0x0a
+)
+
+const (
+	SCProtocol2 = 2
+	SCProtocol3 = 3 // exchanges the signatures of bridge operators on value transfers
 )
 
 var (
 	SCProtocolName    = "servicechain"
-	SCProtocolVersion = []uint{2}
-	SCProtocolLength  = []uint64{10}
+	SCProtocolVersion = []uint{SCProtocol3, SCProtocol2}
+	SCProtocolLength  = []uint64{11, 10}
 )
 
 // Protocol defines the protocol of the consensus
@@ -97,3 +105,17 @@ type statusData struct {
 	CurrentBlock    common.Hash
 	ChainID         *big.Int // A child chain must know parent chain's ChainID to sign a transaction.
 }
+
+// valueTransferSignature is the network packet for the signature of a bridge operator on a value transfer.
+// The operators sign keccak256(Bridge, CallHash), where CallHash is the hash of the call data of the
+// handle value transfer function, and one of them submits the call with a threshold of the signatures.
+type valueTransferSignature struct {
+	Bridge       common.Address // the bridge contract handling the value transfer
+	RequestNonce uint64
+	CallHash     common.Hash
+	Signature    []byte // [R || S || V] with V of 27 or 28
+
+	// Counterpart is the parent chain bridge of the pair if Bridge is on the child chain. It is not signed,
+	// but lets the main bridge validate the signer with the operators of the parent chain bridge.
+	Counterpart common.Address `rlp:"optional"`
+}
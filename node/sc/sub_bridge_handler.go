@@ -37,6 +37,7 @@ const (
 var (
 	ErrInvalidBlock              = errors.New("block is invalid")
 	ErrUnknownBridgeContractAddr = errors.New("The given address was not found in the bridge contract list")
+	ErrMerkleRootMismatch        = errors.New("anchored merkle root does not match the local blocks")
 )
 
 // parentChainInfo handles the information of parent chain, which is needed from child chain.
@@ -260,6 +261,11 @@ func (sbh *SubBridgeHandler) HandleMainMsg(p BridgePeer, msg p2p.Msg) error {
 		if err := sbh.handleParentChainInvalidTxResponseMsg(msg); err != nil {
 			return err
 		}
+	case ServiceChainVTSignatureMsg:
+		logger.Trace("received ServiceChainVTSignatureMsg")
+		if err := sbh.handleValueTransferSignatureMsg(msg); err != nil {
+			return err
+		}
 	default:
 		return errResp(ErrInvalidMsgCode, "%v", msg.Code)
 	}
@@ -320,9 +326,19 @@ func (sbh *SubBridgeHandler) handleParentChainReceiptResponseMsg(p BridgePeer, m
 }
 
 // genUnsignedChainDataAnchoringTx generates an unsigned transaction, which type is TxTypeChainDataAnchoring.
+// If the block hashes of the anchoring period are given, the Merkle root of them is anchored instead of the block.
 // Nonce of account used for service chain transaction will be increased after the signing.
-func (sbh *SubBridgeHandler) genUnsignedChainDataAnchoringTx(block *types.Block) (*types.Transaction, error) {
-	anchoringData, err := types.NewAnchoringDataType0(block, block.NumberU64()-sbh.txCountStartingBlockNumber+1, sbh.txCount)
+func (sbh *SubBridgeHandler) genUnsignedChainDataAnchoringTx(block *types.Block, blockHashes []common.Hash) (*types.Transaction, error) {
+	var (
+		anchoringData *types.AnchoringData
+		err           error
+	)
+	if blockHashes != nil {
+		fromBlockNumber := block.NumberU64() - uint64(len(blockHashes)) + 1
+		anchoringData, err = types.NewAnchoringDataMerkle(fromBlockNumber, blockHashes, sbh.txCount)
+	} else {
+		anchoringData, err = types.NewAnchoringDataType0(block, block.NumberU64()-sbh.txCountStartingBlockNumber+1, sbh.txCount)
+	}
 	if err != nil {
 		return nil, err
 	}
@@ -448,6 +464,12 @@ func (sbh *SubBridgeHandler) writeServiceChainTxReceipts(bc *blockchain.BlockCha
 				}
 				sbh.WriteReceiptFromParentChain(decodedData.GetBlockHash(), (*types.Receipt)(receipt))
 				sbh.WriteAnchoredBlockNumber(decodedData.GetBlockNumber().Uint64())
+				// The proofs are valid only if the Merkle root is anchored on the parent chain.
+				if merkle, ok := decodedData.(*types.AnchoringDataMerkle); ok && receipt.Status == types.ReceiptStatusSuccessful {
+					if err := sbh.writeAnchoringProofs(merkle, txHash); err != nil {
+						logger.Error("failed to write anchoring proofs", "txHash", txHash.String(), "err", err)
+					}
+				}
 			}
 			// TODO-Klaytn-ServiceChain: support other tx types if needed.
 			sbh.subbridge.GetBridgeTxPool().RemoveTx(tx)
@@ -534,7 +556,15 @@ func (sbh *SubBridgeHandler) generateAndAddAnchoringTxIntoTxPool(block *types.Bl
 	sbh.LockParentOperator()
 	defer sbh.UnLockParentOperator()
 
-	unsignedTx, err := sbh.genUnsignedChainDataAnchoringTx(block)
+	var blockHashes []common.Hash
+	if sbh.subbridge.config.MerkleAnchoring {
+		var err error
+		if blockHashes, err = sbh.anchoringBlockHashes(block); err != nil {
+			logger.Error("Failed to collect the block hashes to anchor", "blockNum", block.NumberU64(), "err", err)
+			return err
+		}
+	}
+	unsignedTx, err := sbh.genUnsignedChainDataAnchoringTx(block, blockHashes)
 	if err != nil {
 		logger.Error("Failed to generate service chain transaction", "blockNum", block.NumberU64(), "err", err)
 		return err
@@ -555,12 +585,77 @@ func (sbh *SubBridgeHandler) generateAndAddAnchoringTxIntoTxPool(block *types.Bl
 		logger.Debug("failed to add tx into bridge txpool", "err", err)
 		return err
 	}
-
 	logger.Info("Generate an anchoring tx", "blockNum", block.NumberU64(), "blockhash", block.Hash().String(), "txCount", txCount, "txHash", signedTx.Hash().String())
 
 	return nil
 }
 
+// anchoringBlockHashes returns the hashes of the blocks from the start of the anchoring period to the given block.
+func (sbh *SubBridgeHandler) anchoringBlockHashes(block *types.Block) ([]common.Hash, error) {
+	from := sbh.txCountStartingBlockNumber
+	if from == 0 || from > block.NumberU64() {
+		from = block.NumberU64()
+	}
+	var hashes []common.Hash
+	if from < block.NumberU64() {
+		var err error
+		if hashes, err = sbh.blockHashes(from, block.NumberU64()-1); err != nil {
+			return nil, err
+		}
+	}
+	return append(hashes, block.Hash()), nil
+}
+
+// blockHashes returns the hashes of the local blocks from the block number from to to.
+func (sbh *SubBridgeHandler) blockHashes(from, to uint64) ([]common.Hash, error) {
+	var hashes []common.Hash
+	for i := from; i <= to; i++ {
+		header := sbh.subbridge.blockchain.GetHeaderByNumber(i)
+		if header == nil {
+			return nil, fmt.Errorf("%w: missing block %d", ErrInvalidBlock, i)
+		}
+		hashes = append(hashes, header.Hash())
+	}
+	return hashes, nil
+}
+
+// writeAnchoringProofs writes the inclusion proofs of the blocks in the Merkle root anchored by
+// the given tx, so that the blocks can be verified against the anchoring tx of the parent chain.
+// It should be called only after the anchoring tx is executed successfully on the parent chain.
+func (sbh *SubBridgeHandler) writeAnchoringProofs(data *types.AnchoringDataMerkle, txHash common.Hash) error {
+	from, to := data.FromBlockNumber.Uint64(), data.BlockNumber.Uint64()
+	if from > to {
+		return fmt.Errorf("%w: invalid anchoring range %d-%d", ErrInvalidBlock, from, to)
+	}
+	blockHashes, err := sbh.blockHashes(from, to)
+	if err != nil {
+		return err
+	}
+	tree := types.NewMerkleTree(blockHashes)
+	if tree.Root() != data.MerkleRoot {
+		return ErrMerkleRootMismatch
+	}
+	proofs := make([]*types.AnchoringProof, len(blockHashes))
+	for i, hash := range blockHashes {
+		proofs[i] = &types.AnchoringProof{
+			BlockNumber:     from + uint64(i),
+			BlockHash:       hash,
+			Siblings:        tree.Proof(i),
+			MerkleRoot:      tree.Root(),
+			FromBlockNumber: from,
+			ToBlockNumber:   to,
+			AnchoringTxHash: txHash,
+		}
+	}
+	sbh.subbridge.chainDB.WriteAnchoringProofs(proofs)
+	return nil
+}
+
+// GetAnchoringProof returns the inclusion proof of the block in the Merkle root anchored to the parent chain.
+func (sbh *SubBridgeHandler) GetAnchoringProof(blockNum uint64) *types.AnchoringProof {
+	return sbh.subbridge.chainDB.ReadAnchoringProof(blockNum)
+}
+
 // SyncNonceAndGasPrice requests the nonce of address used for service chain tx to parent chain peers.
 func (scpm *SubBridgeHandler) SyncNonceAndGasPrice() {
 	addr := scpm.GetParentOperatorAddr()
@@ -600,3 +695,55 @@ func (sbh *SubBridgeHandler) WriteReceiptFromParentChain(blockHash common.Hash,
 func (sbh *SubBridgeHandler) GetReceiptFromParentChain(blockHash common.Hash) *types.Receipt {
 	return sbh.subbridge.chainDB.ReadReceiptFromParentChain(blockHash)
 }
+
+// handleValueTransferSignatureMsg collects the signatures of the other bridge operators on value transfers,
+// and submits the value transfers if a threshold of signatures are collected.
+func (sbh *SubBridgeHandler) handleValueTransferSignatureMsg(msg p2p.Msg) error {
+	var sigs []*valueTransferSignature
+	if err := msg.Decode(&sigs); err != nil {
+		return errResp(ErrDecode, "msg %v: %v", msg, err)
+	}
+	pool := sbh.subbridge.vtSignatures
+	if pool == nil {
+		return nil
+	}
+	vtSignatureInMeter.Mark(int64(len(sigs)))
+
+	for _, sig := range sigs {
+		// Only the signatures of the operators on the bridges managed by this node are collected.
+		bi, ok := sbh.subbridge.bridgeManager.GetBridgeInfo(sig.Bridge)
+		if !ok {
+			logger.Trace("Dropped a value transfer signature of an unknown bridge", "bridge", sig.Bridge.String(), "nonce", sig.RequestNonce)
+			continue
+		}
+		if err := bi.validateVTSignature(sig); err != nil {
+			logger.Debug("Dropped an invalid value transfer signature", "bridge", sig.Bridge.String(), "nonce", sig.RequestNonce, "err", err)
+			continue
+		}
+		added, err := pool.add(sig)
+		if err != nil {
+			logger.Debug("Dropped a value transfer signature", "bridge", sig.Bridge.String(), "nonce", sig.RequestNonce, "err", err)
+			continue
+		}
+		if !added {
+			continue
+		}
+		if err := bi.submitSignedValueTransfer(sig.RequestNonce, false); err != nil {
+			logger.Warn("Failed to submit a signed value transfer", "bridge", sig.Bridge.String(), "nonce", sig.RequestNonce, "err", err)
+		}
+	}
+	return nil
+}
+
+// broadcastValueTransferSignatures sends the signatures of this bridge operator on value transfers
+// to the main bridge peers, which relay them to the other bridge operators.
+func (sbh *SubBridgeHandler) broadcastValueTransferSignatures(sigs []*valueTransferSignature) {
+	for _, peer := range sbh.subbridge.BridgePeerSet().Peers() {
+		if peer.GetVersion() < SCProtocol3 {
+			continue
+		}
+		if err := peer.SendValueTransferSignatures(sigs); err != nil {
+			logger.Warn("Failed to send value transfer signatures", "peer", peer.GetID(), "err", err)
+		}
+	}
+}
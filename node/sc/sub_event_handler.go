@@ -92,6 +92,9 @@ func (cce *ChildChainEventHandler) ProcessHandleEvent(ev *HandleValueTransferEve
 
 	handleBridgeInfo.MarkHandledNonce(ev.HandleNonce)
 	handleBridgeInfo.UpdateLowerHandleNonce(ev.LowerHandleNonce)
+	if pool := cce.subbridge.vtSignatures; pool != nil {
+		pool.prune(ev.Raw.Address, ev.LowerHandleNonce)
+	}
 
 	logger.Trace("RequestValueTransfer Event",
 		"bridgeAddr", ev.Raw.Address.String(),
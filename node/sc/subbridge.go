@@ -163,6 +163,10 @@ type SubBridge struct {
 
 	bridgeAccounts *BridgeAccounts
 
+	// vtSignatures collects the signatures of the bridge operators on value transfers
+	// if the value transfers are handled with a threshold of signatures.
+	vtSignatures *vtSignaturePool
+
 	bootFail bool
 
 	// service on/off
@@ -218,7 +222,8 @@ func NewSubBridge(ctx *node.ServiceContext, config *SCConfig) (*SubBridge, error
 	sb.bridgeTxPool = bridgepool.NewBridgeTxPool(bridgetxConfig)
 
 	var err error
-	sb.bridgeAccounts, err = NewBridgeAccounts(sb.accountManager, config.DataDir, chainDB, sb.config.ServiceChainParentOperatorGasLimit, sb.config.ServiceChainChildOperatorGasLimit)
+	sb.bridgeAccounts, err = NewBridgeAccountsWithOperators(sb.accountManager, config.DataDir, chainDB, sb.config.ServiceChainParentOperatorGasLimit, sb.config.ServiceChainChildOperatorGasLimit,
+		sb.config.ServiceChainParentOperator, sb.config.ServiceChainChildOperator)
 	if err != nil {
 		return nil, err
 	}
@@ -232,6 +237,10 @@ func NewSubBridge(ctx *node.ServiceContext, config *SCConfig) (*SubBridge, error
 	}
 	sb.bridgeAccounts.pAccount.SetChainID(new(big.Int).SetUint64(config.ParentChainID))
 
+	if config.VTThresholdSigning {
+		sb.vtSignatures = newVTSignaturePool(path.Join(config.DataDir, VTSignatureJournal))
+	}
+
 	return sb, nil
 }
 
@@ -773,5 +782,9 @@ func (sb *SubBridge) Stop() error {
 	sb.bridgeTxPool.Stop()
 	sb.bridgeServer.Stop()
 
+	if sb.vtSignatures != nil {
+		sb.vtSignatures.stop()
+	}
+
 	return nil
 }
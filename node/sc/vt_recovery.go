@@ -193,9 +193,23 @@ func (vtr *valueTransferRecovery) updateRecoveryHint() error {
 		vtr.parent2childHint.candidate = true
 	}
 
+	vtr.pruneSignatures()
+
 	return nil
 }
 
+// pruneSignatures removes the journaled signatures on the value transfers which are already handled.
+// The signatures on pending value transfers are kept, so that the operators re-share and submit them
+// when the pending events are recovered, even after they are restarted.
+func (vtr *valueTransferRecovery) pruneSignatures() {
+	if vtr.cBridgeInfo.subBridge == nil || vtr.cBridgeInfo.subBridge.vtSignatures == nil {
+		return
+	}
+	pool := vtr.cBridgeInfo.subBridge.vtSignatures
+	pool.prune(vtr.pBridgeInfo.address, vtr.child2parentHint.handleNonce)
+	pool.prune(vtr.cBridgeInfo.address, vtr.parent2childHint.handleNonce)
+}
+
 // updateRecoveryHint updates a hint for the one-way value transfers.
 func updateRecoveryHintFromTo(prevHint *valueTransferHint, from, to *BridgeInfo) (*valueTransferHint, error) {
 	var err error
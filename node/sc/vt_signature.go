@@ -0,0 +1,462 @@
+// Copyright 2024 The klaytn Authors
+// This file is part of the klaytn library.
+//
+// The klaytn library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The klaytn library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the klaytn library. If not, see <http://www.gnu.org/licenses/>.
+
+package sc
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/klaytn/klaytn/accounts/abi"
+	"github.com/klaytn/klaytn/accounts/abi/bind"
+	"github.com/klaytn/klaytn/common"
+	bridgecontract "github.com/klaytn/klaytn/contracts/bridge"
+	"github.com/klaytn/klaytn/crypto"
+)
+
+// handleVTWithSignaturesABI is the ABI of the bridge contract function which handles a value transfer
+// with the signatures of the operators instead of their votes.
+const handleVTWithSignaturesABI = `[{"constant":false,"inputs":[{"name":"_handleCall","type":"bytes"},{"name":"_signatures","type":"bytes"}],"name":"handleValueTransferWithSignatures","outputs":[],"payable":false,"stateMutability":"nonpayable","type":"function"}]`
+
+const handleVTWithSignaturesMethod = "handleValueTransferWithSignatures"
+
+const (
+	// maxVTSignatureNonces is the maximum number of the request nonces of a bridge with pending signatures.
+	maxVTSignatureNonces = maxPendingNonceDiff
+	// maxVTSignaturesPerNonce is the maximum number of the pending signatures on the value transfer of a request nonce.
+	maxVTSignaturesPerNonce = 64
+)
+
+var (
+	ErrInvalidVTSignature  = errors.New("invalid value transfer signature")
+	ErrUnknownTokenType    = errors.New("unknown token type")
+	ErrVTSignatureLimit    = errors.New("too many pending value transfer signatures")
+	ErrVTSignatureNonce    = errors.New("value transfer signature on a request nonce not pending")
+	ErrNotVTBridgeOperator = errors.New("value transfer signer is not an operator of the bridge")
+	ErrUnknownVTBridge     = errors.New("unknown bridge of value transfer signature")
+	ErrNoVTCounterpart     = errors.New("no counterpart bridge of value transfer signature")
+)
+
+var (
+	bridgeContractABI   = mustParseABI(bridgecontract.BridgeABI)
+	handleVTWithSigsABI = mustParseABI(handleVTWithSignaturesABI)
+)
+
+func mustParseABI(abiJSON string) abi.ABI {
+	parsed, err := abi.JSON(strings.NewReader(abiJSON))
+	if err != nil {
+		panic(err)
+	}
+	return parsed
+}
+
+// vtSignatureDigest returns the digest signed by the bridge operators for the handle value transfer call.
+func vtSignatureDigest(bridge common.Address, callHash common.Hash) common.Hash {
+	return crypto.Keccak256Hash(bridge.Bytes(), callHash.Bytes())
+}
+
+// signer returns the operator who made the signature.
+func (sig *valueTransferSignature) signer() (common.Address, error) {
+	if len(sig.Signature) != crypto.SignatureLength {
+		return common.Address{}, ErrInvalidVTSignature
+	}
+	v := sig.Signature[crypto.RecoveryIDOffset]
+	if v != 27 && v != 28 {
+		return common.Address{}, ErrInvalidVTSignature
+	}
+	rsv := common.CopyBytes(sig.Signature)
+	rsv[crypto.RecoveryIDOffset] -= 27
+
+	pub, err := crypto.SigToPub(vtSignatureDigest(sig.Bridge, sig.CallHash).Bytes(), rsv)
+	if err != nil {
+		return common.Address{}, ErrInvalidVTSignature
+	}
+	return crypto.PubkeyToAddress(*pub), nil
+}
+
+// packHandleValueTransferCall returns the call data of the handle value transfer function for the request.
+func packHandleValueTransferCall(ev IRequestValueTransferEvent, ctpartTokenAddr common.Address) ([]byte, error) {
+	var (
+		txHash                  = [32]byte(ev.GetRaw().TxHash)
+		from, to                = ev.GetFrom(), ev.GetTo()
+		valueOrTokenId          = ev.GetValueOrTokenId()
+		requestNonce, blkNumber = ev.GetRequestNonce(), ev.GetRaw().BlockNumber
+		extraData               = ev.GetExtraData()
+	)
+	switch ev.GetTokenType() {
+	case KLAY:
+		return bridgeContractABI.Pack(handleVTmethods[KLAY], txHash, from, to, valueOrTokenId, requestNonce, blkNumber, extraData)
+	case ERC20:
+		return bridgeContractABI.Pack(handleVTmethods[ERC20], txHash, from, to, ctpartTokenAddr, valueOrTokenId, requestNonce, blkNumber, extraData)
+	case ERC721:
+		return bridgeContractABI.Pack(handleVTmethods[ERC721], txHash, from, to, ctpartTokenAddr, valueOrTokenId, requestNonce, blkNumber, GetURI(ev), extraData)
+	}
+	return nil, ErrUnknownTokenType
+}
+
+type vtSignatureKey struct {
+	bridge common.Address
+	nonce  uint64
+}
+
+// vtSignatureEntry holds the signatures on the value transfer of a request nonce. They are grouped by
+// the call hash, since the operators can sign different calls if they disagree on the request.
+type vtSignatureEntry struct {
+	sigs  map[common.Hash]map[common.Address]*valueTransferSignature
+	count int
+
+	// the handle call signed by this node and whether it has been submitted.
+	handleCall    []byte
+	callHash      common.Hash
+	requestTxHash common.Hash
+	submitted     bool
+}
+
+// vtSignaturePool collects the signatures of the bridge operators on value transfers until
+// the value transfers are handled. The signatures are journaled to survive node restarts.
+type vtSignaturePool struct {
+	entries map[vtSignatureKey]*vtSignatureEntry
+	nonces  map[common.Address]int // the number of the request nonces with signatures by bridge
+	journal *vtSignatureJournal
+	mu      sync.Mutex
+}
+
+// newVTSignaturePool creates a signature pool which loads the signatures from the journal at the path.
+// The signatures are not journaled if the path is empty.
+func newVTSignaturePool(path string) *vtSignaturePool {
+	pool := &vtSignaturePool{
+		entries: make(map[vtSignatureKey]*vtSignatureEntry),
+		nonces:  make(map[common.Address]int),
+	}
+	if path == "" {
+		return pool
+	}
+	pool.journal = newVTSignatureJournal(path)
+	if err := pool.journal.load(func(sig *valueTransferSignature) error {
+		_, err := pool.add(sig)
+		return err
+	}); err != nil {
+		logger.Error("Failed to load value transfer signature journal", "err", err)
+	}
+	if err := pool.journal.rotate(pool.all()); err != nil {
+		logger.Error("Failed to rotate value transfer signature journal", "err", err)
+	}
+	return pool
+}
+
+// add adds the signature to the pool, and returns true if it is a new signature. It returns
+// ErrVTSignatureLimit if the bridge or the request nonce has too many pending signatures.
+func (pool *vtSignaturePool) add(sig *valueTransferSignature) (bool, error) {
+	signer, err := sig.signer()
+	if err != nil {
+		return false, err
+	}
+
+	pool.mu.Lock()
+	defer pool.mu.Unlock()
+
+	key := vtSignatureKey{sig.Bridge, sig.RequestNonce}
+	entry, ok := pool.entries[key]
+	if !ok {
+		if pool.nonces[sig.Bridge] >= maxVTSignatureNonces {
+			return false, ErrVTSignatureLimit
+		}
+		entry = &vtSignatureEntry{sigs: make(map[common.Hash]map[common.Address]*valueTransferSignature)}
+		pool.entries[key] = entry
+		pool.nonces[sig.Bridge]++
+	}
+	if _, exist := entry.sigs[sig.CallHash][signer]; exist {
+		return false, nil
+	}
+	if entry.count >= maxVTSignaturesPerNonce {
+		return false, ErrVTSignatureLimit
+	}
+	if entry.sigs[sig.CallHash] == nil {
+		entry.sigs[sig.CallHash] = make(map[common.Address]*valueTransferSignature)
+	}
+	entry.sigs[sig.CallHash][signer] = sig
+	entry.count++
+
+	if pool.journal != nil {
+		if err := pool.journal.insert(sig); err != nil {
+			logger.Warn("Failed to journal value transfer signature", "err", err)
+		}
+	}
+	return true, nil
+}
+
+// get returns the signature of the signer on the call of the value transfer.
+func (pool *vtSignaturePool) get(bridge common.Address, nonce uint64, callHash common.Hash, signer common.Address) *valueTransferSignature {
+	pool.mu.Lock()
+	defer pool.mu.Unlock()
+
+	if entry, ok := pool.entries[vtSignatureKey{bridge, nonce}]; ok {
+		return entry.sigs[callHash][signer]
+	}
+	return nil
+}
+
+// setHandleCall sets the handle call of the value transfer signed by this node.
+func (pool *vtSignaturePool) setHandleCall(bridge common.Address, nonce uint64, handleCall []byte, requestTxHash common.Hash) {
+	pool.mu.Lock()
+	defer pool.mu.Unlock()
+
+	if entry, ok := pool.entries[vtSignatureKey{bridge, nonce}]; ok {
+		entry.handleCall = handleCall
+		entry.callHash = crypto.Keccak256Hash(handleCall)
+		entry.requestTxHash = requestTxHash
+	}
+}
+
+// handleCall returns the handle call of the value transfer signed by this node, and its signatures
+// made by the given operators sorted by the address of the signers. It returns nil if this node
+// has not signed the value transfer yet.
+func (pool *vtSignaturePool) handleCall(bridge common.Address, nonce uint64, operators []common.Address) ([]byte, common.Hash, [][]byte) {
+	pool.mu.Lock()
+	defer pool.mu.Unlock()
+
+	entry, ok := pool.entries[vtSignatureKey{bridge, nonce}]
+	if !ok || entry.handleCall == nil {
+		return nil, common.Hash{}, nil
+	}
+	signers := make([]common.Address, 0, len(operators))
+	for _, operator := range operators {
+		if _, ok := entry.sigs[entry.callHash][operator]; ok {
+			signers = append(signers, operator)
+		}
+	}
+	sort.Slice(signers, func(i, j int) bool {
+		return bytes.Compare(signers[i].Bytes(), signers[j].Bytes()) < 0
+	})
+	sigs := make([][]byte, len(signers))
+	for i, signer := range signers {
+		sigs[i] = entry.sigs[entry.callHash][signer].Signature
+	}
+	return entry.handleCall, entry.requestTxHash, sigs
+}
+
+// setSubmitted sets whether the value transfer is submitted, and returns the previous status.
+func (pool *vtSignaturePool) setSubmitted(bridge common.Address, nonce uint64, submitted bool) bool {
+	pool.mu.Lock()
+	defer pool.mu.Unlock()
+
+	entry, ok := pool.entries[vtSignatureKey{bridge, nonce}]
+	if !ok {
+		return false
+	}
+	prev := entry.submitted
+	entry.submitted = submitted
+	return prev
+}
+
+// prune removes the signatures on the value transfers of the bridge below the lower handle nonce.
+func (pool *vtSignaturePool) prune(bridge common.Address, lowerHandleNonce uint64) {
+	pool.mu.Lock()
+	defer pool.mu.Unlock()
+
+	pruned := 0
+	for key := range pool.entries {
+		if key.bridge == bridge && key.nonce < lowerHandleNonce {
+			delete(pool.entries, key)
+			pruned++
+		}
+	}
+	if pool.nonces[bridge] -= pruned; pool.nonces[bridge] <= 0 {
+		delete(pool.nonces, bridge)
+	}
+	if pruned > 0 && pool.journal != nil {
+		if err := pool.journal.rotate(pool.all()); err != nil {
+			logger.Error("Failed to rotate value transfer signature journal", "err", err)
+		}
+	}
+}
+
+// all returns all signatures in the pool. The caller must hold the lock if the pool is in use.
+func (pool *vtSignaturePool) all() []*valueTransferSignature {
+	var sigs []*valueTransferSignature
+	for _, entry := range pool.entries {
+		for _, signed := range entry.sigs {
+			for _, sig := range signed {
+				sigs = append(sigs, sig)
+			}
+		}
+	}
+	return sigs
+}
+
+// stop closes the journal of the pool.
+func (pool *vtSignaturePool) stop() {
+	pool.mu.Lock()
+	defer pool.mu.Unlock()
+
+	if pool.journal != nil {
+		pool.journal.close()
+	}
+}
+
+// validateVTSignature checks that the signature received from a peer is made by an operator of
+// the bridge on a request nonce pending in the bridge.
+func (bi *BridgeInfo) validateVTSignature(sig *valueTransferSignature) error {
+	if sig.Bridge != bi.address {
+		return ErrUnknownVTBridge
+	}
+	if lower := bi.lowerHandleNonce; sig.RequestNonce < lower || sig.RequestNonce >= lower+maxVTSignatureNonces {
+		return ErrVTSignatureNonce
+	}
+	signer, err := sig.signer()
+	if err != nil {
+		return err
+	}
+	isOperator, err := bi.bridge.Operators(nil, signer)
+	if err != nil {
+		return err
+	}
+	if !isOperator {
+		return ErrNotVTBridgeOperator
+	}
+	return nil
+}
+
+// validateVTSignatureOnParentChain checks that the signature is made by an operator of the bridge on the
+// parent chain, or of the parent chain bridge paired with it if the bridge is on the child chain.
+func validateVTSignatureOnParentChain(caller bind.ContractCaller, sig *valueTransferSignature) error {
+	signer, err := sig.signer()
+	if err != nil {
+		return err
+	}
+	code, err := caller.CodeAt(context.Background(), sig.Bridge, nil)
+	if err != nil {
+		return err
+	}
+	bridge := sig.Bridge
+	if len(code) == 0 {
+		if sig.Counterpart == (common.Address{}) {
+			return ErrNoVTCounterpart
+		}
+		counterpart, err := bridgecontract.NewBridgeCaller(sig.Counterpart, caller)
+		if err != nil {
+			return err
+		}
+		if paired, err := counterpart.CounterpartBridge(nil); err != nil || paired != sig.Bridge {
+			return ErrUnknownVTBridge
+		}
+		bridge = sig.Counterpart
+	}
+	contract, err := bridgecontract.NewBridgeCaller(bridge, caller)
+	if err != nil {
+		return err
+	}
+	isOperator, err := contract.Operators(nil, signer)
+	if err != nil {
+		return ErrUnknownVTBridge
+	}
+	if !isOperator {
+		return ErrNotVTBridgeOperator
+	}
+	return nil
+}
+
+// signValueTransfer signs the handle value transfer call of the request with the operator account,
+// shares the signature with the other operators, and submits the call with their signatures if
+// this operator is in charge of it.
+func (bi *BridgeInfo) signValueTransfer(ev IRequestValueTransferEvent, ctpartTokenAddr common.Address) error {
+	pool := bi.subBridge.vtSignatures
+
+	handleCall, err := packHandleValueTransferCall(ev, ctpartTokenAddr)
+	if err != nil {
+		return err
+	}
+	var (
+		callHash = crypto.Keccak256Hash(handleCall)
+		nonce    = ev.GetRequestNonce()
+	)
+
+	// The operator signed the value transfer before if it is retried by the recovery or restored from the
+	// journal. In that case, the operator submits it without waiting for the operator in charge of it.
+	sig := pool.get(bi.address, nonce, callHash, bi.account.address)
+	retried := sig != nil
+	if !retried {
+		signature, err := bi.account.SignHash(vtSignatureDigest(bi.address, callHash))
+		if err != nil {
+			return err
+		}
+		sig = &valueTransferSignature{Bridge: bi.address, RequestNonce: nonce, CallHash: callHash, Signature: signature}
+		if bi.onChildChain {
+			sig.Counterpart = bi.counterpartAddress
+		}
+		if _, err := pool.add(sig); err != nil {
+			return err
+		}
+	}
+	pool.setHandleCall(bi.address, nonce, handleCall, ev.GetRaw().TxHash)
+	bi.subBridge.handler.broadcastValueTransferSignatures([]*valueTransferSignature{sig})
+
+	return bi.submitSignedValueTransfer(nonce, retried)
+}
+
+// submitSignedValueTransfer sends the handle value transfer call signed by this operator with a threshold
+// of the signatures, if they are collected. Unless forced, only the operator in charge of the request
+// nonce, which is chosen from the operator list of the bridge in turn, sends it.
+func (bi *BridgeInfo) submitSignedValueTransfer(nonce uint64, force bool) error {
+	pool := bi.subBridge.vtSignatures
+
+	operators, err := bi.bridge.GetOperatorList(nil)
+	if err != nil {
+		return err
+	}
+	threshold, err := bi.bridge.OperatorThresholds(nil, voteTypeValueTransfer)
+	if err != nil {
+		return err
+	}
+	handleCall, requestTxHash, sigs := pool.handleCall(bi.address, nonce, operators)
+	if handleCall == nil || len(sigs) < int(threshold) || len(operators) == 0 {
+		return nil
+	}
+	if !force && operators[nonce%uint64(len(operators))] != bi.account.address {
+		return nil
+	}
+	if pool.setSubmitted(bi.address, nonce, true) && !force {
+		return nil
+	}
+
+	bridgeAcc := bi.account
+
+	bridgeAcc.Lock()
+	defer bridgeAcc.UnLock()
+
+	backend := bi.subBridge.remoteBackend
+	if bi.onChildChain {
+		backend = bi.subBridge.localBackend
+	}
+	contract := bind.NewBoundContract(bi.address, handleVTWithSigsABI, backend, backend, backend)
+	handleTx, err := contract.Transact(bridgeAcc.GenerateTransactOpts(), handleVTWithSignaturesMethod, handleCall, bytes.Join(sigs[:threshold], nil))
+	if err != nil {
+		pool.setSubmitted(bi.address, nonce, false)
+		return err
+	}
+	bridgeAcc.IncNonce()
+	vtSignedHandleTxMeter.Mark(1)
+
+	logger.Trace("Bridge contract transaction is created with signatures", "bridge", bi.address.String(),
+		"nonce", nonce, "txHash", handleTx.Hash().String(), "signatures", threshold)
+
+	bi.bridgeDB.WriteHandleTxHashFromRequestTxHash(requestTxHash, handleTx.Hash())
+	return nil
+}
@@ -0,0 +1,138 @@
+// Copyright 2024 The klaytn Authors
+// This file is part of the klaytn library.
+//
+// The klaytn library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The klaytn library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the klaytn library. If not, see <http://www.gnu.org/licenses/>.
+
+package sc
+
+import (
+	"errors"
+	"io"
+	"os"
+
+	"github.com/klaytn/klaytn/node/sc/bridgepool"
+	"github.com/klaytn/klaytn/rlp"
+)
+
+var errNoActiveVTSignatureJournal = errors.New("no active value transfer signature journal")
+
+// vtSignatureJournal is a rotating log of the signatures on value transfers with the aim of
+// letting the collected signatures survive node restarts until the value transfers are handled.
+type vtSignatureJournal struct {
+	path   string         // Filesystem path to store the signatures at
+	writer io.WriteCloser // Output stream to write new signatures into
+}
+
+// newVTSignatureJournal creates a new value transfer signature journal.
+func newVTSignatureJournal(path string) *vtSignatureJournal {
+	return &vtSignatureJournal{
+		path: path,
+	}
+}
+
+// load parses a signature journal dump from disk, loading its contents into
+// the specified pool.
+func (journal *vtSignatureJournal) load(add func(sig *valueTransferSignature) error) error {
+	// Skip the parsing if the journal file doesn't exist at all
+	if _, err := os.Stat(journal.path); os.IsNotExist(err) {
+		return nil
+	}
+	input, err := os.Open(journal.path)
+	if err != nil {
+		return err
+	}
+	defer input.Close()
+
+	// Temporarily discard any journal additions (don't double add on load)
+	journal.writer = new(bridgepool.DevNull)
+	defer func() { journal.writer = nil }()
+
+	stream := rlp.NewStream(input, 0)
+	total, dropped := 0, 0
+
+	var failure error
+	for {
+		sig := new(valueTransferSignature)
+		if err = stream.Decode(sig); err != nil {
+			if err != io.EOF {
+				failure = err
+			}
+			break
+		}
+		total++
+
+		if err := add(sig); err != nil {
+			logger.Debug("Failed to add journaled value transfer signature", "err", err)
+			dropped++
+		}
+	}
+	logger.Info("Loaded value transfer signature journal", "signatures", total, "dropped", dropped)
+
+	return failure
+}
+
+// insert adds the specified signature to the local disk journal.
+func (journal *vtSignatureJournal) insert(sig *valueTransferSignature) error {
+	if journal.writer == nil {
+		return errNoActiveVTSignatureJournal
+	}
+	return rlp.Encode(journal.writer, sig)
+}
+
+// rotate regenerates the signature journal based on the current contents of the pool.
+func (journal *vtSignatureJournal) rotate(all []*valueTransferSignature) error {
+	// Close the current journal (if any is open)
+	if journal.writer != nil {
+		if err := journal.writer.Close(); err != nil {
+			return err
+		}
+		journal.writer = nil
+	}
+	// Generate a new journal with the contents of the current pool
+	replacement, err := os.OpenFile(journal.path+".new", os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0o600)
+	if err != nil {
+		return err
+	}
+	for _, sig := range all {
+		if err = rlp.Encode(replacement, sig); err != nil {
+			replacement.Close()
+			return err
+		}
+	}
+	replacement.Close()
+
+	// Replace the live journal with the newly generated one
+	if err = os.Rename(journal.path+".new", journal.path); err != nil {
+		return err
+	}
+	sink, err := os.OpenFile(journal.path, os.O_WRONLY|os.O_APPEND, 0o600)
+	if err != nil {
+		return err
+	}
+	journal.writer = sink
+	logger.Debug("Regenerated value transfer signature journal", "signatures", len(all))
+
+	return nil
+}
+
+// close flushes the signature journal contents to disk and closes the file.
+func (journal *vtSignatureJournal) close() error {
+	var err error
+
+	if journal.writer != nil {
+		err = journal.writer.Close()
+		journal.writer = nil
+	}
+	return err
+}
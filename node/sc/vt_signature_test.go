@@ -0,0 +1,263 @@
+// Copyright 2024 The klaytn Authors
+// This file is part of the klaytn library.
+//
+// The klaytn library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The klaytn library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the klaytn library. If not, see <http://www.gnu.org/licenses/>.
+
+package sc
+
+import (
+	"bytes"
+	"crypto/ecdsa"
+	"math/big"
+	"os"
+	"path"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/klaytn/klaytn/accounts/abi/bind"
+	"github.com/klaytn/klaytn/accounts/abi/bind/backends"
+	"github.com/klaytn/klaytn/blockchain"
+	"github.com/klaytn/klaytn/common"
+	bridgecontract "github.com/klaytn/klaytn/contracts/bridge"
+	"github.com/klaytn/klaytn/crypto"
+	"github.com/klaytn/klaytn/networks/p2p"
+	"github.com/klaytn/klaytn/params"
+	"github.com/klaytn/klaytn/rlp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func signTestValueTransfer(t *testing.T, key *ecdsa.PrivateKey, bridge common.Address, nonce uint64, callHash common.Hash) *valueTransferSignature {
+	sig, err := crypto.Sign(vtSignatureDigest(bridge, callHash).Bytes(), key)
+	assert.NoError(t, err)
+	sig[crypto.RecoveryIDOffset] += 27
+	return &valueTransferSignature{Bridge: bridge, RequestNonce: nonce, CallHash: callHash, Signature: sig}
+}
+
+func TestValueTransferSignature_Signer(t *testing.T) {
+	key, _ := crypto.GenerateKey()
+	bridge := common.HexToAddress("0x1")
+	sig := signTestValueTransfer(t, key, bridge, 1, crypto.Keccak256Hash([]byte("call")))
+
+	signer, err := sig.signer()
+	assert.NoError(t, err)
+	assert.Equal(t, crypto.PubkeyToAddress(key.PublicKey), signer)
+
+	// The signature on the other bridge is signed by someone else.
+	sig.Bridge = common.HexToAddress("0x2")
+	signer, err = sig.signer()
+	assert.NoError(t, err)
+	assert.NotEqual(t, crypto.PubkeyToAddress(key.PublicKey), signer)
+
+	// The recovery id should be 27 or 28.
+	sig.Signature[crypto.RecoveryIDOffset] -= 27
+	_, err = sig.signer()
+	assert.Equal(t, ErrInvalidVTSignature, err)
+
+	sig.Signature = sig.Signature[:crypto.SignatureLength-1]
+	_, err = sig.signer()
+	assert.Equal(t, ErrInvalidVTSignature, err)
+}
+
+func TestVTSignaturePool(t *testing.T) {
+	journalPath := path.Join(os.TempDir(), "test_vt_signatures.rlp")
+	defer os.Remove(journalPath)
+
+	var (
+		bridge     = common.HexToAddress("0x1")
+		handleCall = []byte("handle call")
+		callHash   = crypto.Keccak256Hash(handleCall)
+		keys       = make([]*ecdsa.PrivateKey, 3)
+		operators  = make([]common.Address, 3)
+	)
+	for i := range keys {
+		keys[i], _ = crypto.GenerateKey()
+		operators[i] = crypto.PubkeyToAddress(keys[i].PublicKey)
+	}
+
+	pool := newVTSignaturePool(journalPath)
+	for _, key := range keys {
+		added, err := pool.add(signTestValueTransfer(t, key, bridge, 1, callHash))
+		assert.NoError(t, err)
+		assert.True(t, added)
+	}
+	// The duplicated signature is not added.
+	added, err := pool.add(signTestValueTransfer(t, keys[0], bridge, 1, callHash))
+	assert.NoError(t, err)
+	assert.False(t, added)
+
+	// The signature on another call of the same nonce is kept apart.
+	_, err = pool.add(signTestValueTransfer(t, keys[0], bridge, 1, crypto.Keccak256Hash([]byte("other call"))))
+	assert.NoError(t, err)
+	_, err = pool.add(signTestValueTransfer(t, keys[0], bridge, 2, callHash))
+	assert.NoError(t, err)
+
+	// No handle call is returned before this node signs it.
+	call, _, sigs := pool.handleCall(bridge, 1, operators)
+	assert.Nil(t, call)
+	assert.Nil(t, sigs)
+
+	requestTxHash := common.HexToHash("0x1234")
+	pool.setHandleCall(bridge, 1, handleCall, requestTxHash)
+	call, txHash, sigs := pool.handleCall(bridge, 1, operators[:2])
+	assert.Equal(t, handleCall, call)
+	assert.Equal(t, requestTxHash, txHash)
+	assert.Equal(t, 2, len(sigs))
+
+	call, _, sigs = pool.handleCall(bridge, 1, operators)
+	assert.Equal(t, handleCall, call)
+	assert.Equal(t, 3, len(sigs))
+
+	// The signatures are sorted by the address of the signers.
+	prev := common.Address{}
+	for _, sig := range sigs {
+		signer, err := (&valueTransferSignature{Bridge: bridge, CallHash: callHash, Signature: sig}).signer()
+		assert.NoError(t, err)
+		assert.True(t, bytes.Compare(prev.Bytes(), signer.Bytes()) < 0)
+		prev = signer
+	}
+
+	assert.False(t, pool.setSubmitted(bridge, 1, true))
+	assert.True(t, pool.setSubmitted(bridge, 1, true))
+
+	// The signatures of the handled value transfers are pruned from the pool and the journal.
+	pool.prune(bridge, 2)
+	assert.Nil(t, pool.get(bridge, 1, callHash, operators[0]))
+	assert.NotNil(t, pool.get(bridge, 2, callHash, operators[0]))
+	pool.stop()
+
+	pool = newVTSignaturePool(journalPath)
+	defer pool.stop()
+	assert.Nil(t, pool.get(bridge, 1, callHash, operators[1]))
+	assert.NotNil(t, pool.get(bridge, 2, callHash, operators[0]))
+}
+
+func TestVTSignaturePool_Limits(t *testing.T) {
+	var (
+		bridge   = common.HexToAddress("0x1")
+		callHash = crypto.Keccak256Hash([]byte("call"))
+		key, _   = crypto.GenerateKey()
+	)
+	pool := newVTSignaturePool("")
+	defer pool.stop()
+
+	// The signatures on a request nonce are limited.
+	for i := 0; i < maxVTSignaturesPerNonce; i++ {
+		added, err := pool.add(signTestValueTransfer(t, key, bridge, 0, common.BigToHash(big.NewInt(int64(i)))))
+		require.NoError(t, err)
+		require.True(t, added)
+	}
+	_, err := pool.add(signTestValueTransfer(t, key, bridge, 0, callHash))
+	assert.Equal(t, ErrVTSignatureLimit, err)
+
+	// The request nonces of a bridge with signatures are limited.
+	for nonce := uint64(1); nonce < maxVTSignatureNonces; nonce++ {
+		_, err := pool.add(signTestValueTransfer(t, key, bridge, nonce, callHash))
+		require.NoError(t, err)
+	}
+	_, err = pool.add(signTestValueTransfer(t, key, bridge, maxVTSignatureNonces, callHash))
+	assert.Equal(t, ErrVTSignatureLimit, err)
+	_, err = pool.add(signTestValueTransfer(t, key, common.HexToAddress("0x3"), maxVTSignatureNonces, callHash))
+	assert.NoError(t, err)
+
+	// The pruned nonces make room for the others.
+	pool.prune(bridge, 1)
+	_, err = pool.add(signTestValueTransfer(t, key, bridge, maxVTSignatureNonces, callHash))
+	assert.NoError(t, err)
+}
+
+// deployTestVTBridge deploys a bridge whose operator is the deployer on the simulated backend.
+func deployTestVTBridge(t *testing.T) (*backends.SimulatedBackend, *bind.TransactOpts, *ecdsa.PrivateKey, common.Address, *bridgecontract.Bridge) {
+	key, _ := crypto.GenerateKey()
+	auth := bind.NewKeyedTransactor(key)
+	auth.GasLimit = DefaultBridgeTxGasLimit
+	sim := backends.NewSimulatedBackend(blockchain.GenesisAlloc{auth.From: {Balance: big.NewInt(params.KLAY)}})
+	t.Cleanup(func() { sim.Close() })
+
+	addr, _, contract, err := bridgecontract.DeployBridge(auth, sim, false)
+	require.NoError(t, err)
+	sim.Commit()
+	return sim, auth, key, addr, contract
+}
+
+func TestBridgeInfo_ValidateVTSignature(t *testing.T) {
+	_, _, operatorKey, addr, contract := deployTestVTBridge(t)
+	otherKey, _ := crypto.GenerateKey()
+	bi := &BridgeInfo{address: addr, bridge: contract, lowerHandleNonce: 10}
+	callHash := common.HexToHash("0x1")
+
+	assert.NoError(t, bi.validateVTSignature(signTestValueTransfer(t, operatorKey, addr, 10, callHash)))
+	assert.Equal(t, ErrNotVTBridgeOperator, bi.validateVTSignature(signTestValueTransfer(t, otherKey, addr, 10, callHash)))
+	assert.Equal(t, ErrVTSignatureNonce, bi.validateVTSignature(signTestValueTransfer(t, operatorKey, addr, 9, callHash)))
+	assert.Equal(t, ErrVTSignatureNonce, bi.validateVTSignature(signTestValueTransfer(t, operatorKey, addr, 10+maxVTSignatureNonces, callHash)))
+	assert.Equal(t, ErrUnknownVTBridge, bi.validateVTSignature(signTestValueTransfer(t, operatorKey, common.HexToAddress("0x1"), 10, callHash)))
+
+	invalid := signTestValueTransfer(t, operatorKey, addr, 10, callHash)
+	invalid.Signature = invalid.Signature[1:]
+	assert.Equal(t, ErrInvalidVTSignature, bi.validateVTSignature(invalid))
+}
+
+func TestMainBridgeHandler_RelayValueTransferSignatures(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	sim, auth, operatorKey, parentBridge, contract := deployTestVTBridge(t)
+	childBridge := common.HexToAddress("0xc")
+	_, err := contract.SetCounterPartBridge(auth, childBridge)
+	require.NoError(t, err)
+	sim.Commit()
+
+	var (
+		otherKey, _ = crypto.GenerateKey()
+		callHash    = common.HexToHash("0x2")
+		onParent    = signTestValueTransfer(t, operatorKey, parentBridge, 1, callHash)
+		onChild     = signTestValueTransfer(t, operatorKey, childBridge, 1, callHash)
+		notOperator = signTestValueTransfer(t, otherKey, parentBridge, 1, callHash)
+		noPair      = signTestValueTransfer(t, operatorKey, childBridge, 2, callHash)
+		wrongPair   = signTestValueTransfer(t, operatorKey, common.HexToAddress("0xd"), 1, callHash)
+	)
+	onChild.Counterpart = parentBridge
+	wrongPair.Counterpart = parentBridge
+
+	mb := &MainBridge{peers: newBridgePeerSet(), bridgeCaller: sim}
+	mbh, err := NewMainBridgeHandler(nil, mb)
+	assert.NoError(t, err)
+
+	newPeer := func(id string, version int) *MockBridgePeer {
+		peer := NewMockBridgePeer(mockCtrl)
+		peer.EXPECT().GetID().Return(id).AnyTimes()
+		peer.EXPECT().GetVersion().Return(version).AnyTimes()
+		assert.NoError(t, mb.peers.Register(peer))
+		return peer
+	}
+	sender := newPeer("sender", SCProtocol3)
+	receiver := newPeer("receiver", SCProtocol3)
+	newPeer("legacy", SCProtocol2)
+
+	// Only the signatures of the operators are relayed.
+	receiver.EXPECT().SendValueTransferSignatures(gomock.Any()).DoAndReturn(func(relayed []*valueTransferSignature) error {
+		assert.Equal(t, []*valueTransferSignature{onParent, onChild}, relayed)
+		return nil
+	}).Times(1)
+
+	sigs := []*valueTransferSignature{onParent, notOperator, onChild, noPair, wrongPair}
+	size, r, err := rlp.EncodeToReader(sigs)
+	assert.NoError(t, err)
+	assert.NoError(t, mbh.HandleSubMsg(sender, p2p.Msg{Code: ServiceChainVTSignatureMsg, Size: uint32(size), Payload: r}))
+
+	// Nothing is relayed if no signature is valid.
+	size, r, err = rlp.EncodeToReader([]*valueTransferSignature{notOperator})
+	assert.NoError(t, err)
+	assert.NoError(t, mbh.HandleSubMsg(sender, p2p.Msg{Code: ServiceChainVTSignatureMsg, Size: uint32(size), Payload: r}))
+}
@@ -22,6 +22,7 @@ package node
 
 import (
 	"crypto/ecdsa"
+	"net/http"
 	"reflect"
 
 	"github.com/klaytn/klaytn/accounts"
@@ -115,3 +116,9 @@ type Service interface {
 	// set components (blockchain, txpool, ..) in core service
 	SetComponents(components []interface{})
 }
+
+// HTTPHandlerService is a service serving HTTP handlers alongside the HTTP RPC endpoint,
+// e.g., the GraphQL service. The handlers are served at the paths of the map.
+type HTTPHandlerService interface {
+	HTTPHandlers() map[string]http.Handler
+}
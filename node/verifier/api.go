@@ -0,0 +1,48 @@
+// Copyright 2024 The klaytn Authors
+// This file is part of the klaytn library.
+//
+// The klaytn library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The klaytn library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the klaytn library. If not, see <http://www.gnu.org/licenses/>.
+
+package verifier
+
+import (
+	"encoding/json"
+
+	"github.com/klaytn/klaytn/common"
+)
+
+// PublicVerifierAPI provides an API to verify the contracts deployed on the chain.
+type PublicVerifierAPI struct {
+	verifier *Verifier
+}
+
+func NewPublicVerifierAPI(verifier *Verifier) *PublicVerifierAPI {
+	return &PublicVerifierAPI{verifier: verifier}
+}
+
+// VerifyContract verifies the contract at the address with the solc standard JSON input, which
+// can be given as a JSON object or a string. The contract name can be "<source>:<contract>" or
+// "<contract>". If it is omitted, every contract compiled from the input is tried.
+// The verified ABI can be retrieved through klay_getContractABI.
+func (api *PublicVerifierAPI) VerifyContract(address common.Address, input json.RawMessage, contractName *string) (*VerificationResult, error) {
+	var str string
+	if err := json.Unmarshal(input, &str); err == nil {
+		input = json.RawMessage(str)
+	}
+	name := ""
+	if contractName != nil {
+		name = *contractName
+	}
+	return api.verifier.Verify(address, input, name)
+}
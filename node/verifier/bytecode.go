@@ -0,0 +1,96 @@
+// Copyright 2024 The klaytn Authors
+// This file is part of the klaytn library.
+//
+// The klaytn library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The klaytn library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the klaytn library. If not, see <http://www.gnu.org/licenses/>.
+
+package verifier
+
+import (
+	"bytes"
+	"errors"
+
+	"github.com/klaytn/klaytn/common"
+)
+
+const (
+	// PerfectMatch means the deployed bytecode is identical to the compiled one including the metadata.
+	PerfectMatch = "perfect"
+	// PartialMatch means the deployed bytecode is identical to the compiled one except for the metadata.
+	PartialMatch = "partial"
+)
+
+// libraryAddressPlaceholder is the beginning of the deployed bytecode of a library, which pushes
+// the address of the library replaced at the deployment (PUSH20 0x00..00).
+var libraryAddressPlaceholder = append([]byte{0x73}, make([]byte, 20)...)
+
+var errMalformedImmutable = errors.New("the immutable reference is out of the bytecode")
+
+// codeRange is a range of the bytecode filled at the deployment.
+type codeRange struct {
+	start, length int
+}
+
+// stripMetadata removes the CBOR-encoded metadata appended by solc to the bytecode.
+// The last two bytes of the bytecode are the big-endian length of the metadata.
+// The bytecode is returned as is if it does not end with the metadata.
+func stripMetadata(code []byte) []byte {
+	if len(code) < 2 {
+		return code
+	}
+	size := int(code[len(code)-2])<<8 | int(code[len(code)-1])
+	if size == 0 || size+2 > len(code) {
+		return code
+	}
+	// The metadata is a CBOR map, whose major type is 5 (0xa0-0xbf).
+	if head := code[len(code)-2-size]; head < 0xa0 || head > 0xbf {
+		return code
+	}
+	return code[:len(code)-2-size]
+}
+
+// maskDeployed returns a copy of the deployed bytecode whose ranges filled at the deployment,
+// the immutable variables and the address of a library, are replaced with the ones of the
+// compiled bytecode.
+func maskDeployed(compiled, deployed []byte, ranges []codeRange) ([]byte, error) {
+	masked := common.CopyBytes(deployed)
+	for _, r := range ranges {
+		if r.start < 0 || r.length < 0 || r.start+r.length > len(masked) || r.start+r.length > len(compiled) {
+			return nil, errMalformedImmutable
+		}
+		copy(masked[r.start:r.start+r.length], compiled[r.start:r.start+r.length])
+	}
+	if bytes.HasPrefix(compiled, libraryAddressPlaceholder) && len(masked) >= len(libraryAddressPlaceholder) {
+		copy(masked[1:len(libraryAddressPlaceholder)], compiled[1:len(libraryAddressPlaceholder)])
+	}
+	return masked, nil
+}
+
+// matchBytecode compares the compiled runtime bytecode with the deployed one, and returns
+// PerfectMatch, PartialMatch or an empty string if they do not match.
+func matchBytecode(compiled, deployed []byte, ranges []codeRange) (string, error) {
+	if len(compiled) == 0 || len(deployed) == 0 {
+		return "", nil
+	}
+	masked, err := maskDeployed(compiled, deployed, ranges)
+	if err != nil {
+		return "", err
+	}
+	if bytes.Equal(compiled, masked) {
+		return PerfectMatch, nil
+	}
+	if bytes.Equal(stripMetadata(compiled), stripMetadata(masked)) {
+		return PartialMatch, nil
+	}
+	return "", nil
+}
@@ -0,0 +1,78 @@
+// Copyright 2024 The klaytn Authors
+// This file is part of the klaytn library.
+//
+// The klaytn library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The klaytn library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the klaytn library. If not, see <http://www.gnu.org/licenses/>.
+
+package verifier
+
+import (
+	"testing"
+
+	"github.com/klaytn/klaytn/common"
+	"github.com/stretchr/testify/assert"
+)
+
+// testMetadata is a CBOR-encoded metadata {"solc": 0x000811} followed by its length.
+var testMetadata = common.FromHex("a164736f6c6343000811000a")
+
+func TestStripMetadata(t *testing.T) {
+	code := common.FromHex("6080604052")
+	assert.Equal(t, code, stripMetadata(append(common.CopyBytes(code), testMetadata...)))
+
+	// The bytecode without the metadata is returned as is.
+	assert.Equal(t, code, stripMetadata(code))
+	assert.Equal(t, []byte{0x00}, stripMetadata([]byte{0x00}))
+	assert.Equal(t, common.FromHex("60806040ff0003"), stripMetadata(common.FromHex("60806040ff0003")))
+}
+
+func TestMatchBytecode(t *testing.T) {
+	code := common.FromHex("7f0000000000000000000000000000000000000000000000000000000000000000600055")
+	other := common.FromHex("a164736f6c6343000812000a")
+	immutable := []codeRange{{start: 1, length: 32}}
+
+	deployed := common.CopyBytes(code)
+	deployed[32] = 0x01
+
+	testcases := []struct {
+		compiled []byte
+		deployed []byte
+		ranges   []codeRange
+		match    string
+	}{
+		{append(common.CopyBytes(code), testMetadata...), append(common.CopyBytes(code), testMetadata...), nil, PerfectMatch},
+		{append(common.CopyBytes(code), testMetadata...), append(common.CopyBytes(code), other...), nil, PartialMatch},
+		{append(common.CopyBytes(code), testMetadata...), append(common.CopyBytes(deployed), testMetadata...), immutable, PerfectMatch},
+		{append(common.CopyBytes(code), testMetadata...), append(common.CopyBytes(deployed), testMetadata...), nil, ""},
+		{append(common.CopyBytes(code), testMetadata...), common.FromHex("6080"), nil, ""},
+		{nil, code, nil, ""},
+	}
+	for i, tc := range testcases {
+		match, err := matchBytecode(tc.compiled, tc.deployed, tc.ranges)
+		assert.NoError(t, err, "testcase %d", i)
+		assert.Equal(t, tc.match, match, "testcase %d", i)
+	}
+
+	_, err := matchBytecode(code, code, []codeRange{{start: 30, length: 32}})
+	assert.Equal(t, errMalformedImmutable, err)
+}
+
+func TestMatchBytecode_Library(t *testing.T) {
+	compiled := append(common.CopyBytes(libraryAddressPlaceholder), common.FromHex("3014608060405200")...)
+	deployed := common.CopyBytes(compiled)
+	copy(deployed[1:21], common.HexToAddress("0x0000000000000000000000000000000000000400").Bytes())
+
+	match, err := matchBytecode(compiled, deployed, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, PerfectMatch, match)
+}
@@ -0,0 +1,32 @@
+// Copyright 2024 The klaytn Authors
+// This file is part of the klaytn library.
+//
+// The klaytn library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The klaytn library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the klaytn library. If not, see <http://www.gnu.org/licenses/>.
+
+package verifier
+
+// Config is the configuration of the contract verification service.
+type Config struct {
+	EnabledVerifier bool
+
+	// Solc is the path of the solc binary compiling the sources.
+	// If empty, solc is looked up in PATH.
+	Solc string
+}
+
+func DefaultConfig() *Config {
+	return &Config{
+		EnabledVerifier: false,
+	}
+}
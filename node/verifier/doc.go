@@ -0,0 +1,39 @@
+// Copyright 2024 The klaytn Authors
+// This file is part of the klaytn library.
+//
+// The klaytn library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The klaytn library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the klaytn library. If not, see <http://www.gnu.org/licenses/>.
+
+/*
+Package verifier implements the contract verification service.
+
+The verifier associates the bytecode deployed on the chain with its source and ABI, similarly to Sourcify.
+A user submits the solc standard JSON input of a contract through verifier_verifyContract. The verifier
+compiles the input with the solc binary found in PATH (or given by the configuration), and compares
+the runtime bytecode of the compiled contract with the code deployed at the address. The ranges filled
+at the deployment, i.e., the immutable variables and the address of a library, are ignored.
+
+A contract is verified with a perfect match if the bytecodes are identical, or with a partial match
+if they differ only in the metadata appended by solc. The ABI of a verified contract is stored in
+the misc DB, and served by klay_getContractABI so that tracers, the console and explorers can decode
+calls and logs of the contract.
+
+Source Files
+
+  - api.go	: Provides `PublicVerifierAPI` serving the verifier namespace
+  - bytecode.go	: Provides functions comparing the compiled bytecode with the deployed one
+  - config.go	: Defines the configuration of the contract verifier
+  - metrics.go	: Defines the metrics of the contract verifier
+  - verifier.go	: Provides `Verifier` service compiling and verifying contracts
+*/
+package verifier
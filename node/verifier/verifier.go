@@ -0,0 +1,231 @@
+// Copyright 2024 The klaytn Authors
+// This file is part of the klaytn library.
+//
+// The klaytn library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The klaytn library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the klaytn library. If not, see <http://www.gnu.org/licenses/>.
+
+package verifier
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/klaytn/klaytn/blockchain"
+	"github.com/klaytn/klaytn/blockchain/state"
+	"github.com/klaytn/klaytn/common"
+	"github.com/klaytn/klaytn/common/compiler"
+	"github.com/klaytn/klaytn/common/hexutil"
+	"github.com/klaytn/klaytn/log"
+	"github.com/klaytn/klaytn/networks/p2p"
+	"github.com/klaytn/klaytn/networks/rpc"
+	"github.com/klaytn/klaytn/node"
+	"github.com/klaytn/klaytn/storage/database"
+)
+
+var logger = log.NewModuleLogger(log.NodeVerifier)
+
+var (
+	errNotReady         = errors.New("the contract verifier is not ready")
+	errNoCode           = errors.New("no contract is deployed at the address")
+	errNoContract       = errors.New("no contract is compiled from the input")
+	errBytecodeMismatch = errors.New("the compiled bytecode does not match the deployed bytecode")
+	errUnlinkedLibrary  = errors.New("the compiled bytecode has unlinked libraries")
+)
+
+// BlockChain is the interface of the blockchain used by the contract verifier.
+type BlockChain interface {
+	State() (*state.StateDB, error)
+}
+
+// VerificationResult is the result of a successful contract verification.
+type VerificationResult struct {
+	Address         common.Address  `json:"address"`
+	Contract        string          `json:"contract"`
+	CompilerVersion string          `json:"compilerVersion"`
+	Match           string          `json:"match"`
+	ABI             json.RawMessage `json:"abi"`
+}
+
+// Verifier is a service which compiles the solc standard JSON input of a contract, compares
+// the compiled runtime bytecode with the deployed one, and stores the ABI of the contract
+// if they match.
+type Verifier struct {
+	config *Config
+
+	blockchain BlockChain
+	chainDB    database.DBManager
+
+	// compile compiles a solc standard JSON input. Compilations are serialized by lock
+	// since solc is CPU and memory intensive.
+	compile func(input []byte) (*compiler.StandardJSONOutput, error)
+	lock    sync.Mutex
+}
+
+// NewVerifier creates a contract verification service with the given configuration.
+func NewVerifier(ctx *node.ServiceContext, cfg *Config) (*Verifier, error) {
+	return newVerifier(cfg), nil
+}
+
+func newVerifier(cfg *Config) *Verifier {
+	return &Verifier{
+		config: cfg,
+		compile: func(input []byte) (*compiler.StandardJSONOutput, error) {
+			return compiler.CompileStandardJSON(cfg.Solc, input)
+		},
+	}
+}
+
+func (v *Verifier) Protocols() []p2p.Protocol {
+	return []p2p.Protocol{}
+}
+
+func (v *Verifier) APIs() []rpc.API {
+	return []rpc.API{
+		{
+			Namespace: "verifier",
+			Version:   "1.0",
+			Service:   NewPublicVerifierAPI(v),
+			Public:    true,
+		},
+	}
+}
+
+func (v *Verifier) Start(server p2p.Server) error {
+	solc, err := compiler.SolidityVersion(v.config.Solc)
+	if err != nil {
+		logger.Warn("Solidity compiler is not found. Contracts cannot be verified until it is installed", "err", err)
+	} else {
+		logger.Info("Contract verifier is started", "solc", solc.Path, "version", solc.Version)
+	}
+	return nil
+}
+
+func (v *Verifier) Stop() error {
+	logger.Info("Contract verifier is stopped")
+	return nil
+}
+
+func (v *Verifier) Components() []interface{} {
+	return nil
+}
+
+func (v *Verifier) SetComponents(components []interface{}) {
+	for _, component := range components {
+		switch c := component.(type) {
+		case *blockchain.BlockChain:
+			v.blockchain = c
+		case database.DBManager:
+			v.chainDB = c
+		}
+	}
+}
+
+// Verify compiles the solc standard JSON input and compares the runtime bytecode of the
+// contract with the code deployed at the address. The metadata appended by solc and the
+// immutable variables are ignored in the comparison. If name is empty, every compiled
+// contract is tried. On success, the ABI of the contract is stored in the database.
+func (v *Verifier) Verify(address common.Address, input []byte, name string) (*VerificationResult, error) {
+	if v.blockchain == nil || v.chainDB == nil {
+		return nil, errNotReady
+	}
+	statedb, err := v.blockchain.State()
+	if err != nil {
+		return nil, err
+	}
+	deployed := statedb.GetCode(address)
+	if len(deployed) == 0 {
+		return nil, errNoCode
+	}
+
+	v.lock.Lock()
+	output, err := v.compile(input)
+	v.lock.Unlock()
+	if err != nil {
+		return nil, err
+	}
+
+	candidates := make(map[string]*compiler.StandardJSONContract)
+	if name != "" {
+		fullName, contract, err := output.Contract(name)
+		if err != nil {
+			return nil, err
+		}
+		candidates[fullName] = contract
+	} else {
+		for source, contracts := range output.Contracts {
+			for contractName, contract := range contracts {
+				candidates[source+":"+contractName] = contract
+			}
+		}
+	}
+	if len(candidates) == 0 {
+		return nil, errNoContract
+	}
+	names := make([]string, 0, len(candidates))
+	for fullName := range candidates {
+		names = append(names, fullName)
+	}
+	sort.Strings(names)
+
+	for _, fullName := range names {
+		contract := candidates[fullName]
+		match, err := matchContract(contract, deployed)
+		if err != nil {
+			if name != "" {
+				return nil, fmt.Errorf("%s: %w", fullName, err)
+			}
+			logger.Debug("Skipped a contract not comparable", "contract", fullName, "err", err)
+			continue
+		}
+		if match == "" {
+			continue
+		}
+		if err := v.chainDB.WriteContractABI(address, contract.ABI); err != nil {
+			return nil, err
+		}
+		verifiedCounter.Inc(1)
+		logger.Info("Verified a contract", "address", address, "contract", fullName, "match", match)
+		return &VerificationResult{
+			Address:         address,
+			Contract:        fullName,
+			CompilerVersion: output.Version,
+			Match:           match,
+			ABI:             contract.ABI,
+		}, nil
+	}
+	failedCounter.Inc(1)
+	return nil, errBytecodeMismatch
+}
+
+// matchContract compares the runtime bytecode of the compiled contract with the deployed one.
+func matchContract(contract *compiler.StandardJSONContract, deployed []byte) (string, error) {
+	object := contract.EVM.DeployedBytecode.Object
+	if strings.Contains(object, "__") {
+		return "", errUnlinkedLibrary
+	}
+	compiled, err := hexutil.Decode("0x" + strings.TrimPrefix(object, "0x"))
+	if err != nil {
+		return "", err
+	}
+	var ranges []codeRange
+	for _, refs := range contract.EVM.DeployedBytecode.ImmutableReferences {
+		for _, ref := range refs {
+			ranges = append(ranges, codeRange{start: ref.Start, length: ref.Length})
+		}
+	}
+	return matchBytecode(compiled, deployed, ranges)
+}
@@ -0,0 +1,155 @@
+// Copyright 2024 The klaytn Authors
+// This file is part of the klaytn library.
+//
+// The klaytn library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The klaytn library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the klaytn library. If not, see <http://www.gnu.org/licenses/>.
+
+package verifier
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/klaytn/klaytn/blockchain/state"
+	"github.com/klaytn/klaytn/common"
+	"github.com/klaytn/klaytn/common/compiler"
+	"github.com/klaytn/klaytn/common/hexutil"
+	"github.com/klaytn/klaytn/storage/database"
+	"github.com/stretchr/testify/assert"
+)
+
+var (
+	testContractAddr = common.HexToAddress("0x0000000000000000000000000000000000000400")
+	testRuntimeCode  = common.FromHex("6080604052348015600f57600080fd5b50a164736f6c6343000811000a")
+	testABI          = json.RawMessage(`[{"type":"function","name":"totalSupply","inputs":[],"outputs":[{"name":"","type":"uint256"}],"stateMutability":"view"}]`)
+)
+
+type testBlockChain struct {
+	statedb *state.StateDB
+}
+
+func (bc *testBlockChain) State() (*state.StateDB, error) {
+	return bc.statedb, nil
+}
+
+func newTestVerifier(t *testing.T, output string) (*Verifier, database.DBManager) {
+	dbm := database.NewMemoryDBManager()
+	statedb, err := state.New(common.Hash{}, state.NewDatabase(dbm), nil, nil)
+	assert.NoError(t, err)
+	statedb.SetCode(testContractAddr, testRuntimeCode)
+
+	v := newVerifier(DefaultConfig())
+	v.compile = func(input []byte) (*compiler.StandardJSONOutput, error) {
+		if output == "" {
+			return nil, errors.New("solc: compilation failed")
+		}
+		out, err := compiler.ParseStandardJSONOutput([]byte(output))
+		if err != nil {
+			return nil, err
+		}
+		out.Version = "0.8.17"
+		return out, nil
+	}
+	v.SetComponents([]interface{}{dbm})
+	v.blockchain = &testBlockChain{statedb: statedb}
+	return v, dbm
+}
+
+func testOutput(object string) string {
+	return `{"contracts": {"Token.sol": {
+		"IToken": {"abi": [], "evm": {"deployedBytecode": {"object": ""}}},
+		"Token": {"abi": ` + string(testABI) + `, "evm": {"deployedBytecode": {"object": "` + object + `"}}}
+	}}}`
+}
+
+func TestVerifier_Verify(t *testing.T) {
+	// Only the metadata is different.
+	partial := hexutil.Encode(testRuntimeCode)[2:]
+	partial = partial[:len(partial)-6] + "12000a"
+
+	testcases := []struct {
+		output string
+		name   string
+		match  string
+		err    error
+	}{
+		{testOutput(hexutil.Encode(testRuntimeCode)[2:]), "", PerfectMatch, nil},
+		{testOutput(hexutil.Encode(testRuntimeCode)[2:]), "Token", PerfectMatch, nil},
+		{testOutput(partial), "Token.sol:Token", PartialMatch, nil},
+		{testOutput("6080604052"), "", "", errBytecodeMismatch},
+		{testOutput("__$1234$__"), "", "", errBytecodeMismatch},
+		{testOutput(hexutil.Encode(testRuntimeCode)[2:]), "IToken", "", errBytecodeMismatch},
+	}
+	for i, tc := range testcases {
+		v, dbm := newTestVerifier(t, tc.output)
+		result, err := v.Verify(testContractAddr, []byte(`{}`), tc.name)
+		if tc.err != nil {
+			assert.Equal(t, tc.err, err, "testcase %d", i)
+			_, err := dbm.ReadContractABI(testContractAddr)
+			assert.Error(t, err, "testcase %d", i)
+			continue
+		}
+		assert.NoError(t, err, "testcase %d", i)
+		assert.Equal(t, "Token.sol:Token", result.Contract, "testcase %d", i)
+		assert.Equal(t, tc.match, result.Match, "testcase %d", i)
+		assert.Equal(t, "0.8.17", result.CompilerVersion, "testcase %d", i)
+
+		abi, err := dbm.ReadContractABI(testContractAddr)
+		assert.NoError(t, err, "testcase %d", i)
+		assert.JSONEq(t, string(testABI), string(abi), "testcase %d", i)
+	}
+}
+
+func TestVerifier_VerifyErrors(t *testing.T) {
+	v := newVerifier(DefaultConfig())
+	_, err := v.Verify(testContractAddr, []byte(`{}`), "")
+	assert.Equal(t, errNotReady, err)
+
+	v, _ = newTestVerifier(t, testOutput(hexutil.Encode(testRuntimeCode)[2:]))
+	_, err = v.Verify(common.HexToAddress("0x01"), []byte(`{}`), "")
+	assert.Equal(t, errNoCode, err)
+
+	_, err = v.Verify(testContractAddr, []byte(`{}`), "Unknown")
+	assert.Error(t, err)
+
+	v, _ = newTestVerifier(t, testOutput("__$1234$__"))
+	_, err = v.Verify(testContractAddr, []byte(`{}`), "Token")
+	assert.ErrorIs(t, err, errUnlinkedLibrary)
+
+	v, _ = newTestVerifier(t, "")
+	_, err = v.Verify(testContractAddr, []byte(`{}`), "")
+	assert.Error(t, err)
+}
+
+func TestPublicVerifierAPI_VerifyContract(t *testing.T) {
+	v, dbm := newTestVerifier(t, testOutput(hexutil.Encode(testRuntimeCode)[2:]))
+	api := NewPublicVerifierAPI(v)
+
+	var inputs []json.RawMessage
+	v.compile = func(input []byte) (*compiler.StandardJSONOutput, error) {
+		inputs = append(inputs, input)
+		return compiler.ParseStandardJSONOutput([]byte(testOutput(hexutil.Encode(testRuntimeCode)[2:])))
+	}
+
+	// The input can be given as an object or a string.
+	name := "Token"
+	_, err := api.VerifyContract(testContractAddr, json.RawMessage(`{"language":"Solidity"}`), &name)
+	assert.NoError(t, err)
+	_, err = api.VerifyContract(testContractAddr, json.RawMessage(`"{\"language\":\"Solidity\"}"`), nil)
+	assert.NoError(t, err)
+	assert.Equal(t, []json.RawMessage{json.RawMessage(`{"language":"Solidity"}`), json.RawMessage(`{"language":"Solidity"}`)}, inputs)
+
+	_, err = dbm.ReadContractABI(testContractAddr)
+	assert.NoError(t, err)
+}
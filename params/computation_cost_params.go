@@ -172,4 +172,20 @@ const (
 
 	// computation costs for opcode added at koreCompatible Protocol Upgrade
 	RandomComputationCost = 1498
+
+	// computation costs for opcode added at cancunCompatible Protocol Upgrade
+	TloadComputationCost  = 280
+	TstoreComputationCost = 280
+	McopyComputationCost  = 250
+
+	// computation costs for precompiled contracts added at pragueCompatible Protocol Upgrade
+	P256VerifyComputationCost             = 120000
+	Bls12381G1AddComputationCost          = 15000
+	Bls12381G1MulComputationCost          = 450000
+	Bls12381G2AddComputationCost          = 25000
+	Bls12381G2MulComputationCost          = 900000
+	Bls12381PairingBaseComputationCost    = 1500000
+	Bls12381PairingPerPairComputationCost = 1300000
+	Bls12381MapG1ComputationCost          = 220000
+	Bls12381MapG2ComputationCost          = 950000
 )
@@ -49,6 +49,7 @@ var (
 		KoreCompatibleBlock:      big.NewInt(119750400),
 		ShanghaiCompatibleBlock:  big.NewInt(135456000),
 		CancunCompatibleBlock:    nil, // TODO-Klaytn-Cancun: set Cypress CancunCompatibleBlock
+		PragueCompatibleBlock:    nil, // TODO-Klaytn-Prague: set Cypress PragueCompatibleBlock
 		Kip103CompatibleBlock:    big.NewInt(119750400),
 		Kip103ContractAddress:    common.HexToAddress("0xD5ad6D61Dd87EdabE2332607C328f5cc96aeCB95"),
 		DeriveShaImpl:            2,
@@ -83,6 +84,7 @@ var (
 		KoreCompatibleBlock:      big.NewInt(111736800),
 		ShanghaiCompatibleBlock:  big.NewInt(131608000),
 		CancunCompatibleBlock:    nil, // TODO-Klaytn-Cancun: set Baobab CancunCompatibleBlock
+		PragueCompatibleBlock:    nil, // TODO-Klaytn-Prague: set Baobab PragueCompatibleBlock
 		Kip103CompatibleBlock:    big.NewInt(119145600),
 		Kip103ContractAddress:    common.HexToAddress("0xD5ad6D61Dd87EdabE2332607C328f5cc96aeCB95"),
 		DeriveShaImpl:            2,
@@ -186,12 +188,17 @@ type ChainConfig struct {
 	KoreCompatibleBlock      *big.Int `json:"koreCompatibleBlock,omitempty"`      // KoreCompatible switch block (nil = no fork, 0 already on Kore)
 	ShanghaiCompatibleBlock  *big.Int `json:"shanghaiCompatibleBlock,omitempty"`  // ShanghaiCompatible switch block (nil = no fork, 0 already on shanghai)
 	CancunCompatibleBlock    *big.Int `json:"cancunCompatibleBlock,omitempty"`    // CancunCompatible switch block (nil = no fork, 0 already on Cancun)
+	PragueCompatibleBlock    *big.Int `json:"pragueCompatibleBlock,omitempty"`    // PragueCompatible switch block (nil = no fork, 0 already on Prague)
 
 	// KIP103 is a special purpose hardfork feature that can be executed only once
 	// Both Kip103CompatibleBlock and Kip103ContractAddress should be specified to enable KIP103
 	Kip103CompatibleBlock *big.Int       `json:"kip103CompatibleBlock,omitempty"` // Kip103Compatible activate block (nil = no fork)
 	Kip103ContractAddress common.Address `json:"kip103ContractAddress,omitempty"` // Kip103 contract address already deployed on the network
 
+	// TreasuryRebalances schedules additional treasury rebalances executed with the TreasuryRebalance contract
+	// in the same way as KIP103. Each rebalance is executed only once at the specified block.
+	TreasuryRebalances []*TreasuryRebalanceConfig `json:"treasuryRebalances,omitempty"`
+
 	// Various consensus engines
 	Gxhash   *GxhashConfig   `json:"gxhash,omitempty"` // (deprecated) not supported engine
 	Clique   *CliqueConfig   `json:"clique,omitempty"`
@@ -202,6 +209,13 @@ type ChainConfig struct {
 	Governance    *GovernanceConfig `json:"governance"`
 }
 
+// TreasuryRebalanceConfig stores a treasury rebalance scheduled at Block
+// with the TreasuryRebalance contract deployed at Contract.
+type TreasuryRebalanceConfig struct {
+	Block    *big.Int       `json:"block"`
+	Contract common.Address `json:"contract"`
+}
+
 // GovernanceConfig stores governance information for a network
 type GovernanceConfig struct {
 	GoverningNode    common.Address `json:"governingNode"`
@@ -283,9 +297,14 @@ func (c *ChainConfig) String() string {
 	}
 
 	kip103 := fmt.Sprintf("KIP103CompatibleBlock: %v KIP103ContractAddress %s", c.Kip103CompatibleBlock, c.Kip103ContractAddress.String())
+	for _, rebalance := range c.TreasuryRebalances {
+		if rebalance != nil {
+			kip103 += fmt.Sprintf(" TreasuryRebalance: {%v %s}", rebalance.Block, rebalance.Contract.String())
+		}
+	}
 
 	if c.Istanbul != nil {
-		return fmt.Sprintf("{ChainID: %v IstanbulCompatibleBlock: %v LondonCompatibleBlock: %v EthTxTypeCompatibleBlock: %v MagmaCompatibleBlock: %v KoreCompatibleBlock: %v ShanghaiCompatibleBlock: %v CancunCompatibleBlock: %v %s SubGroupSize: %d UnitPrice: %d DeriveShaImpl: %d Engine: %v}",
+		return fmt.Sprintf("{ChainID: %v IstanbulCompatibleBlock: %v LondonCompatibleBlock: %v EthTxTypeCompatibleBlock: %v MagmaCompatibleBlock: %v KoreCompatibleBlock: %v ShanghaiCompatibleBlock: %v CancunCompatibleBlock: %v PragueCompatibleBlock: %v %s SubGroupSize: %d UnitPrice: %d DeriveShaImpl: %d Engine: %v}",
 			c.ChainID,
 			c.IstanbulCompatibleBlock,
 			c.LondonCompatibleBlock,
@@ -294,6 +313,7 @@ func (c *ChainConfig) String() string {
 			c.KoreCompatibleBlock,
 			c.ShanghaiCompatibleBlock,
 			c.CancunCompatibleBlock,
+			c.PragueCompatibleBlock,
 			kip103,
 			c.Istanbul.SubGroupSize,
 			c.UnitPrice,
@@ -301,7 +321,7 @@ func (c *ChainConfig) String() string {
 			engine,
 		)
 	} else {
-		return fmt.Sprintf("{ChainID: %v IstanbulCompatibleBlock: %v LondonCompatibleBlock: %v EthTxTypeCompatibleBlock: %v MagmaCompatibleBlock: %v KoreCompatibleBlock: %v ShanghaiCompatibleBlock: %v CancunCompatibleBlock: %v %s UnitPrice: %d DeriveShaImpl: %d Engine: %v }",
+		return fmt.Sprintf("{ChainID: %v IstanbulCompatibleBlock: %v LondonCompatibleBlock: %v EthTxTypeCompatibleBlock: %v MagmaCompatibleBlock: %v KoreCompatibleBlock: %v ShanghaiCompatibleBlock: %v CancunCompatibleBlock: %v PragueCompatibleBlock: %v %s UnitPrice: %d DeriveShaImpl: %d Engine: %v }",
 			c.ChainID,
 			c.IstanbulCompatibleBlock,
 			c.LondonCompatibleBlock,
@@ -310,6 +330,7 @@ func (c *ChainConfig) String() string {
 			c.KoreCompatibleBlock,
 			c.ShanghaiCompatibleBlock,
 			c.CancunCompatibleBlock,
+			c.PragueCompatibleBlock,
 			kip103,
 			c.UnitPrice,
 			c.DeriveShaImpl,
@@ -360,6 +381,11 @@ func (c *ChainConfig) IsCancunForkEnabled(num *big.Int) bool {
 	return isForked(c.CancunCompatibleBlock, num)
 }
 
+// IsPragueForkEnabled returns whether num is either equal to the prague block or greater.
+func (c *ChainConfig) IsPragueForkEnabled(num *big.Int) bool {
+	return isForked(c.PragueCompatibleBlock, num)
+}
+
 // IsKIP103ForkBlock returns whether num is equal to the kip103 block.
 func (c *ChainConfig) IsKIP103ForkBlock(num *big.Int) bool {
 	if c.Kip103CompatibleBlock == nil || num == nil {
@@ -368,6 +394,51 @@ func (c *ChainConfig) IsKIP103ForkBlock(num *big.Int) bool {
 	return c.Kip103CompatibleBlock.Cmp(num) == 0
 }
 
+// TreasuryRebalanceContract returns the address of the TreasuryRebalance contract to be executed at num.
+// The KIP103 hardfork is regarded as one of the scheduled treasury rebalances.
+func (c *ChainConfig) TreasuryRebalanceContract(num *big.Int) (common.Address, bool) {
+	if c.IsKIP103ForkBlock(num) {
+		return c.Kip103ContractAddress, true
+	}
+	if num == nil {
+		return common.Address{}, false
+	}
+	for _, rebalance := range c.TreasuryRebalances {
+		if rebalance != nil && rebalance.Block != nil && rebalance.Block.Cmp(num) == 0 {
+			return rebalance.Contract, true
+		}
+	}
+	return common.Address{}, false
+}
+
+// IsTreasuryRebalanceBlock returns whether a treasury rebalance is scheduled at num.
+func (c *ChainConfig) IsTreasuryRebalanceBlock(num *big.Int) bool {
+	_, ok := c.TreasuryRebalanceContract(num)
+	return ok
+}
+
+// CheckTreasuryRebalances checks that every scheduled treasury rebalance has a block and
+// a contract address, and that no two rebalances are scheduled at the same block.
+func (c *ChainConfig) CheckTreasuryRebalances() error {
+	scheduled := make(map[uint64]bool)
+	if c.Kip103CompatibleBlock != nil {
+		scheduled[c.Kip103CompatibleBlock.Uint64()] = true
+	}
+	for i, rebalance := range c.TreasuryRebalances {
+		if rebalance == nil || rebalance.Block == nil || rebalance.Block.Sign() <= 0 {
+			return fmt.Errorf("invalid treasury rebalance %d: block should be positive", i)
+		}
+		if common.EmptyAddress(rebalance.Contract) {
+			return fmt.Errorf("invalid treasury rebalance %d: contract address is not specified", i)
+		}
+		if scheduled[rebalance.Block.Uint64()] {
+			return fmt.Errorf("invalid treasury rebalance %d: another rebalance is already scheduled at %v", i, rebalance.Block)
+		}
+		scheduled[rebalance.Block.Uint64()] = true
+	}
+	return nil
+}
+
 // CheckCompatible checks whether scheduled fork transitions have been imported
 // with a mismatching chain configuration.
 func (c *ChainConfig) CheckCompatible(newcfg *ChainConfig, height uint64) *ConfigCompatError {
@@ -403,6 +474,7 @@ func (c *ChainConfig) CheckConfigForkOrder() error {
 		{name: "koreBlock", block: c.KoreCompatibleBlock},
 		{name: "shanghaiBlock", block: c.ShanghaiCompatibleBlock},
 		{name: "cancunBlock", block: c.CancunCompatibleBlock},
+		{name: "pragueBlock", block: c.PragueCompatibleBlock},
 	} {
 		if lastFork.name != "" {
 			// Next one must be higher number
@@ -449,6 +521,26 @@ func (c *ChainConfig) checkCompatible(newcfg *ChainConfig, head *big.Int) *Confi
 	if isForkIncompatible(c.CancunCompatibleBlock, newcfg.CancunCompatibleBlock, head) {
 		return newCompatError("Cancun Block", c.CancunCompatibleBlock, newcfg.CancunCompatibleBlock)
 	}
+	if isForkIncompatible(c.PragueCompatibleBlock, newcfg.PragueCompatibleBlock, head) {
+		return newCompatError("Prague Block", c.PragueCompatibleBlock, newcfg.PragueCompatibleBlock)
+	}
+	// A treasury rebalance which is already executed cannot be removed or replaced by another contract.
+	for _, rebalance := range c.TreasuryRebalances {
+		if rebalance == nil || rebalance.Block == nil || !isForked(rebalance.Block, head) {
+			continue
+		}
+		if contract, ok := newcfg.TreasuryRebalanceContract(rebalance.Block); !ok || contract != rebalance.Contract {
+			return newCompatError("Treasury Rebalance Block", rebalance.Block, nil)
+		}
+	}
+	for _, rebalance := range newcfg.TreasuryRebalances {
+		if rebalance == nil || rebalance.Block == nil || !isForked(rebalance.Block, head) {
+			continue
+		}
+		if contract, ok := c.TreasuryRebalanceContract(rebalance.Block); !ok || contract != rebalance.Contract {
+			return newCompatError("Treasury Rebalance Block", nil, rebalance.Block)
+		}
+	}
 	return nil
 }
 
@@ -569,6 +661,7 @@ type Rules struct {
 	IsKore      bool
 	IsShanghai  bool
 	IsCancun    bool
+	IsPrague    bool
 }
 
 // Rules ensures c's ChainID is not nil.
@@ -586,6 +679,7 @@ func (c *ChainConfig) Rules(num *big.Int) Rules {
 		IsKore:      c.IsKoreForkEnabled(num),
 		IsShanghai:  c.IsShanghaiForkEnabled(num),
 		IsCancun:    c.IsCancunForkEnabled(num),
+		IsPrague:    c.IsPragueForkEnabled(num),
 	}
 }
 
@@ -20,6 +20,7 @@ import (
 	"math/big"
 	"testing"
 
+	"github.com/klaytn/klaytn/common"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -76,3 +77,61 @@ func BenchmarkChainConfig_Copy(b *testing.B) {
 		a.Copy()
 	}
 }
+
+func TestChainConfig_TreasuryRebalances(t *testing.T) {
+	c := CypressChainConfig.Copy()
+	c.TreasuryRebalances = []*TreasuryRebalanceConfig{
+		{Block: big.NewInt(200000000), Contract: common.HexToAddress("0x1000")},
+		{Block: big.NewInt(300000000), Contract: common.HexToAddress("0x2000")},
+	}
+	assert.Nil(t, c.CheckTreasuryRebalances())
+
+	// the KIP103 hardfork is one of the treasury rebalances
+	contract, ok := c.TreasuryRebalanceContract(c.Kip103CompatibleBlock)
+	assert.True(t, ok)
+	assert.Equal(t, c.Kip103ContractAddress, contract)
+
+	contract, ok = c.TreasuryRebalanceContract(big.NewInt(300000000))
+	assert.True(t, ok)
+	assert.Equal(t, common.HexToAddress("0x2000"), contract)
+
+	assert.False(t, c.IsTreasuryRebalanceBlock(big.NewInt(300000001)))
+	assert.False(t, c.IsTreasuryRebalanceBlock(nil))
+
+	// the config survives a copy
+	assert.Equal(t, c.TreasuryRebalances, c.Copy().TreasuryRebalances)
+
+	// invalid configs
+	for _, rebalance := range []*TreasuryRebalanceConfig{
+		{Block: nil, Contract: common.HexToAddress("0x3000")},
+		{Block: big.NewInt(400000000), Contract: common.Address{}},
+		{Block: big.NewInt(200000000), Contract: common.HexToAddress("0x3000")},
+		{Block: new(big.Int).Set(c.Kip103CompatibleBlock), Contract: common.HexToAddress("0x3000")},
+	} {
+		invalid := c.Copy()
+		invalid.TreasuryRebalances = append(invalid.TreasuryRebalances, rebalance)
+		assert.NotNil(t, invalid.CheckTreasuryRebalances())
+	}
+}
+
+func TestChainConfig_CheckCompatibleTreasuryRebalances(t *testing.T) {
+	stored := CypressChainConfig.Copy()
+	stored.TreasuryRebalances = []*TreasuryRebalanceConfig{
+		{Block: big.NewInt(200000000), Contract: common.HexToAddress("0x1000")},
+	}
+
+	// scheduling a new rebalance in the future is compatible
+	newcfg := stored.Copy()
+	newcfg.TreasuryRebalances = append(newcfg.TreasuryRebalances, &TreasuryRebalanceConfig{Block: big.NewInt(300000000), Contract: common.HexToAddress("0x2000")})
+	assert.Nil(t, stored.CheckCompatible(newcfg, 250000000))
+
+	// replacing an executed rebalance is not compatible
+	newcfg = stored.Copy()
+	newcfg.TreasuryRebalances[0].Contract = common.HexToAddress("0x3000")
+	err := stored.CheckCompatible(newcfg, 250000000)
+	assert.NotNil(t, err)
+	assert.Equal(t, uint64(199999999), err.RewindTo)
+
+	// replacing a rebalance not executed yet is compatible
+	assert.Nil(t, stored.CheckCompatible(newcfg, 150000000))
+}
@@ -0,0 +1,231 @@
+// Copyright 2024 The klaytn Authors
+// This file is part of the klaytn library.
+//
+// The klaytn library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The klaytn library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the klaytn library. If not, see <http://www.gnu.org/licenses/>.
+
+package signer
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"errors"
+	"fmt"
+	"math/big"
+	"sort"
+
+	"github.com/klaytn/klaytn/accounts/keystore"
+	"github.com/klaytn/klaytn/blockchain/types"
+	"github.com/klaytn/klaytn/blockchain/types/accountkey"
+	"github.com/klaytn/klaytn/common"
+	"github.com/klaytn/klaytn/common/hexutil"
+	"github.com/klaytn/klaytn/crypto"
+	"github.com/klaytn/klaytn/log"
+	"github.com/klaytn/klaytn/rlp"
+)
+
+// Version is the version of the external signer API.
+const Version = "1.0.0"
+
+var logger = log.NewModuleLogger(log.Signer)
+
+var (
+	errUnknownAccount   = errors.New("unknown account")
+	errInvalidHash      = errors.New("hash must be 32 bytes")
+	errChainIDNil       = errors.New("chain id is nil")
+	errSenderMismatch   = errors.New("the sender of the transaction is not the requested account")
+	errNotFeeDelegated  = errors.New("the transaction is not a fee-delegated transaction")
+	errFeePayerMismatch = errors.New("the fee payer of the transaction is not the requested account")
+	errNoKeyForRole     = errors.New("no key for the role")
+)
+
+// SignerAPI is the JSON-RPC API served by the external signer under the "account" namespace.
+// Every signing request is checked against the rules and recorded in the audit log.
+type SignerAPI struct {
+	keys  map[common.Address]keystore.Key
+	rules *Rules
+	audit *AuditLogger
+}
+
+// NewSignerAPI returns a SignerAPI signing with the given keys.
+// If rules is nil, every request except account_signHash is approved.
+func NewSignerAPI(keys []keystore.Key, rules *Rules, audit *AuditLogger) *SignerAPI {
+	if rules == nil {
+		rules = new(Rules)
+	}
+	if audit == nil {
+		audit = NewAuditLogger(nil)
+	}
+	api := &SignerAPI{
+		keys:  make(map[common.Address]keystore.Key, len(keys)),
+		rules: rules,
+		audit: audit,
+	}
+	for _, key := range keys {
+		api.keys[key.GetAddress()] = key
+	}
+	return api
+}
+
+// Version returns the version of the external signer API.
+func (api *SignerAPI) Version(ctx context.Context) (string, error) {
+	return Version, nil
+}
+
+// List returns the addresses of the accounts managed by the signer.
+func (api *SignerAPI) List(ctx context.Context) ([]common.Address, error) {
+	addrs := make([]common.Address, 0, len(api.keys))
+	for addr := range api.keys {
+		addrs = append(addrs, addr)
+	}
+	sort.Slice(addrs, func(i, j int) bool {
+		return addrs[i].Hex() < addrs[j].Hex()
+	})
+	return addrs, nil
+}
+
+// SignHash signs the given hash with the RoleTransaction key of the account.
+// The produced signature is in the [R || S || V] format where V is 0 or 1.
+func (api *SignerAPI) SignHash(ctx context.Context, addr common.Address, hash hexutil.Bytes) (hexutil.Bytes, error) {
+	record := &AuditRecord{Method: "account_signHash", Account: addr}
+	sig, err := api.signHash(addr, hash, record)
+	api.logAudit(record, err)
+	return sig, err
+}
+
+func (api *SignerAPI) signHash(addr common.Address, hash []byte, record *AuditRecord) ([]byte, error) {
+	if len(hash) != common.HashLength {
+		return nil, errInvalidHash
+	}
+	record.SigHash = common.BytesToHash(hash)
+	keys, err := api.roleKeys(addr, accountkey.RoleTransaction)
+	if err != nil {
+		return nil, err
+	}
+	if err := api.rules.CheckSignHash(); err != nil {
+		return nil, err
+	}
+	return crypto.Sign(hash, keys[0])
+}
+
+// SignTransaction signs the RLP-encoded transaction as the sender with the keys of the role
+// required by the transaction type, and returns the RLP-encoded signed transaction.
+func (api *SignerAPI) SignTransaction(ctx context.Context, addr common.Address, rawTx hexutil.Bytes, chainID *hexutil.Big) (hexutil.Bytes, error) {
+	record := &AuditRecord{Method: "account_signTransaction", Account: addr}
+	signed, err := api.signTransaction(addr, rawTx, chainID, record)
+	api.logAudit(record, err)
+	return signed, err
+}
+
+func (api *SignerAPI) signTransaction(addr common.Address, rawTx []byte, chainID *hexutil.Big, record *AuditRecord) ([]byte, error) {
+	tx, signer, err := decodeTx(rawTx, chainID)
+	if err != nil {
+		return nil, err
+	}
+	record.TxType = tx.Type().String()
+	record.SigHash = signer.Hash(tx)
+
+	if !tx.IsEthereumTransaction() {
+		if from, err := tx.From(); err != nil || from != addr {
+			return nil, errSenderMismatch
+		}
+	}
+	keys, err := api.roleKeys(addr, tx.GetRoleTypeForValidation())
+	if err != nil {
+		return nil, err
+	}
+	if err := api.rules.CheckTx(tx); err != nil {
+		return nil, err
+	}
+	if err := tx.SignWithKeys(signer, keys); err != nil {
+		return nil, err
+	}
+	return rlp.EncodeToBytes(tx)
+}
+
+// SignTransactionAsFeePayer signs the RLP-encoded fee-delegated transaction as the fee payer
+// with the RoleFeePayer keys, and returns the RLP-encoded signed transaction.
+func (api *SignerAPI) SignTransactionAsFeePayer(ctx context.Context, addr common.Address, rawTx hexutil.Bytes, chainID *hexutil.Big) (hexutil.Bytes, error) {
+	record := &AuditRecord{Method: "account_signTransactionAsFeePayer", Account: addr}
+	signed, err := api.signTransactionAsFeePayer(addr, rawTx, chainID, record)
+	api.logAudit(record, err)
+	return signed, err
+}
+
+func (api *SignerAPI) signTransactionAsFeePayer(addr common.Address, rawTx []byte, chainID *hexutil.Big, record *AuditRecord) ([]byte, error) {
+	tx, signer, err := decodeTx(rawTx, chainID)
+	if err != nil {
+		return nil, err
+	}
+	record.TxType = tx.Type().String()
+	if !tx.Type().IsFeeDelegatedTransaction() {
+		return nil, errNotFeeDelegated
+	}
+	if record.SigHash, err = signer.HashFeePayer(tx); err != nil {
+		return nil, err
+	}
+	if feePayer, err := tx.FeePayer(); err != nil || feePayer != addr {
+		return nil, errFeePayerMismatch
+	}
+	keys, err := api.roleKeys(addr, accountkey.RoleFeePayer)
+	if err != nil {
+		return nil, err
+	}
+	if err := api.rules.CheckFeePayerTx(tx); err != nil {
+		return nil, err
+	}
+	if err := tx.SignFeePayerWithKeys(signer, keys); err != nil {
+		return nil, err
+	}
+	return rlp.EncodeToBytes(tx)
+}
+
+// roleKeys returns the keys of the given role. Like a role-based account key,
+// the keys of RoleTransaction are used if the key of the role is not defined.
+func (api *SignerAPI) roleKeys(addr common.Address, role accountkey.RoleType) ([]*ecdsa.PrivateKey, error) {
+	key, ok := api.keys[addr]
+	if !ok {
+		return nil, errUnknownAccount
+	}
+	keys := key.GetPrivateKeysWithRole(int(role))
+	if len(keys) == 0 {
+		keys = key.GetPrivateKeysWithRole(int(accountkey.RoleTransaction))
+	}
+	if len(keys) == 0 {
+		return nil, fmt.Errorf("%w: %d", errNoKeyForRole, role)
+	}
+	return keys, nil
+}
+
+func (api *SignerAPI) logAudit(record *AuditRecord, err error) {
+	record.Approved = err == nil
+	if err != nil {
+		record.Reason = err.Error()
+		logger.Warn("Rejected a signing request", "method", record.Method, "account", record.Account, "err", err)
+	} else {
+		logger.Info("Approved a signing request", "method", record.Method, "account", record.Account, "sigHash", record.SigHash)
+	}
+	api.audit.Log(record)
+}
+
+// decodeTx decodes an unsigned or partially signed transaction.
+func decodeTx(rawTx []byte, chainID *hexutil.Big) (*types.Transaction, types.Signer, error) {
+	if chainID == nil {
+		return nil, nil, errChainIDNil
+	}
+	tx, err := types.DecodeTxWithoutSigValidation(rawTx)
+	if err != nil {
+		return nil, nil, err
+	}
+	return tx, types.LatestSignerForChainID((*big.Int)(chainID)), nil
+}
@@ -0,0 +1,69 @@
+// Copyright 2024 The klaytn Authors
+// This file is part of the klaytn library.
+//
+// The klaytn library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The klaytn library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the klaytn library. If not, see <http://www.gnu.org/licenses/>.
+
+package signer
+
+import (
+	"encoding/json"
+	"io"
+	"sync"
+	"time"
+
+	"github.com/klaytn/klaytn/common"
+)
+
+// AuditRecord is an entry of the audit log written for every signing request.
+type AuditRecord struct {
+	Time     time.Time      `json:"time"`
+	Method   string         `json:"method"`
+	Account  common.Address `json:"account"`
+	TxType   string         `json:"txType,omitempty"`
+	SigHash  common.Hash    `json:"sigHash"`
+	Approved bool           `json:"approved"`
+	Reason   string         `json:"reason,omitempty"`
+}
+
+// AuditLogger writes audit records to the underlying writer as JSON lines.
+type AuditLogger struct {
+	mu sync.Mutex
+	w  io.Writer
+}
+
+// NewAuditLogger returns an AuditLogger writing to w. If w is nil, records are discarded.
+func NewAuditLogger(w io.Writer) *AuditLogger {
+	if w == nil {
+		w = io.Discard
+	}
+	return &AuditLogger{w: w}
+}
+
+// Log writes the given record. A failure of writing is logged but does not
+// reject the signing request.
+func (l *AuditLogger) Log(record *AuditRecord) {
+	if record.Time.IsZero() {
+		record.Time = time.Now().UTC()
+	}
+	b, err := json.Marshal(record)
+	if err != nil {
+		logger.Error("Failed to encode an audit record", "err", err)
+		return
+	}
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if _, err := l.w.Write(append(b, '\n')); err != nil {
+		logger.Error("Failed to write an audit record", "err", err)
+	}
+}
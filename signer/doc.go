@@ -0,0 +1,63 @@
+// Copyright 2024 The klaytn Authors
+// This file is part of the klaytn library.
+//
+// The klaytn library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The klaytn library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the klaytn library. If not, see <http://www.gnu.org/licenses/>.
+
+/*
+Package signer implements the external signer which keeps the keys outside of a node.
+
+A node started with `--signer <endpoint>` forwards the signing requests to the external
+signer via the ExternalSigner wallet of accounts/external. The reference binary is cmd/ksigner.
+
+# JSON-RPC API
+
+The API is served under the "account" namespace. Transactions are exchanged as RLP-encoded bytes,
+so every Klaytn transaction type can be signed. A transaction is signed with the keys of the role
+required by its type, e.g., RoleAccountUpdate for account update transactions. If the key of the role
+is not defined, the key of RoleTransaction is used as a role-based account key does.
+
+	account_version()                                               : returns the version of the API
+	account_list()                                                  : returns the addresses of the accounts
+	account_signHash(address, hash)                                 : signs a 32-byte hash with the RoleTransaction key
+	account_signTransaction(address, rawTx, chainId)                : signs a transaction as the sender
+	account_signTransactionAsFeePayer(address, rawTx, chainId)      : signs a fee-delegated transaction with the RoleFeePayer keys
+
+# Rules
+
+A rule file is a JSON object restricting the signing requests. An omitted field means no restriction,
+except that account_signHash is rejected unless allowSignHash is true.
+
+	{
+	  "allowSignHash": false,
+	  "allowedTxTypes": ["TxTypeValueTransfer", "TxTypeFeeDelegatedValueTransfer"],
+	  "allowedRecipients": ["0x..."],
+	  "maxValue": "1000000000000000000",
+	  "maxGas": 1000000,
+	  "allowedFeeDelegationSenders": ["0x..."]
+	}
+
+# Audit Log
+
+Every request is appended to the audit log as a JSON line, whether it is approved or not.
+
+	{"time":"...","method":"account_signTransaction","account":"0x...","txType":"TxTypeValueTransfer","sigHash":"0x...","approved":true}
+
+# Source Files
+
+Each file contains following contents
+  - api.go 	: Defines `SignerAPI` serving the JSON-RPC API
+  - audit.go 	: Defines `AuditLogger` writing the audit log
+  - rules.go 	: Defines `Rules` deciding whether a request is approved
+*/
+package signer
@@ -0,0 +1,149 @@
+// Copyright 2024 The klaytn Authors
+// This file is part of the klaytn library.
+//
+// The klaytn library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The klaytn library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the klaytn library. If not, see <http://www.gnu.org/licenses/>.
+
+package signer
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	gomath "math"
+	"math/big"
+
+	"github.com/klaytn/klaytn/blockchain/types"
+	"github.com/klaytn/klaytn/common"
+	"github.com/klaytn/klaytn/common/math"
+)
+
+var (
+	errSignHashNotAllowed  = errors.New("signing an arbitrary hash is not allowed")
+	errTxTypeNotAllowed    = errors.New("transaction type is not allowed")
+	errRecipientNotAllowed = errors.New("recipient is not allowed")
+	errValueTooLarge       = errors.New("value exceeds the limit")
+	errGasTooLarge         = errors.New("gas limit exceeds the limit")
+	errSenderNotAllowed    = errors.New("sender is not allowed to delegate fees")
+)
+
+// Rules decides whether a signing request is approved or not.
+// An empty list or a zero limit means that there is no restriction on the field.
+type Rules struct {
+	// AllowSignHash allows account_signHash. Since a hash can be a transaction
+	// signature hash, it is disallowed by default.
+	AllowSignHash bool `json:"allowSignHash"`
+
+	// AllowedTxTypes is the list of transaction types which can be signed, e.g., "TxTypeValueTransfer".
+	AllowedTxTypes []string `json:"allowedTxTypes"`
+
+	// AllowedRecipients is the list of recipients of transactions.
+	// Transactions without a recipient, i.e., contract deployments, are allowed only if the list is empty.
+	AllowedRecipients []common.Address `json:"allowedRecipients"`
+
+	// MaxValue is the maximum value which can be transferred by a transaction.
+	MaxValue *math.HexOrDecimal256 `json:"maxValue"`
+
+	// MaxGas is the maximum gas limit of a transaction.
+	MaxGas uint64 `json:"maxGas"`
+
+	// AllowedFeeDelegationSenders is the list of senders whose transactions can be signed as a fee payer.
+	AllowedFeeDelegationSenders []common.Address `json:"allowedFeeDelegationSenders"`
+}
+
+// LoadRules reads the rules from the given JSON file.
+func LoadRules(path string) (*Rules, error) {
+	b, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	rules := new(Rules)
+	if err := json.Unmarshal(b, rules); err != nil {
+		return nil, fmt.Errorf("invalid rule file %s: %v", path, err)
+	}
+	for _, txType := range rules.AllowedTxTypes {
+		if !isKnownTxType(txType) {
+			return nil, fmt.Errorf("invalid rule file %s: unknown transaction type %s", path, txType)
+		}
+	}
+	return rules, nil
+}
+
+// CheckSignHash returns an error if signing an arbitrary hash is not allowed.
+func (r *Rules) CheckSignHash() error {
+	if !r.AllowSignHash {
+		return errSignHashNotAllowed
+	}
+	return nil
+}
+
+// CheckTx returns an error if the transaction cannot be signed by a sender.
+func (r *Rules) CheckTx(tx *types.Transaction) error {
+	if len(r.AllowedTxTypes) > 0 && !containsString(r.AllowedTxTypes, tx.Type().String()) {
+		return fmt.Errorf("%w: %s", errTxTypeNotAllowed, tx.Type().String())
+	}
+	if len(r.AllowedRecipients) > 0 {
+		if tx.To() == nil || !containsAddress(r.AllowedRecipients, *tx.To()) {
+			return errRecipientNotAllowed
+		}
+	}
+	if r.MaxValue != nil && tx.Value().Cmp((*big.Int)(r.MaxValue)) > 0 {
+		return fmt.Errorf("%w: %v > %v", errValueTooLarge, tx.Value(), (*big.Int)(r.MaxValue))
+	}
+	if r.MaxGas != 0 && tx.Gas() > r.MaxGas {
+		return fmt.Errorf("%w: %d > %d", errGasTooLarge, tx.Gas(), r.MaxGas)
+	}
+	return nil
+}
+
+// CheckFeePayerTx returns an error if the transaction cannot be signed by a fee payer.
+func (r *Rules) CheckFeePayerTx(tx *types.Transaction) error {
+	if len(r.AllowedFeeDelegationSenders) > 0 {
+		from, err := tx.From()
+		if err != nil || !containsAddress(r.AllowedFeeDelegationSenders, from) {
+			return errSenderNotAllowed
+		}
+	}
+	if r.MaxGas != 0 && tx.Gas() > r.MaxGas {
+		return fmt.Errorf("%w: %d > %d", errGasTooLarge, tx.Gas(), r.MaxGas)
+	}
+	return nil
+}
+
+func isKnownTxType(name string) bool {
+	for t := 0; t <= gomath.MaxUint16; t++ {
+		if types.TxType(t).String() == name {
+			return true
+		}
+	}
+	return false
+}
+
+func containsString(list []string, s string) bool {
+	for _, item := range list {
+		if item == s {
+			return true
+		}
+	}
+	return false
+}
+
+func containsAddress(list []common.Address, addr common.Address) bool {
+	for _, item := range list {
+		if item == addr {
+			return true
+		}
+	}
+	return false
+}
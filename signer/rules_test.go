@@ -0,0 +1,50 @@
+// Copyright 2024 The klaytn Authors
+// This file is part of the klaytn library.
+//
+// The klaytn library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The klaytn library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the klaytn library. If not, see <http://www.gnu.org/licenses/>.
+
+package signer
+
+import (
+	"io/ioutil"
+	"math/big"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLoadRules(t *testing.T) {
+	dir := t.TempDir()
+
+	path := filepath.Join(dir, "rules.json")
+	require.NoError(t, ioutil.WriteFile(path, []byte(`{
+		"allowedTxTypes": ["TxTypeValueTransfer", "TxTypeFeeDelegatedSmartContractExecution"],
+		"maxValue": "0x64",
+		"maxGas": 100000
+	}`), 0o600))
+	rules, err := LoadRules(path)
+	require.NoError(t, err)
+	assert.False(t, rules.AllowSignHash)
+	assert.Equal(t, []string{"TxTypeValueTransfer", "TxTypeFeeDelegatedSmartContractExecution"}, rules.AllowedTxTypes)
+	assert.Equal(t, int64(100), (*big.Int)(rules.MaxValue).Int64())
+	assert.Equal(t, uint64(100000), rules.MaxGas)
+	assert.ErrorIs(t, rules.CheckSignHash(), errSignHashNotAllowed)
+
+	path = filepath.Join(dir, "invalid.json")
+	require.NoError(t, ioutil.WriteFile(path, []byte(`{"allowedTxTypes": ["TxTypeUnknown"]}`), 0o600))
+	_, err = LoadRules(path)
+	assert.ErrorContains(t, err, "unknown transaction type TxTypeUnknown")
+}
@@ -141,6 +141,24 @@ func TestChildChainData_ReadAndWrite_ReceiptFromParentChain(t *testing.T) {
 	assert.Nil(t, rctFromDB)
 }
 
+func TestChildChainData_ReadAndWrite_AnchoringProof(t *testing.T) {
+	dbm := NewMemoryDBManager()
+	defer dbm.Close()
+
+	assert.Nil(t, dbm.ReadAnchoringProof(10))
+
+	proofs := []*types.AnchoringProof{
+		{BlockNumber: 10, BlockHash: common.HexToHash("0x0a"), Siblings: []common.Hash{common.HexToHash("0x0b")}, FromBlockNumber: 10, ToBlockNumber: 11},
+		{BlockNumber: 11, BlockHash: common.HexToHash("0x0b"), Siblings: []common.Hash{common.HexToHash("0x0a")}, FromBlockNumber: 10, ToBlockNumber: 11},
+	}
+	dbm.WriteAnchoringProofs(proofs)
+
+	for _, proof := range proofs {
+		assert.Equal(t, proof, dbm.ReadAnchoringProof(proof.BlockNumber))
+	}
+	assert.Nil(t, dbm.ReadAnchoringProof(12))
+}
+
 func TestChildChainData_ReadAndWrite_ValueTransferTxHash(t *testing.T) {
 	dir, err := ioutil.TempDir("", "klaytn-test-child-chain-data")
 	if err != nil {
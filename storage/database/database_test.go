@@ -70,6 +70,24 @@ func newTestBadgerDB() (Database, func(), string) {
 	}, "badger"
 }
 
+func newTestPebbleDB() (Database, func(), string) {
+	dirName, err := ioutil.TempDir(os.TempDir(), "klay_pebbledb_test_")
+	if err != nil {
+		panic("failed to create test file: " + err.Error())
+	}
+	config := GetDefaultPebbleDBConfig()
+	config.DisableMetrics = true
+	db, err := NewPebbleDB(dirName, config, false)
+	if err != nil {
+		panic("failed to create test database: " + err.Error())
+	}
+
+	return db, func() {
+		db.Close()
+		os.RemoveAll(dirName)
+	}, "pebble"
+}
+
 func newTestMemDB() (Database, func(), string) {
 	db := NewMemDB()
 	return db, func() {
@@ -118,7 +136,7 @@ func TestDatabaseTestSuite(t *testing.T) {
 	// var testDatabases = []func() (Database, func()){newTestLDB, newTestBadgerDB, newTestMemDB, newTestDynamoS3DB}
 
 	// TODO-Klaytn-Database Need to add DynamoDB to the below list.
-	testDatabases = append(testDatabases, newTestLDB, newTestBadgerDB, newTestMemDB)
+	testDatabases = append(testDatabases, newTestLDB, newTestBadgerDB, newTestPebbleDB, newTestMemDB)
 	for _, newFn := range testDatabases {
 		suite.Run(t, &commonDatabaseTestSuite{newFn: newFn})
 	}
@@ -0,0 +1,267 @@
+// Copyright 2024 The klaytn Authors
+// This file is part of the klaytn library.
+//
+// The klaytn library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The klaytn library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the klaytn library. If not, see <http://www.gnu.org/licenses/>.
+
+package database
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/klaytn/klaytn/common"
+)
+
+var errUnknownDBEntry = errors.New("unknown database entry")
+
+// KeyStat is the number and the total size of the keys (including values)
+// belonging to one key category of the schema.
+type KeyStat struct {
+	Category string
+	Count    uint64
+	Size     common.StorageSize
+}
+
+// DBEntryStat is the key statistics of a physical database of a DBManager.
+type DBEntryStat struct {
+	Entry      string
+	Count      uint64
+	Size       common.StorageSize
+	Categories []KeyStat
+}
+
+// ChainIssue describes an inconsistency found in the canonical chain index.
+type ChainIssue struct {
+	Number uint64
+	Hash   common.Hash
+	Reason string
+}
+
+const (
+	unaccountedCategory = "Unaccounted"
+	singleDBEntry       = "single"
+)
+
+// metadataKeys are the singleton keys of the schema.
+var metadataKeys = [][]byte{
+	databaseVerisionKey, headHeaderKey, headBlockKey, headBlockBackupKey,
+	headFastBlockKey, headFastBlockBackupKey, fastTrieProgressKey, validSectionKey,
+	snapshotJournalKey, SnapshotGeneratorKey, snapshotDisabledKey, snapshotRecoveryKey,
+	snapshotSyncStatusKey, snapshotRootKey, badBlockKey, pruningEnabledKey,
+	lastServiceChainTxReceiptKey, lastIndexedBlockKey, migrationStatusKey,
+	chaindatafetcherCheckpointKey,
+}
+
+// keyCategories classifies a key by the schema prefixes. The first matching
+// category wins, so the more specific rules come first.
+var keyCategories = []struct {
+	name  string
+	match func(key []byte) bool
+}{
+	{"Metadata", func(key []byte) bool {
+		for _, k := range metadataKeys {
+			if bytes.Equal(key, k) {
+				return true
+			}
+		}
+		return bytes.HasPrefix(key, databaseDirPrefix) || bytes.HasPrefix(key, sectionHeadKeyPrefix)
+	}},
+	{"Headers", prefixedLen(headerPrefix, 8+common.HashLength)},
+	{"Total difficulties", func(key []byte) bool {
+		return prefixedLen(headerPrefix, 8+common.HashLength+len(headerTDSuffix))(key) && bytes.HasSuffix(key, headerTDSuffix)
+	}},
+	{"Canonical hashes", func(key []byte) bool {
+		return prefixedLen(headerPrefix, 8+len(headerHashSuffix))(key) && bytes.HasSuffix(key, headerHashSuffix)
+	}},
+	{"Header numbers", prefixedLen(headerNumberPrefix, common.HashLength)},
+	{"Bodies", prefixedLen(blockBodyPrefix, 8+common.HashLength)},
+	{"Receipts", prefixedLen(blockReceiptsPrefix, 8+common.HashLength)},
+	{"Transaction lookups", prefixedLen(txLookupPrefix, common.HashLength)},
+	{"Contract codes", prefixedLen(codePrefix, common.HashLength)},
+	{"Snapshot accounts", prefixedLen(SnapshotAccountPrefix, common.HashLength)},
+	{"Snapshot storages", prefixedLen(SnapshotStoragePrefix, 2*common.HashLength)},
+	{"Preimages", prefixedLen(preimagePrefix, common.HashLength)},
+	{"Bloom bits", prefixedLen(bloomBitsPrefix, 10+common.HashLength)},
+	{"Bloom bits index", hasPrefix(BloomBitsIndexPrefix)},
+	{"Chain configs", prefixedLen(configPrefix, common.HashLength)},
+	{"Pruning marks", prefixedLen(pruningMarkPrefix, pruningMarkKeyLen-len(pruningMarkPrefix))},
+	{"Governance", hasPrefix(governancePrefix)},
+	{"Istanbul snapshots", prefixedLen(snapshotKeyPrefix, common.HashLength)},
+	{"Staking info", hasPrefix(stakingInfoPrefix)},
+	{"Treasury rebalance", hasPrefix(treasuryRebalancePrefix)},
+	{"Contract ABIs", prefixedLen(contractABIPrefix, common.AddressLength)},
+	{"Sender tx hashes", prefixedLen(senderTxHashToTxHashPrefix, common.HashLength)},
+	{"Bridge data", hasPrefix(childChainTxHashPrefix, receiptFromParentChainKeyPrefix, anchoringProofKeyPrefix,
+		parentOperatorFeePayerPrefix, childOperatorFeePayerPrefix, valueTransferTxHashPrefix)},
+	{"Trie nodes", func(key []byte) bool {
+		return len(key) == common.HashLength || len(key) == common.ExtHashLength
+	}},
+}
+
+func hasPrefix(prefixes ...[]byte) func([]byte) bool {
+	return func(key []byte) bool {
+		for _, prefix := range prefixes {
+			if bytes.HasPrefix(key, prefix) {
+				return true
+			}
+		}
+		return false
+	}
+}
+
+func prefixedLen(prefix []byte, suffixLen int) func([]byte) bool {
+	return func(key []byte) bool {
+		return len(key) == len(prefix)+suffixLen && bytes.HasPrefix(key, prefix)
+	}
+}
+
+// KeyCategory returns the name of the schema category the key belongs to.
+func KeyCategory(key []byte) string {
+	for _, category := range keyCategories {
+		if category.match(key) {
+			return category.name
+		}
+	}
+	return unaccountedCategory
+}
+
+// ParseDBEntryType returns the DBEntryType whose directory name is the given name.
+func ParseDBEntryType(name string) (DBEntryType, error) {
+	for et, dir := range dbBaseDirs {
+		if dir == name {
+			return DBEntryType(et), nil
+		}
+	}
+	return 0, fmt.Errorf("%w: %q", errUnknownDBEntry, name)
+}
+
+// GetRawDatabase returns the physical database holding the given entry type.
+func GetRawDatabase(dbm DBManager, et DBEntryType) (Database, error) {
+	if et >= databaseEntryTypeSize {
+		return nil, fmt.Errorf("%w: %d", errUnknownDBEntry, et)
+	}
+	db := dbm.getDatabase(et)
+	if db == nil {
+		return nil, fmt.Errorf("database %q is not opened", et)
+	}
+	return db, nil
+}
+
+// InspectDatabase iterates all databases of the given DBManager and returns
+// the key statistics of each of them, grouped by key category.
+func InspectDatabase(dbm DBManager) ([]DBEntryStat, error) {
+	if dbm.IsSingle() || dbm.GetDBConfig().DBType == MemoryDB {
+		stat, err := inspectRawDatabase(singleDBEntry, dbm.getDatabase(MiscDB))
+		if err != nil {
+			return nil, err
+		}
+		return []DBEntryStat{stat}, nil
+	}
+
+	var stats []DBEntryStat
+	for et := MiscDB; et < databaseEntryTypeSize; et++ {
+		db := dbm.getDatabase(et)
+		if db == nil {
+			continue
+		}
+		stat, err := inspectRawDatabase(et.String(), db)
+		if err != nil {
+			return nil, err
+		}
+		stats = append(stats, stat)
+	}
+	return stats, nil
+}
+
+func inspectRawDatabase(entry string, db Database) (DBEntryStat, error) {
+	var (
+		stat    = DBEntryStat{Entry: entry}
+		indices = make(map[string]int)
+		start   = time.Now()
+		logged  = time.Now()
+	)
+	it := db.NewIterator(nil, nil)
+	defer it.Release()
+
+	for it.Next() {
+		var (
+			key      = it.Key()
+			size     = common.StorageSize(len(key) + len(it.Value()))
+			category = KeyCategory(key)
+		)
+		idx, ok := indices[category]
+		if !ok {
+			idx = len(stat.Categories)
+			indices[category] = idx
+			stat.Categories = append(stat.Categories, KeyStat{Category: category})
+		}
+		stat.Categories[idx].Count++
+		stat.Categories[idx].Size += size
+		stat.Count++
+		stat.Size += size
+
+		if time.Since(logged) > 8*time.Second {
+			logger.Info("Inspecting database", "entry", entry, "count", stat.Count, "elapsed", common.PrettyDuration(time.Since(start)))
+			logged = time.Now()
+		}
+	}
+	return stat, it.Error()
+}
+
+// CheckCanonicalChain walks the canonical chain index from the genesis up to
+// the head header and reports canonical hashes without a header, canonical
+// blocks without a body and canonical hashes left beyond the head header.
+func CheckCanonicalChain(dbm DBManager) []ChainIssue {
+	headHeader := dbm.ReadHeadHeaderHash()
+	headHeaderNum := dbm.ReadHeaderNumber(headHeader)
+	if headHeaderNum == nil {
+		return []ChainIssue{{Hash: headHeader, Reason: "head header number missing"}}
+	}
+	headBlockNum := *headHeaderNum
+	if n := dbm.ReadHeaderNumber(dbm.ReadHeadBlockHash()); n != nil && *n < headBlockNum {
+		headBlockNum = *n
+	}
+
+	var (
+		issues []ChainIssue
+		start  = time.Now()
+		logged = time.Now()
+	)
+	for number := uint64(0); number <= *headHeaderNum; number++ {
+		hash := dbm.ReadCanonicalHash(number)
+		switch {
+		case common.EmptyHash(hash):
+			issues = append(issues, ChainIssue{Number: number, Reason: "missing canonical hash"})
+		case !dbm.HasHeader(hash, number):
+			issues = append(issues, ChainIssue{Number: number, Hash: hash, Reason: "dangling canonical hash"})
+		case number <= headBlockNum && !dbm.HasBody(hash, number):
+			issues = append(issues, ChainIssue{Number: number, Hash: hash, Reason: "missing body"})
+		}
+
+		if time.Since(logged) > 8*time.Second {
+			logger.Info("Checking canonical chain", "number", number, "head", *headHeaderNum, "issues", len(issues), "elapsed", common.PrettyDuration(time.Since(start)))
+			logged = time.Now()
+		}
+	}
+	for number := *headHeaderNum + 1; ; number++ {
+		hash := dbm.ReadCanonicalHash(number)
+		if common.EmptyHash(hash) {
+			break
+		}
+		issues = append(issues, ChainIssue{Number: number, Hash: hash, Reason: "canonical hash beyond head header"})
+	}
+	return issues
+}
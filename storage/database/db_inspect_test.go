@@ -0,0 +1,140 @@
+// Copyright 2024 The klaytn Authors
+// This file is part of the klaytn library.
+//
+// The klaytn library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The klaytn library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the klaytn library. If not, see <http://www.gnu.org/licenses/>.
+
+package database
+
+import (
+	"math/big"
+	"os"
+	"testing"
+
+	"github.com/klaytn/klaytn/blockchain/types"
+	"github.com/klaytn/klaytn/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func writeTestChain(dbm DBManager, n int) []*types.Header {
+	headers := make([]*types.Header, n)
+	for i := 0; i < n; i++ {
+		header := &types.Header{Number: big.NewInt(int64(i))}
+		if i > 0 {
+			header.ParentHash = headers[i-1].Hash()
+		}
+		headers[i] = header
+		dbm.WriteHeader(header)
+		dbm.WriteBody(header.Hash(), uint64(i), &types.Body{})
+		dbm.WriteCanonicalHash(header.Hash(), uint64(i))
+	}
+	dbm.WriteHeadHeaderHash(headers[n-1].Hash())
+	dbm.WriteHeadBlockHash(headers[n-1].Hash())
+	return headers
+}
+
+func TestKeyCategory(t *testing.T) {
+	hash := common.HexToHash("0x1234")
+	tests := map[string][]byte{
+		"Metadata":            headBlockKey,
+		"Headers":             headerKey(1, hash),
+		"Total difficulties":  headerTDKey(1, hash),
+		"Canonical hashes":    headerHashKey(1),
+		"Header numbers":      headerNumberKey(hash),
+		"Bodies":              blockBodyKey(1, hash),
+		"Receipts":            blockReceiptsKey(1, hash),
+		"Transaction lookups": TxLookupKey(hash),
+		"Contract codes":      CodeKey(hash),
+		"Governance":          makeKey(governancePrefix, 1),
+		"Pruning marks":       pruningMarkKey(PruningMark{Number: 1, Hash: hash.ExtendZero()}),
+		"Trie nodes":          hash.Bytes(),
+		unaccountedCategory:   []byte("unknown-key"),
+	}
+	for category, key := range tests {
+		assert.Equal(t, category, KeyCategory(key), "key %x", key)
+	}
+}
+
+func TestParseDBEntryType(t *testing.T) {
+	et, err := ParseDBEntryType("receipts")
+	assert.NoError(t, err)
+	assert.Equal(t, ReceiptsDB, et)
+
+	_, err = ParseDBEntryType("unknown")
+	assert.ErrorIs(t, err, errUnknownDBEntry)
+}
+
+func TestInspectDatabase(t *testing.T) {
+	dbm := NewMemoryDBManager()
+	writeTestChain(dbm, 3)
+
+	stats, err := InspectDatabase(dbm)
+	require.NoError(t, err)
+	require.Len(t, stats, 1)
+	assert.Equal(t, singleDBEntry, stats[0].Entry)
+
+	counts := make(map[string]uint64)
+	var total uint64
+	for _, category := range stats[0].Categories {
+		counts[category.Category] = category.Count
+		total += category.Count
+	}
+	assert.Equal(t, uint64(3), counts["Headers"])
+	assert.Equal(t, uint64(3), counts["Bodies"])
+	assert.Equal(t, uint64(3), counts["Canonical hashes"])
+	assert.Equal(t, uint64(3), counts["Header numbers"])
+	assert.Equal(t, uint64(2), counts["Metadata"])
+	assert.Equal(t, stats[0].Count, total)
+}
+
+func TestCheckCanonicalChain(t *testing.T) {
+	dbm := NewMemoryDBManager()
+	headers := writeTestChain(dbm, 5)
+	assert.Empty(t, CheckCanonicalChain(dbm))
+
+	dbm.DeleteBody(headers[2].Hash(), 2)
+	dbm.DeleteHeader(headers[3].Hash(), 3)
+	dbm.WriteCanonicalHash(common.HexToHash("0xdead"), 7)
+
+	issues := CheckCanonicalChain(dbm)
+	assert.Equal(t, []ChainIssue{
+		{Number: 2, Hash: headers[2].Hash(), Reason: "missing body"},
+		{Number: 3, Hash: headers[3].Hash(), Reason: "dangling canonical hash"},
+	}, issues)
+
+	dbm.WriteCanonicalHash(common.HexToHash("0xbeef"), 5)
+	issues = CheckCanonicalChain(dbm)
+	require.Len(t, issues, 3)
+	assert.Equal(t, ChainIssue{Number: 5, Hash: common.HexToHash("0xbeef"), Reason: "canonical hash beyond head header"}, issues[2])
+}
+
+func TestReadOnlyDBManager(t *testing.T) {
+	dir, err := os.MkdirTemp("", "klaytn-db-readonly")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	dbc := &DBConfig{Dir: dir, DBType: LevelDB, LevelDBCacheSize: 16, OpenFilesLimit: 16}
+	dbm := NewDBManager(dbc)
+	headers := writeTestChain(dbm, 2)
+	dbm.Close()
+
+	dbc = &DBConfig{Dir: dir, DBType: LevelDB, LevelDBCacheSize: 16, OpenFilesLimit: 16, ReadOnly: true}
+	dbm = NewDBManager(dbc)
+	defer dbm.Close()
+
+	assert.Equal(t, headers[1].Hash(), dbm.ReadHeadBlockHash())
+	db, err := GetRawDatabase(dbm, BodyDB)
+	require.NoError(t, err)
+	assert.Error(t, db.Put([]byte("key"), []byte("value")))
+}
@@ -27,6 +27,7 @@ import (
 	"strconv"
 	"strings"
 	"sync"
+	"time"
 
 	"github.com/dgraph-io/badger"
 	"github.com/klaytn/klaytn/blockchain/types"
@@ -260,6 +261,9 @@ type DBManager interface {
 	WriteReceiptFromParentChain(blockHash common.Hash, receipt *types.Receipt)
 	ReadReceiptFromParentChain(blockHash common.Hash) *types.Receipt
 
+	WriteAnchoringProofs(proofs []*types.AnchoringProof)
+	ReadAnchoringProof(blockNum uint64) *types.AnchoringProof
+
 	WriteHandleTxHashFromRequestTxHash(rTx, hTx common.Hash)
 	ReadHandleTxHashFromRequestTxHash(rTx common.Hash) common.Hash
 
@@ -294,8 +298,19 @@ type DBManager interface {
 	WriteStakingInfo(blockNum uint64, stakingInfo []byte) error
 	HasStakingInfo(blockNum uint64) (bool, error)
 
+	// TreasuryRebalance related functions
+	ReadTreasuryRebalanceResult(blockNum uint64) ([]byte, error)
+	WriteTreasuryRebalanceResult(blockNum uint64, result []byte) error
+
+	// Verified contract related functions
+	ReadContractABI(addr common.Address) ([]byte, error)
+	WriteContractABI(addr common.Address, abi []byte) error
+
 	// DB migration related function
 	StartDBMigration(DBManager) error
+	StartOnlineDBMigration(DBManager) error
+	StopOnlineDBMigration() error
+	OnlineDBMigrationStatus() *OnlineDBMigrationStatus
 
 	// ChainDataFetcher checkpoint function
 	WriteChainDataFetcherCheckpoint(checkpoint uint64) error
@@ -410,6 +425,13 @@ func getDBEntryConfig(originalDBC *DBConfig, i DBEntryType, dbDir string) *DBCon
 		newDBC.RocksDBConfig = &newRocksDBConfig
 	}
 
+	if newDBC.PebbleDBConfig != nil {
+		newPebbleDBConfig := *originalDBC.PebbleDBConfig
+		newPebbleDBConfig.CacheSize = originalDBC.PebbleDBConfig.CacheSize * uint64(ratio) / 100
+		newPebbleDBConfig.MaxOpenFiles = originalDBC.PebbleDBConfig.MaxOpenFiles * ratio / 100
+		newDBC.PebbleDBConfig = &newPebbleDBConfig
+	}
+
 	return &newDBC
 }
 
@@ -423,6 +445,11 @@ type databaseManager struct {
 	lockInMigration      sync.RWMutex
 	inMigration          bool
 	migrationBlockNumber uint64
+
+	// lockDBs protects dbs from being replaced while they are read.
+	// It is held exclusively by the migrations replacing dbs.
+	lockDBs         sync.RWMutex
+	onlineMigration *onlineDBMigration
 }
 
 func NewMemoryDBManager() DBManager {
@@ -448,6 +475,11 @@ type DBConfig struct {
 	ParallelDBWrite     bool
 	OpenFilesLimit      int
 	EnableDBPerfMetrics bool // If true, read and write performance will be logged
+	ReadOnly            bool // If true, LevelDB, PebbleDB and DynamoDB are opened without write access
+
+	// Sharded state trie DB related configurations.
+	ShardMirror        bool          // whether each shard of state trie db has a mirror
+	ShardScrubInterval time.Duration // interval of verifying and repairing shards with their mirrors, 0 to disable
 
 	// LevelDB related configurations.
 	LevelDBCacheSize   int // LevelDBCacheSize = BlockCacheCapacity + WriteBuffer
@@ -457,6 +489,9 @@ type DBConfig struct {
 	// RocksDB related configurations
 	RocksDBConfig *RocksDBConfig
 
+	// PebbleDB related configurations
+	PebbleDBConfig *PebbleDBConfig
+
 	// DynamoDB related configurations
 	DynamoDBConfig *DynamoDBConfig
 }
@@ -545,9 +580,16 @@ func newDatabase(dbc *DBConfig, entryType DBEntryType) (Database, error) {
 		return NewRocksDB(dbc.Dir, dbc.RocksDBConfig)
 	case BadgerDB:
 		return NewBadgerDB(dbc.Dir)
+	case PebbleDB:
+		return NewPebbleDB(dbc.Dir, dbc.PebbleDBConfig, dbc.ReadOnly)
 	case MemoryDB:
 		return NewMemDB(), nil
 	case DynamoDB:
+		if dbc.ReadOnly {
+			dynamoConfig := *dbc.DynamoDBConfig
+			dynamoConfig.ReadOnly = true
+			return NewDynamoDB(&dynamoConfig)
+		}
 		return NewDynamoDB(dbc.DynamoDBConfig)
 	default:
 		logger.Info("database type is not set, fall back to default LevelDB")
@@ -629,7 +671,7 @@ func (dbm *databaseManager) NewBatch(dbEntryType DBEntryType) Batch {
 	} else if dbEntryType == StateTrieMigrationDB {
 		return dbm.GetStateTrieMigrationDB().NewBatch()
 	}
-	return dbm.getDatabase(dbEntryType).NewBatch()
+	return dbm.newEntryBatch(dbEntryType)
 }
 
 func NewStateTrieDBBatch(batches []Batch) Batch {
@@ -775,6 +817,10 @@ func (dbm *databaseManager) CreateMigrationDBAndSetStatus(blockNum uint64) error
 		logger.Warn("Setting a new database for state trie migration is allowed for non-single database only")
 		return errors.New("singleDB does not support state trie migration")
 	}
+	if status := dbm.OnlineDBMigrationStatus(); status != nil && status.Running {
+		logger.Warn("Failed to set a new state trie migration db. Online DB migration is in progress")
+		return errOnlineMigrationInProgress
+	}
 
 	logger.Info("Start setting a new database for state trie migration", "blockNum", blockNum)
 
@@ -789,7 +835,7 @@ func (dbm *databaseManager) CreateMigrationDBAndSetStatus(blockNum uint64) error
 	dbm.setDBDir(StateTrieMigrationDB, newDBDir)
 
 	// Set migration db
-	dbm.dbs[StateTrieMigrationDB] = newDB
+	dbm.setDatabase(StateTrieMigrationDB, newDB)
 
 	// Store the migration status
 	dbm.setStateTrieMigrationStatus(blockNum)
@@ -819,11 +865,11 @@ func (dbm *databaseManager) FinishStateMigration(succeed bool) chan struct{} {
 
 	// Replace StateTrieDB with new one
 	dbm.setDBDir(StateTrieDB, dbDirToBeUsed)
-	dbm.dbs[StateTrieDB] = dbToBeUsed
+	dbm.setDatabase(StateTrieDB, dbToBeUsed)
 
 	dbm.setStateTrieMigrationStatus(0)
 
-	dbm.dbs[StateTrieMigrationDB] = nil
+	dbm.setDatabase(StateTrieMigrationDB, nil)
 	dbm.setDBDir(StateTrieMigrationDB, "")
 
 	dbPathToBeRemoved := filepath.Join(dbm.config.Dir, dbDirToBeRemoved)
@@ -848,14 +894,23 @@ func removeDB(dbPath string, endCheck chan struct{}) {
 }
 
 func (dbm *databaseManager) GetStateTrieDB() Database {
+	dbm.lockDBs.RLock()
+	defer dbm.lockDBs.RUnlock()
+
 	return dbm.dbs[StateTrieDB]
 }
 
 func (dbm *databaseManager) GetStateTrieMigrationDB() Database {
+	dbm.lockDBs.RLock()
+	defer dbm.lockDBs.RUnlock()
+
 	return dbm.dbs[StateTrieMigrationDB]
 }
 
 func (dbm *databaseManager) GetMiscDB() Database {
+	dbm.lockDBs.RLock()
+	defer dbm.lockDBs.RUnlock()
+
 	return dbm.dbs[MiscDB]
 }
 
@@ -897,6 +952,14 @@ func (dbm *databaseManager) GetDBConfig() *DBConfig {
 }
 
 func (dbm *databaseManager) getDatabase(dbEntryType DBEntryType) Database {
+	dbm.lockDBs.RLock()
+	defer dbm.lockDBs.RUnlock()
+
+	return dbm.getDatabaseLocked(dbEntryType)
+}
+
+// getDatabaseLocked is getDatabase for callers holding lockDBs.
+func (dbm *databaseManager) getDatabaseLocked(dbEntryType DBEntryType) Database {
 	if dbm.config.DBType == MemoryDB {
 		return dbm.dbs[0]
 	} else {
@@ -904,7 +967,20 @@ func (dbm *databaseManager) getDatabase(dbEntryType DBEntryType) Database {
 	}
 }
 
+// setDatabase replaces the database of dbEntryType.
+func (dbm *databaseManager) setDatabase(dbEntryType DBEntryType, db Database) {
+	dbm.lockDBs.Lock()
+	defer dbm.lockDBs.Unlock()
+
+	dbm.dbs[dbEntryType] = db
+}
+
 func (dbm *databaseManager) Close() {
+	// Return to the original databases before closing them.
+	if err := dbm.StopOnlineDBMigration(); err == nil {
+		logger.Info("Online DB migration is stopped by closing databases")
+	}
+
 	// If single DB, only close the first database.
 	if dbm.config.SingleDB {
 		dbm.dbs[0].Close()
@@ -2582,6 +2658,41 @@ func (dbm *databaseManager) ReadReceiptFromParentChain(blockHash common.Hash) *t
 	return (*types.Receipt)(serviceChainTxReceipt)
 }
 
+// WriteAnchoringProofs writes the inclusion proofs of child chain blocks in the Merkle root
+// anchored to the parent chain.
+func (dbm *databaseManager) WriteAnchoringProofs(proofs []*types.AnchoringProof) {
+	batch := dbm.NewBatch(bridgeServiceDB)
+	defer batch.Release()
+	for _, proof := range proofs {
+		data, err := rlp.EncodeToBytes(proof)
+		if err != nil {
+			logger.Crit("Failed to RLP encode anchoring proof", "blockNumber", proof.BlockNumber, "err", err)
+		}
+		if err := batch.Put(anchoringProofKey(proof.BlockNumber), data); err != nil {
+			logger.Crit("Failed to store anchoring proof", "blockNumber", proof.BlockNumber, "err", err)
+		}
+	}
+	if err := batch.Write(); err != nil {
+		logger.Crit("Failed to store anchoring proofs", "err", err)
+	}
+}
+
+// ReadAnchoringProof returns the inclusion proof of the child chain block in the Merkle root
+// anchored to the parent chain.
+func (dbm *databaseManager) ReadAnchoringProof(blockNum uint64) *types.AnchoringProof {
+	db := dbm.getDatabase(bridgeServiceDB)
+	data, _ := db.Get(anchoringProofKey(blockNum))
+	if len(data) == 0 {
+		return nil
+	}
+	proof := new(types.AnchoringProof)
+	if err := rlp.DecodeBytes(data, proof); err != nil {
+		logger.Error("Invalid anchoring proof RLP", "blockNumber", blockNum, "err", err)
+		return nil
+	}
+	return proof
+}
+
 // WriteParentOperatorFeePayer writes a fee payer of parent operator.
 func (dbm *databaseManager) WriteParentOperatorFeePayer(feePayer common.Address) {
 	key := parentOperatorFeePayerPrefix
@@ -0,0 +1,33 @@
+// Copyright 2024 The klaytn Authors
+// This file is part of the klaytn library.
+//
+// The klaytn library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The klaytn library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the klaytn library. If not, see <http://www.gnu.org/licenses/>.
+
+package database
+
+import "github.com/klaytn/klaytn/common"
+
+// ReadContractABI reads the verified ABI of the contract deployed at the given address.
+// The ABI is stored in MiscDB.
+func (dbm *databaseManager) ReadContractABI(addr common.Address) ([]byte, error) {
+	db := dbm.getDatabase(MiscDB)
+	return db.Get(contractABIKey(addr))
+}
+
+// WriteContractABI writes the verified ABI of the contract deployed at the given address.
+// Value is the ABI in JSON format. The ABI is stored in MiscDB.
+func (dbm *databaseManager) WriteContractABI(addr common.Address, abi []byte) error {
+	db := dbm.getDatabase(MiscDB)
+	return db.Put(contractABIKey(addr), abi)
+}
@@ -0,0 +1,39 @@
+// Copyright 2024 The klaytn Authors
+// This file is part of the klaytn library.
+//
+// The klaytn library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The klaytn library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the klaytn library. If not, see <http://www.gnu.org/licenses/>.
+
+package database
+
+import (
+	"testing"
+
+	"github.com/klaytn/klaytn/common"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDatabaseManager_ContractABI(t *testing.T) {
+	addr := common.HexToAddress("0x0000000000000000000000000000000000000400")
+	for _, dbm := range dbManagers {
+		_, err := dbm.ReadContractABI(addr)
+		assert.Error(t, err)
+
+		abi := []byte(`[{"type":"function","name":"totalSupply","inputs":[],"outputs":[{"name":"","type":"uint256"}]}]`)
+		assert.NoError(t, dbm.WriteContractABI(addr, abi))
+
+		result, err := dbm.ReadContractABI(addr)
+		assert.NoError(t, err)
+		assert.Equal(t, abi, result)
+	}
+}
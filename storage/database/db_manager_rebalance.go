@@ -0,0 +1,36 @@
+// Copyright 2024 The klaytn Authors
+// This file is part of the klaytn library.
+//
+// The klaytn library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The klaytn library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the klaytn library. If not, see <http://www.gnu.org/licenses/>.
+
+package database
+
+// ReadTreasuryRebalanceResult reads the result memo of the treasury rebalance
+// executed at the given block number. The result is stored in MiscDB.
+func (dbm *databaseManager) ReadTreasuryRebalanceResult(blockNum uint64) ([]byte, error) {
+	db := dbm.getDatabase(MiscDB)
+
+	key := makeKey(treasuryRebalancePrefix, blockNum)
+	return db.Get(key)
+}
+
+// WriteTreasuryRebalanceResult writes the result memo of the treasury rebalance
+// executed at the given block number. Value is the marshaled result of the rebalance.
+// The result is stored in MiscDB.
+func (dbm *databaseManager) WriteTreasuryRebalanceResult(blockNum uint64, result []byte) error {
+	db := dbm.getDatabase(MiscDB)
+
+	key := makeKey(treasuryRebalancePrefix, blockNum)
+	return db.Put(key, result)
+}
@@ -0,0 +1,605 @@
+// Copyright 2024 The klaytn Authors
+// This file is part of the klaytn library.
+//
+// The klaytn library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The klaytn library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the klaytn library. If not, see <http://www.gnu.org/licenses/>.
+
+package database
+
+import (
+	"sync"
+	"time"
+
+	"github.com/klaytn/klaytn/common"
+	"github.com/pkg/errors"
+)
+
+var (
+	errOnlineMigrationInProgress = errors.New("online db migration is already in progress")
+	errOnlineMigrationStopped    = errors.New("online db migration is stopped")
+	errOnlineMigrationNotRunning = errors.New("online db migration is not in progress")
+	errOnlineMigrationSingleDB   = errors.New("single db can only be migrated to single db")
+	errOnlineMigrationStateTrie  = errors.New("online db migration is not allowed during state trie migration")
+)
+
+// OnlineDBMigrationEntryStatus is the copy progress of one database entry.
+type OnlineDBMigrationEntryStatus struct {
+	Entry      string             `json:"entry"`
+	Copied     uint64             `json:"copied"`
+	CopiedSize common.StorageSize `json:"copiedSize"`
+	Done       bool               `json:"done"`
+}
+
+// OnlineDBMigrationStatus is the progress of an online DB migration.
+type OnlineDBMigrationStatus struct {
+	Running   bool                           `json:"running"`
+	Switched  bool                           `json:"switched"`
+	DstDBType DBType                         `json:"dstDBType"`
+	DstDir    string                         `json:"dstDir"`
+	StartTime time.Time                      `json:"startTime"`
+	Elapsed   string                         `json:"elapsed"`
+	Entries   []OnlineDBMigrationEntryStatus `json:"entries"`
+	Err       string                         `json:"err"`
+}
+
+// onlineMigrationDB is a Database which writes to both of a source and a
+// destination database while the history of the source is copied to the
+// destination in the background. Reads are served by the source until the
+// migration switches them to the destination.
+type onlineMigrationDB struct {
+	name string
+	src  Database
+	dst  Database
+
+	// lock is held exclusively by the copier while it writes a chunk and by
+	// detach, and shared by every other operation.
+	lock     sync.RWMutex
+	switched bool // reads are served by dst
+	detached bool // dst is not used anymore
+
+	copied     uint64
+	copiedSize common.StorageSize
+	done       bool
+
+	onDstErr func(error)
+}
+
+func newOnlineMigrationDB(name string, src, dst Database, onDstErr func(error)) *onlineMigrationDB {
+	return &onlineMigrationDB{name: name, src: src, dst: dst, onDstErr: onDstErr}
+}
+
+// readDB returns the database serving reads. lock should be held.
+func (db *onlineMigrationDB) readDB() Database {
+	if db.switched && !db.detached {
+		return db.dst
+	}
+	return db.src
+}
+
+// Put writes to both databases. An error of the destination does not fail
+// the write but aborts the migration.
+func (db *onlineMigrationDB) Put(key []byte, value []byte) error {
+	db.lock.RLock()
+	defer db.lock.RUnlock()
+
+	if err := db.src.Put(key, value); err != nil {
+		return err
+	}
+	if !db.detached {
+		if err := db.dst.Put(key, value); err != nil {
+			db.onDstErr(err)
+		}
+	}
+	return nil
+}
+
+func (db *onlineMigrationDB) Delete(key []byte) error {
+	db.lock.RLock()
+	defer db.lock.RUnlock()
+
+	if err := db.src.Delete(key); err != nil {
+		return err
+	}
+	if !db.detached {
+		if err := db.dst.Delete(key); err != nil {
+			db.onDstErr(err)
+		}
+	}
+	return nil
+}
+
+func (db *onlineMigrationDB) Get(key []byte) ([]byte, error) {
+	db.lock.RLock()
+	defer db.lock.RUnlock()
+
+	return db.readDB().Get(key)
+}
+
+func (db *onlineMigrationDB) Has(key []byte) (bool, error) {
+	db.lock.RLock()
+	defer db.lock.RUnlock()
+
+	return db.readDB().Has(key)
+}
+
+// Close closes the source database only. The destination is closed by its DBManager.
+func (db *onlineMigrationDB) Close() {
+	db.src.Close()
+}
+
+func (db *onlineMigrationDB) NewBatch() Batch {
+	db.lock.RLock()
+	defer db.lock.RUnlock()
+
+	if db.detached {
+		return db.src.NewBatch()
+	}
+	return &onlineMigrationBatch{db: db, src: db.src.NewBatch(), dst: db.dst.NewBatch()}
+}
+
+func (db *onlineMigrationDB) Type() DBType {
+	db.lock.RLock()
+	defer db.lock.RUnlock()
+
+	return db.readDB().Type()
+}
+
+// Meter does nothing since both databases are already metered by their DBManagers.
+func (db *onlineMigrationDB) Meter(prefix string) {}
+
+func (db *onlineMigrationDB) NewIterator(prefix []byte, start []byte) Iterator {
+	db.lock.RLock()
+	defer db.lock.RUnlock()
+
+	return db.readDB().NewIterator(prefix, start)
+}
+
+func (db *onlineMigrationDB) GetProperty(name string) string {
+	db.lock.RLock()
+	defer db.lock.RUnlock()
+
+	return db.readDB().GetProperty(name)
+}
+
+func (db *onlineMigrationDB) TryCatchUpWithPrimary() error {
+	return db.src.TryCatchUpWithPrimary()
+}
+
+// copyHistory copies all items of the source to the destination.
+// Values are read again under the lock before being written, so items updated
+// or deleted by concurrent writes after the iterator is created are not
+// overwritten with stale values.
+func (db *onlineMigrationDB) copyHistory(quit, fail <-chan struct{}) error {
+	it := db.src.NewIterator(nil, nil)
+	defer it.Release()
+
+	start := time.Now()
+	keys := make([][]byte, 0, 1024)
+	size := 0
+	for it.Next() {
+		key := make([]byte, len(it.Key()))
+		copy(key, it.Key())
+		keys = append(keys, key)
+		size += len(key) + len(it.Value())
+		if size < IdealBatchSize {
+			continue
+		}
+
+		if err := db.copyKeys(keys); err != nil {
+			return err
+		}
+		keys, size = keys[:0], 0
+
+		select {
+		case <-quit:
+			return errOnlineMigrationStopped
+		case <-fail:
+			return errOnlineMigrationStopped
+		default:
+		}
+	}
+	if err := it.Error(); err != nil {
+		return errors.WithMessage(err, "failed to iterate")
+	}
+	if err := db.copyKeys(keys); err != nil {
+		return err
+	}
+
+	db.lock.Lock()
+	db.done = true
+	copied := db.copied
+	db.lock.Unlock()
+
+	logger.Info("Finish copying DB for online migration", "db", db.name, "copied", copied, "elapsed", time.Since(start))
+	return nil
+}
+
+func (db *onlineMigrationDB) copyKeys(keys [][]byte) error {
+	if len(keys) == 0 {
+		return nil
+	}
+
+	db.lock.Lock()
+	defer db.lock.Unlock()
+
+	batch := db.dst.NewBatch()
+	defer batch.Release()
+
+	copied, size := 0, 0
+	for _, key := range keys {
+		val, err := db.src.Get(key)
+		if err == dataNotFoundErr {
+			continue // deleted after the iterator is created
+		} else if err != nil {
+			return errors.WithMessage(err, "failed to read src db")
+		}
+		if err := batch.Put(key, val); err != nil {
+			return errors.WithMessage(err, "failed to put batch")
+		}
+		copied++
+		size += len(key) + len(val)
+	}
+	if err := batch.Write(); err != nil {
+		return errors.WithMessage(err, "failed to write items")
+	}
+
+	prev := db.copied
+	db.copied += uint64(copied)
+	db.copiedSize += common.StorageSize(size)
+	if db.copied/reportCycle != prev/reportCycle {
+		logger.Info("DB migrated online", "db", db.name, "copied", db.copied, "size", db.copiedSize)
+	}
+	return nil
+}
+
+// switchReads makes the destination serve reads.
+func (db *onlineMigrationDB) switchReads() {
+	db.lock.Lock()
+	defer db.lock.Unlock()
+
+	db.switched = true
+}
+
+// detach stops using the destination. It waits for ongoing operations on the
+// destination so that it can be closed safely after detach returns.
+func (db *onlineMigrationDB) detach() {
+	db.lock.Lock()
+	defer db.lock.Unlock()
+
+	db.detached = true
+}
+
+func (db *onlineMigrationDB) status() OnlineDBMigrationEntryStatus {
+	db.lock.RLock()
+	defer db.lock.RUnlock()
+
+	return OnlineDBMigrationEntryStatus{Entry: db.name, Copied: db.copied, CopiedSize: db.copiedSize, Done: db.done}
+}
+
+type onlineMigrationBatch struct {
+	db  *onlineMigrationDB
+	src Batch
+	dst Batch
+}
+
+func (b *onlineMigrationBatch) Put(key []byte, value []byte) error {
+	if err := b.src.Put(key, value); err != nil {
+		return err
+	}
+	return b.dst.Put(key, value)
+}
+
+func (b *onlineMigrationBatch) Delete(key []byte) error {
+	if err := b.src.Delete(key); err != nil {
+		return err
+	}
+	return b.dst.Delete(key)
+}
+
+func (b *onlineMigrationBatch) ValueSize() int {
+	return b.src.ValueSize()
+}
+
+func (b *onlineMigrationBatch) Write() error {
+	b.db.lock.RLock()
+	defer b.db.lock.RUnlock()
+
+	if err := b.src.Write(); err != nil {
+		return err
+	}
+	if !b.db.detached {
+		if err := b.dst.Write(); err != nil {
+			b.db.onDstErr(err)
+		}
+	}
+	return nil
+}
+
+func (b *onlineMigrationBatch) Reset() {
+	b.src.Reset()
+	b.dst.Reset()
+}
+
+func (b *onlineMigrationBatch) Release() {
+	b.src.Release()
+	b.dst.Release()
+}
+
+func (b *onlineMigrationBatch) Replay(w KeyValueWriter) error {
+	return b.src.Replay(w)
+}
+
+// onlineDBMigration manages an online migration of a databaseManager.
+type onlineDBMigration struct {
+	dstdbm  DBManager
+	dbs     []*onlineMigrationDB
+	origDBs []Database
+
+	startTime time.Time
+	quitCh    chan struct{}
+	failCh    chan struct{}
+	doneCh    chan struct{}
+	quitOnce  sync.Once
+	failOnce  sync.Once
+
+	mu       sync.Mutex
+	running  bool
+	switched bool
+	endTime  time.Time
+	err      error
+}
+
+func (m *onlineDBMigration) fail(err error) {
+	m.failOnce.Do(func() {
+		logger.Error("Online DB migration failed", "err", err)
+		m.mu.Lock()
+		m.err = err
+		m.mu.Unlock()
+		close(m.failCh)
+	})
+}
+
+func (m *onlineDBMigration) isRunning() bool {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	return m.running
+}
+
+func (m *onlineDBMigration) status() *OnlineDBMigrationStatus {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	end := m.endTime
+	if m.running {
+		end = time.Now()
+	}
+	status := &OnlineDBMigrationStatus{
+		Running:   m.running,
+		Switched:  m.switched,
+		DstDBType: m.dstdbm.GetDBConfig().DBType,
+		DstDir:    m.dstdbm.GetDBConfig().Dir,
+		StartTime: m.startTime,
+		Elapsed:   end.Sub(m.startTime).String(),
+		Entries:   make([]OnlineDBMigrationEntryStatus, 0, len(m.dbs)),
+	}
+	if m.err != nil {
+		status.Err = m.err.Error()
+	}
+	for _, db := range m.dbs {
+		status.Entries = append(status.Entries, db.status())
+	}
+	return status
+}
+
+// run copies the history of every source database, switches reads to the
+// destination and keeps writing to both until the migration is stopped or
+// a write to the destination fails.
+func (m *onlineDBMigration) run(dbm *databaseManager) {
+	defer close(m.doneCh)
+
+	errCh := make(chan error, len(m.dbs))
+	for _, db := range m.dbs {
+		go func(db *onlineMigrationDB) {
+			errCh <- db.copyHistory(m.quitCh, m.failCh)
+		}(db)
+	}
+
+	var copyErr error
+	for range m.dbs {
+		if err := <-errCh; err != nil && copyErr == nil {
+			copyErr = err
+		}
+	}
+
+	if copyErr == nil {
+		select {
+		case <-m.quitCh:
+			copyErr = errOnlineMigrationStopped
+		case <-m.failCh:
+			copyErr = errOnlineMigrationStopped
+		default:
+		}
+	}
+
+	if copyErr == nil {
+		// Reset DB dirs copied from the src, since the dst uses default dirs.
+		for et := MiscDB; et < databaseEntryTypeSize; et++ {
+			m.dstdbm.setDBDir(et, "")
+		}
+		for _, db := range m.dbs {
+			db.switchReads()
+		}
+		m.mu.Lock()
+		m.switched = true
+		m.mu.Unlock()
+		logger.Info("Online DB migration has switched reads to the new database. Restart the node with the new database to finish the migration",
+			"dbType", m.dstdbm.GetDBConfig().DBType, "dir", m.dstdbm.GetDBConfig().Dir, "elapsed", time.Since(m.startTime))
+
+		select {
+		case <-m.quitCh:
+		case <-m.failCh:
+		}
+	} else if copyErr != errOnlineMigrationStopped {
+		m.fail(copyErr)
+	}
+
+	// Restore the src databases and release the dst.
+	dbm.lockInMigration.Lock()
+	dbm.lockDBs.Lock()
+	copy(dbm.dbs, m.origDBs)
+	dbm.lockDBs.Unlock()
+	dbm.lockInMigration.Unlock()
+
+	for _, db := range m.dbs {
+		db.detach()
+	}
+	m.dstdbm.Close()
+
+	m.mu.Lock()
+	switched := m.switched
+	m.running = false
+	m.switched = false
+	m.endTime = time.Now()
+	m.mu.Unlock()
+	logger.Info("Online DB migration is stopped", "switched", switched, "elapsed", time.Since(m.startTime))
+}
+
+// StartOnlineDBMigration migrates the databases to dstdbm while the node is running.
+// Writes go to both databases and the existing items are copied in the background.
+// Once all items are copied, reads are served by dstdbm. The node should be
+// restarted with the new database to finish the migration. Stopping the migration
+// or closing the DBManager before that returns to the original databases.
+//
+// The databases are replaced after ongoing batch writes finish, and batches
+// created before that are written to dstdbm as well. A Database obtained from
+// the DBManager before the migration starts should not be kept for writes.
+func (dbm *databaseManager) StartOnlineDBMigration(dstdbm DBManager) error {
+	dbm.lockInMigration.Lock()
+	defer dbm.lockInMigration.Unlock()
+	dbm.lockDBs.Lock()
+	defer dbm.lockDBs.Unlock()
+
+	if dbm.inMigration {
+		return errOnlineMigrationStateTrie
+	}
+	if dbm.onlineMigration != nil && dbm.onlineMigration.isRunning() {
+		return errOnlineMigrationInProgress
+	}
+
+	srcSingle := dbm.config.SingleDB || dbm.config.DBType == MemoryDB
+	dstSingle := dstdbm.IsSingle() || dstdbm.GetDBConfig().DBType == MemoryDB
+	if srcSingle && !dstSingle {
+		return errOnlineMigrationSingleDB
+	}
+
+	m := &onlineDBMigration{
+		dstdbm:    dstdbm,
+		origDBs:   make([]Database, len(dbm.dbs)),
+		startTime: time.Now(),
+		quitCh:    make(chan struct{}),
+		failCh:    make(chan struct{}),
+		doneCh:    make(chan struct{}),
+		running:   true,
+	}
+	copy(m.origDBs, dbm.dbs)
+
+	if srcSingle {
+		db := newOnlineMigrationDB("single", dbm.dbs[0], dstdbm.getDatabase(MiscDB), m.fail)
+		m.dbs = append(m.dbs, db)
+		for i := range dbm.dbs {
+			dbm.dbs[i] = db
+		}
+	} else {
+		for et := MiscDB; et < databaseEntryTypeSize; et++ {
+			if dbm.dbs[et] == nil {
+				continue
+			}
+			dstDB := dstdbm.getDatabase(et)
+			if dstDB == nil {
+				copy(dbm.dbs, m.origDBs)
+				return errors.Errorf("dst db is not set for %s", dbBaseDirs[et])
+			}
+			db := newOnlineMigrationDB(dbBaseDirs[et], dbm.dbs[et], dstDB, m.fail)
+			m.dbs = append(m.dbs, db)
+			dbm.dbs[et] = db
+		}
+	}
+	dbm.onlineMigration = m
+
+	logger.Info("Start online DB migration", "dbType", dstdbm.GetDBConfig().DBType, "dir", dstdbm.GetDBConfig().Dir)
+	go m.run(dbm)
+	return nil
+}
+
+// StopOnlineDBMigration stops the online migration and returns to the original databases.
+func (dbm *databaseManager) StopOnlineDBMigration() error {
+	dbm.lockInMigration.RLock()
+	m := dbm.onlineMigration
+	dbm.lockInMigration.RUnlock()
+
+	if m == nil || !m.isRunning() {
+		return errOnlineMigrationNotRunning
+	}
+	m.quitOnce.Do(func() { close(m.quitCh) })
+	<-m.doneCh
+	return nil
+}
+
+// entryBatch is a batch returned by DBManager.NewBatch. If the database of its
+// entry is replaced by an online migration after the batch is created, the
+// batch is replayed into the current database when it is written, so that
+// the items are written to the destination as well.
+type entryBatch struct {
+	Batch
+	dbm *databaseManager
+	et  DBEntryType
+	db  Database // the database the batch is created from
+}
+
+func (dbm *databaseManager) newEntryBatch(dbEntryType DBEntryType) Batch {
+	db := dbm.getDatabase(dbEntryType)
+	return &entryBatch{Batch: db.NewBatch(), dbm: dbm, et: dbEntryType, db: db}
+}
+
+// Write writes the batch to the current database of the entry. lockDBs is held
+// while writing, so an online migration replaces the databases only after
+// ongoing batch writes finish.
+func (b *entryBatch) Write() error {
+	b.dbm.lockDBs.RLock()
+	defer b.dbm.lockDBs.RUnlock()
+
+	if db := b.dbm.getDatabaseLocked(b.et); db != b.db {
+		batch := db.NewBatch()
+		if err := b.Batch.Replay(batch); err != nil {
+			batch.Release()
+			return err
+		}
+		b.Batch.Release()
+		b.Batch, b.db = batch, db
+	}
+	return b.Batch.Write()
+}
+
+// OnlineDBMigrationStatus returns the status of the last online migration, or
+// nil if no online migration has been started.
+func (dbm *databaseManager) OnlineDBMigrationStatus() *OnlineDBMigrationStatus {
+	dbm.lockInMigration.RLock()
+	m := dbm.onlineMigration
+	dbm.lockInMigration.RUnlock()
+
+	if m == nil {
+		return nil
+	}
+	return m.status()
+}
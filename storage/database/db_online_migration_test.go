@@ -0,0 +1,281 @@
+// Copyright 2024 The klaytn Authors
+// This file is part of the klaytn library.
+//
+// The klaytn library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The klaytn library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the klaytn library. If not, see <http://www.gnu.org/licenses/>.
+
+package database
+
+import (
+	"bytes"
+	"fmt"
+	"math/big"
+	"os"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/klaytn/klaytn/blockchain/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func waitOnlineMigrationSwitched(t *testing.T, dbm DBManager) {
+	for i := 0; i < 500; i++ {
+		status := dbm.OnlineDBMigrationStatus()
+		require.NotNil(t, status)
+		require.Empty(t, status.Err)
+		if status.Switched {
+			return
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	t.Fatal("online db migration has not switched")
+}
+
+// readAllItems returns all items of db except DB dirs, which are reset by migration.
+func readAllItems(db Database) map[string]string {
+	items := make(map[string]string)
+	it := db.NewIterator(nil, nil)
+	defer it.Release()
+	for it.Next() {
+		if bytes.HasPrefix(it.Key(), databaseDirPrefix) {
+			continue
+		}
+		items[string(it.Key())] = string(it.Value())
+	}
+	return items
+}
+
+func TestOnlineDBMigration(t *testing.T) {
+	srcDir, err := os.MkdirTemp("", "klay_online_migration_src_")
+	require.NoError(t, err)
+	defer os.RemoveAll(srcDir)
+	dstDir, err := os.MkdirTemp("", "klay_online_migration_dst_")
+	require.NoError(t, err)
+	defer os.RemoveAll(dstDir)
+
+	src := NewDBManager(&DBConfig{Dir: srcDir, DBType: LevelDB, LevelDBCacheSize: 16, OpenFilesLimit: 16})
+	defer src.Close()
+	headers := writeTestChain(src, 1000)
+
+	dst := NewDBManager(&DBConfig{Dir: dstDir, DBType: PebbleDB, PebbleDBConfig: GetDefaultPebbleDBConfig()})
+	require.NoError(t, src.StartOnlineDBMigration(dst))
+	assert.Equal(t, errOnlineMigrationInProgress, src.StartOnlineDBMigration(dst))
+	assert.Equal(t, errOnlineMigrationInProgress, src.CreateMigrationDBAndSetStatus(1))
+
+	// Keep writing while the history is copied.
+	for i := 1000; i < 1100; i++ {
+		header := &types.Header{Number: big.NewInt(int64(i)), ParentHash: headers[i-1].Hash()}
+		headers = append(headers, header)
+		src.WriteHeader(header)
+		src.WriteCanonicalHash(header.Hash(), uint64(i))
+	}
+	src.DeleteCanonicalHash(5)
+	src.WriteHeadHeaderHash(headers[1099].Hash())
+
+	waitOnlineMigrationSwitched(t, src)
+
+	// Writes after switching reads still go to both databases.
+	src.DeleteCanonicalHash(6)
+	assert.Equal(t, headers[1099].Hash(), src.ReadHeadHeaderHash())
+	assert.Equal(t, headers[7].Hash(), src.ReadCanonicalHash(7))
+
+	m := src.(*databaseManager).onlineMigration
+	for et := MiscDB; et < databaseEntryTypeSize; et++ {
+		if m.origDBs[et] == nil {
+			continue
+		}
+		assert.Equal(t, readAllItems(m.origDBs[et]), readAllItems(dst.getDatabase(et)), dbBaseDirs[et])
+		assert.Equal(t, DBType(PebbleDB), src.getDatabase(et).Type())
+	}
+
+	status := src.OnlineDBMigrationStatus()
+	assert.True(t, status.Running)
+	assert.Equal(t, DBType(PebbleDB), status.DstDBType)
+	for _, entry := range status.Entries {
+		assert.True(t, entry.Done, entry.Entry)
+	}
+
+	// Stopping returns to the source databases.
+	require.NoError(t, src.StopOnlineDBMigration())
+	assert.Equal(t, errOnlineMigrationNotRunning, src.StopOnlineDBMigration())
+	assert.False(t, src.OnlineDBMigrationStatus().Running)
+	for et := MiscDB; et < databaseEntryTypeSize; et++ {
+		assert.Equal(t, m.origDBs[et], src.getDatabase(et))
+	}
+	assert.Equal(t, headers[1099].Hash(), src.ReadHeadHeaderHash())
+}
+
+func TestOnlineDBMigration_Reopen(t *testing.T) {
+	srcDir, err := os.MkdirTemp("", "klay_online_migration_src_")
+	require.NoError(t, err)
+	defer os.RemoveAll(srcDir)
+	dstDir, err := os.MkdirTemp("", "klay_online_migration_dst_")
+	require.NoError(t, err)
+	defer os.RemoveAll(dstDir)
+
+	src := NewDBManager(&DBConfig{Dir: srcDir, DBType: LevelDB, LevelDBCacheSize: 16, OpenFilesLimit: 16})
+	src.setDBDir(StateTrieDB, "statetrie_migrated_1")
+	src.Close()
+
+	src = NewDBManager(&DBConfig{Dir: srcDir, DBType: LevelDB, LevelDBCacheSize: 16, OpenFilesLimit: 16})
+	headers := writeTestChain(src, 10)
+	dst := NewDBManager(&DBConfig{Dir: dstDir, DBType: LevelDB, LevelDBCacheSize: 16, OpenFilesLimit: 16})
+	require.NoError(t, src.StartOnlineDBMigration(dst))
+	waitOnlineMigrationSwitched(t, src)
+
+	header := &types.Header{Number: big.NewInt(10), ParentHash: headers[9].Hash()}
+	src.WriteHeader(header)
+	src.WriteCanonicalHash(header.Hash(), 10)
+	src.Close()
+
+	// The new database has every item and uses its own DB dirs.
+	dst = NewDBManager(&DBConfig{Dir: dstDir, DBType: LevelDB, LevelDBCacheSize: 16, OpenFilesLimit: 16})
+	defer dst.Close()
+	assert.Equal(t, dbBaseDirs[StateTrieDB], dst.getDBDir(StateTrieDB))
+	assert.Equal(t, headers[9].Hash(), dst.ReadHeadBlockHash())
+	assert.Equal(t, header.Hash(), dst.ReadCanonicalHash(10))
+	assert.NotNil(t, dst.ReadHeader(header.Hash(), 10))
+}
+
+func TestOnlineDBMigration_SingleDB(t *testing.T) {
+	src := NewMemoryDBManager()
+	defer src.Close()
+	headers := writeTestChain(src, 10)
+
+	dstDir, err := os.MkdirTemp("", "klay_online_migration_dst_")
+	require.NoError(t, err)
+	defer os.RemoveAll(dstDir)
+
+	dst := NewDBManager(&DBConfig{Dir: dstDir, DBType: LevelDB, LevelDBCacheSize: 16, OpenFilesLimit: 16})
+	assert.Equal(t, errOnlineMigrationSingleDB, src.StartOnlineDBMigration(dst))
+	dst.Close()
+
+	dst = NewMemoryDBManager()
+	require.NoError(t, src.StartOnlineDBMigration(dst))
+	waitOnlineMigrationSwitched(t, src)
+	assert.Equal(t, readAllItems(src.(*databaseManager).onlineMigration.origDBs[0]), readAllItems(dst.getDatabase(MiscDB)))
+	assert.Equal(t, headers[9].Hash(), dst.ReadHeadBlockHash())
+	require.NoError(t, src.StopOnlineDBMigration())
+}
+
+// Batches created before the migration starts are written to the destination
+// if they are written after that.
+func TestOnlineDBMigration_PendingBatch(t *testing.T) {
+	src := NewMemoryDBManager()
+	defer src.Close()
+	writeTestChain(src, 10)
+
+	batch := src.NewBatch(MiscDB)
+	defer batch.Release()
+	require.NoError(t, batch.Put([]byte("pending"), []byte("batch")))
+
+	dst := NewMemoryDBManager()
+	require.NoError(t, src.StartOnlineDBMigration(dst))
+	require.NoError(t, batch.Write())
+	waitOnlineMigrationSwitched(t, src)
+
+	val, err := dst.getDatabase(MiscDB).Get([]byte("pending"))
+	require.NoError(t, err)
+	assert.Equal(t, []byte("batch"), val)
+	assert.Equal(t, readAllItems(src.(*databaseManager).onlineMigration.origDBs[0]), readAllItems(dst.getDatabase(MiscDB)))
+	require.NoError(t, src.StopOnlineDBMigration())
+}
+
+// Reads and writes keep going while the migration is started, copies the
+// history and is stopped. Run with -race to check the databases are replaced safely.
+func TestOnlineDBMigration_ConcurrentWrites(t *testing.T) {
+	srcDir, err := os.MkdirTemp("", "klay_online_migration_src_")
+	require.NoError(t, err)
+	defer os.RemoveAll(srcDir)
+
+	src := NewDBManager(&DBConfig{Dir: srcDir, DBType: LevelDB, LevelDBCacheSize: 16, OpenFilesLimit: 16})
+	defer src.Close()
+	headers := writeTestChain(src, 1000)
+
+	// run writes items with batches, some of which are created long before
+	// written, and reads items until quit is closed.
+	run := func(round int, quit chan struct{}) *sync.WaitGroup {
+		var wg sync.WaitGroup
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			var pending Batch
+			for i := 0; ; i++ {
+				select {
+				case <-quit:
+					if pending != nil {
+						assert.NoError(t, pending.Write())
+						pending.Release()
+					}
+					return
+				default:
+				}
+				key := []byte(fmt.Sprintf("round-%d-%d", round, i))
+				if i%10 == 0 {
+					if pending != nil {
+						assert.NoError(t, pending.Write())
+						pending.Release()
+					}
+					pending = src.NewBatch(MiscDB)
+					assert.NoError(t, pending.Put(key, key))
+					continue
+				}
+				batch := src.NewBatch(MiscDB)
+				assert.NoError(t, batch.Put(key, key))
+				assert.NoError(t, batch.Write())
+				batch.Release()
+			}
+		}()
+		go func() {
+			defer wg.Done()
+			for i := 0; ; i++ {
+				select {
+				case <-quit:
+					return
+				default:
+				}
+				n := uint64(i % len(headers))
+				assert.Equal(t, headers[n].Hash(), src.ReadCanonicalHash(n))
+			}
+		}()
+		return &wg
+	}
+
+	for round := 0; round < 2; round++ {
+		dstDir, err := os.MkdirTemp("", "klay_online_migration_dst_")
+		require.NoError(t, err)
+		defer os.RemoveAll(dstDir)
+		dst := NewDBManager(&DBConfig{Dir: dstDir, DBType: LevelDB, LevelDBCacheSize: 16, OpenFilesLimit: 16})
+
+		// Items written while the history is copied are in both databases.
+		quit := make(chan struct{})
+		wg := run(round, quit)
+		time.Sleep(10 * time.Millisecond)
+		require.NoError(t, src.StartOnlineDBMigration(dst))
+		waitOnlineMigrationSwitched(t, src)
+		close(quit)
+		wg.Wait()
+
+		m := src.(*databaseManager).onlineMigration
+		assert.Equal(t, readAllItems(m.origDBs[MiscDB]), readAllItems(dst.getDatabase(MiscDB)))
+
+		quit = make(chan struct{})
+		wg = run(round+2, quit)
+		time.Sleep(10 * time.Millisecond)
+		require.NoError(t, src.StopOnlineDBMigration())
+		close(quit)
+		wg.Wait()
+	}
+}
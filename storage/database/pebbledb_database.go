@@ -0,0 +1,445 @@
+// Copyright 2024 The klaytn Authors
+// This file is part of the klaytn library.
+//
+// The klaytn library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The klaytn library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the klaytn library. If not, see <http://www.gnu.org/licenses/>.
+
+package database
+
+import (
+	"fmt"
+	"runtime"
+	"sync"
+	"time"
+
+	"github.com/cockroachdb/pebble"
+	"github.com/cockroachdb/pebble/bloom"
+	"github.com/klaytn/klaytn/log"
+	klaytnmetrics "github.com/klaytn/klaytn/metrics"
+	metricutils "github.com/klaytn/klaytn/metrics/utils"
+	"github.com/rcrowley/go-metrics"
+)
+
+type pebbleDB struct {
+	fn     string // filename for reporting
+	config *PebbleDBConfig
+	db     *pebble.DB
+
+	quitCh    chan struct{}
+	quitOnce  sync.Once
+	perfCheck bool // If true, get, put and batch write are timed
+
+	getTimer        klaytnmetrics.HybridTimer
+	putTimer        klaytnmetrics.HybridTimer
+	batchWriteTimer klaytnmetrics.HybridTimer
+
+	compCountMeter  metrics.Meter
+	compReadMeter   metrics.Meter
+	compWriteMeter  metrics.Meter
+	compDebtGauge   metrics.Gauge
+	compActiveGauge metrics.Gauge
+	flushCountMeter metrics.Meter
+	diskWriteMeter  metrics.Meter
+	diskSizeGauge   metrics.Gauge
+	memTableGauge   metrics.Gauge
+	blockCacheGauge metrics.Gauge
+
+	levelSizesGauge  []metrics.Gauge
+	levelTablesGauge []metrics.Gauge
+
+	logger log.Logger // Contextual logger tracking the database path
+}
+
+func getPebbleDBOptions(config *PebbleDBConfig, readOnly bool) *pebble.Options {
+	// Half of the cache goes to the block cache and the rest is shared by the memtables.
+	memTableSize := config.CacheSize * 1024 * 1024 / 2 / pebbleMemTableLimit
+	if memTableSize > maxPebbleMemTableSize {
+		memTableSize = maxPebbleMemTableSize
+	}
+
+	opts := &pebble.Options{
+		Cache:                       pebble.NewCache(int64(config.CacheSize * 1024 * 1024 / 2)),
+		MaxOpenFiles:                config.MaxOpenFiles,
+		MemTableSize:                int(memTableSize),
+		MemTableStopWritesThreshold: pebbleMemTableLimit,
+		MaxConcurrentCompactions:    func() int { return runtime.NumCPU() },
+		ReadOnly:                    readOnly,
+		Logger:                      pebbleLogger{logger},
+	}
+	// The target file size doubles at each level as leveldb does.
+	for i := range opts.Levels {
+		opts.Levels[i].BlockSize = 32 * 1024
+		opts.Levels[i].IndexBlockSize = 256 * 1024
+		opts.Levels[i].FilterPolicy = bloom.FilterPolicy(10)
+		opts.Levels[i].FilterType = pebble.TableFilter
+		opts.Levels[i].TargetFileSize = int64(2*1024*1024) << i
+	}
+	opts.Experimental.ReadSamplingMultiplier = -1 // disable the read triggered compactions
+	return opts
+}
+
+// NewPebbleDB opens the pebble database at the given path, creating it if
+// it doesn't exist unless readOnly is set.
+func NewPebbleDB(path string, config *PebbleDBConfig, readOnly bool) (*pebbleDB, error) {
+	localLogger := logger.NewWith("path", path)
+
+	if config == nil {
+		config = GetDefaultPebbleDBConfig()
+	}
+	// Ensure we have some minimal caching and file guarantees
+	if config.CacheSize < minCacheSizeForPebbleDB {
+		localLogger.Warn("Cache size too small, increasing to minimum recommended", "oldCacheSize", config.CacheSize, "newCacheSize", minCacheSizeForPebbleDB)
+		config.CacheSize = minCacheSizeForPebbleDB
+	}
+	if config.MaxOpenFiles < minOpenFilesForPebbleDB {
+		localLogger.Warn("Max open files too small, increasing to minimum recommended", "oldMaxOpenFiles", config.MaxOpenFiles, "newMaxOpenFiles", minOpenFilesForPebbleDB)
+		config.MaxOpenFiles = minOpenFilesForPebbleDB
+	}
+
+	opts := getPebbleDBOptions(config, readOnly)
+	defer opts.Cache.Unref()
+
+	localLogger.Info("PebbleDB configurations", "cacheSize(MB)", config.CacheSize, "memTableSize(MB)", opts.MemTableSize/1024/1024,
+		"maxOpenFiles", config.MaxOpenFiles, "disableMetrics", config.DisableMetrics, "readOnly", readOnly)
+
+	db, err := pebble.Open(path, opts)
+	if err != nil {
+		return nil, err
+	}
+	return &pebbleDB{
+		fn:     path,
+		config: config,
+		db:     db,
+		quitCh: make(chan struct{}),
+		logger: localLogger,
+	}, nil
+}
+
+func (db *pebbleDB) Type() DBType {
+	return PebbleDB
+}
+
+// Path returns the path to the database directory.
+func (db *pebbleDB) Path() string {
+	return db.fn
+}
+
+// Put inserts the given key and value pair to the database.
+func (db *pebbleDB) Put(key []byte, value []byte) error {
+	if db.perfCheck {
+		start := time.Now()
+		err := db.put(key, value)
+		db.putTimer.Update(time.Since(start))
+		return err
+	}
+	return db.put(key, value)
+}
+
+func (db *pebbleDB) put(key []byte, value []byte) error {
+	return db.db.Set(key, value, pebble.NoSync)
+}
+
+// Has returns true if the corresponding value to the given key exists.
+func (db *pebbleDB) Has(key []byte) (bool, error) {
+	_, closer, err := db.db.Get(key)
+	if err == pebble.ErrNotFound {
+		return false, nil
+	} else if err != nil {
+		return false, err
+	}
+	closer.Close()
+	return true, nil
+}
+
+// Get returns the corresponding value to the given key if exists.
+func (db *pebbleDB) Get(key []byte) ([]byte, error) {
+	if db.perfCheck {
+		start := time.Now()
+		val, err := db.get(key)
+		db.getTimer.Update(time.Since(start))
+		return val, err
+	}
+	return db.get(key)
+}
+
+func (db *pebbleDB) get(key []byte) ([]byte, error) {
+	dat, closer, err := db.db.Get(key)
+	if err == pebble.ErrNotFound {
+		return nil, dataNotFoundErr
+	} else if err != nil {
+		return nil, err
+	}
+	defer closer.Close()
+
+	// The returned slice is only valid until the closer is closed.
+	return append([]byte{}, dat...), nil
+}
+
+// Delete deletes the key from the database.
+func (db *pebbleDB) Delete(key []byte) error {
+	return db.db.Delete(key, pebble.NoSync)
+}
+
+// NewIterator creates a binary-alphabetical iterator over a subset
+// of database content with a particular key prefix, starting at a particular
+// initial key (or after, if it does not exist).
+func (db *pebbleDB) NewIterator(prefix []byte, start []byte) Iterator {
+	r := bytesPrefixRange(prefix, start)
+	iter := db.db.NewIter(&pebble.IterOptions{
+		LowerBound: r.Start,
+		UpperBound: r.Limit,
+	})
+	iter.First()
+	return &pebbleIterator{iter: iter, first: true}
+}
+
+func (db *pebbleDB) Close() {
+	db.quitOnce.Do(func() { close(db.quitCh) })
+	if err := db.db.Close(); err == nil {
+		db.logger.Info("Database closed")
+	} else {
+		db.logger.Error("Failed to close database", "err", err)
+	}
+}
+
+// Meter configures the database metrics collectors and starts a goroutine
+// periodically reporting the internal pebble counters to them.
+func (db *pebbleDB) Meter(prefix string) {
+	db.getTimer = klaytnmetrics.NewRegisteredHybridTimer(prefix+"get/time", nil)
+	db.putTimer = klaytnmetrics.NewRegisteredHybridTimer(prefix+"put/time", nil)
+	db.batchWriteTimer = klaytnmetrics.NewRegisteredHybridTimer(prefix+"batchwrite/time", nil)
+	db.perfCheck = !db.config.DisableMetrics
+
+	db.compCountMeter = metrics.NewRegisteredMeter(prefix+"compaction/count", nil)
+	db.compReadMeter = metrics.NewRegisteredMeter(prefix+"compaction/read", nil)
+	db.compWriteMeter = metrics.NewRegisteredMeter(prefix+"compaction/write", nil)
+	db.compDebtGauge = metrics.NewRegisteredGauge(prefix+"compaction/debt", nil)
+	db.compActiveGauge = metrics.NewRegisteredGauge(prefix+"compaction/active", nil)
+	db.flushCountMeter = metrics.NewRegisteredMeter(prefix+"flush/count", nil)
+	db.diskWriteMeter = metrics.NewRegisteredMeter(prefix+"disk/write", nil)
+	db.diskSizeGauge = metrics.NewRegisteredGauge(prefix+"disk/size", nil)
+	db.memTableGauge = metrics.NewRegisteredGauge(prefix+"memtable/size", nil)
+	db.blockCacheGauge = metrics.NewRegisteredGauge(prefix+"blockcache", nil)
+
+	for lv := 0; lv < len(db.db.Metrics().Levels); lv++ {
+		levelPrefix := prefix + fmt.Sprintf("level%v/", lv)
+		db.levelSizesGauge = append(db.levelSizesGauge, metrics.NewRegisteredGauge(levelPrefix+"size", nil))
+		db.levelTablesGauge = append(db.levelTablesGauge, metrics.NewRegisteredGauge(levelPrefix+"tables", nil))
+	}
+
+	// Short circuit metering if the metrics system is disabled
+	// Above meters are initialized by NilMeter if metricutils.Enabled == false
+	if !metricutils.Enabled || db.config.DisableMetrics {
+		return
+	}
+
+	go db.meter(3 * time.Second)
+}
+
+// meter periodically retrieves internal pebble counters and reports them to
+// the metrics subsystem.
+func (db *pebbleDB) meter(refresh time.Duration) {
+	ticker := time.NewTicker(refresh)
+	defer ticker.Stop()
+
+	var prevCompCount, prevFlushCount int64
+	var prevCompRead, prevCompWrite, prevWALWrite uint64
+
+	for {
+		select {
+		case <-db.quitCh:
+			return
+		case <-ticker.C:
+		}
+
+		m := db.db.Metrics()
+
+		var compRead, compWrite uint64
+		for lv, level := range m.Levels {
+			compRead += level.BytesRead
+			compWrite += level.BytesCompacted
+
+			db.levelSizesGauge[lv].Update(level.Size)
+			db.levelTablesGauge[lv].Update(level.NumFiles)
+		}
+		db.compCountMeter.Mark(m.Compact.Count - prevCompCount)
+		db.compReadMeter.Mark(int64(compRead - prevCompRead))
+		db.compWriteMeter.Mark(int64(compWrite - prevCompWrite))
+		db.compDebtGauge.Update(int64(m.Compact.EstimatedDebt))
+		db.compActiveGauge.Update(m.Compact.NumInProgress)
+		db.flushCountMeter.Mark(m.Flush.Count - prevFlushCount)
+		db.diskWriteMeter.Mark(int64(m.WAL.BytesWritten - prevWALWrite))
+		db.diskSizeGauge.Update(int64(m.DiskSpaceUsage()))
+		db.memTableGauge.Update(int64(m.MemTable.Size))
+		db.blockCacheGauge.Update(m.BlockCache.Size)
+
+		prevCompCount, prevFlushCount = m.Compact.Count, m.Flush.Count
+		prevCompRead, prevCompWrite, prevWALWrite = compRead, compWrite, m.WAL.BytesWritten
+	}
+}
+
+// GetProperty returns the metrics report of pebble as it has no named properties.
+func (db *pebbleDB) GetProperty(name string) string {
+	return db.db.Metrics().String()
+}
+
+func (db *pebbleDB) TryCatchUpWithPrimary() error {
+	return nil
+}
+
+func (db *pebbleDB) NewBatch() Batch {
+	return &pebbleBatch{b: db.db.NewBatch(), db: db}
+}
+
+// pebbleIterator is a wrapper of the pebble iterator implementing Iterator.
+type pebbleIterator struct {
+	iter     *pebble.Iterator
+	first    bool
+	released bool
+}
+
+// Next moves the iterator to the next key/value pair. It returns whether the
+// iterator is exhausted.
+func (it *pebbleIterator) Next() bool {
+	if it.first {
+		it.first = false
+		return it.iter.Valid()
+	}
+	return it.iter.Next()
+}
+
+// Error returns any accumulated error. Exhausting all the key/value pairs
+// is not considered to be an error.
+func (it *pebbleIterator) Error() error {
+	return it.iter.Error()
+}
+
+// Key returns the key of the current key/value pair, or nil if done. The caller
+// should not modify the contents of the returned slice, and its contents may
+// change on the next call to Next.
+func (it *pebbleIterator) Key() []byte {
+	if it.first || !it.iter.Valid() {
+		return nil
+	}
+	return it.iter.Key()
+}
+
+// Value returns the value of the current key/value pair, or nil if done. The
+// caller should not modify the contents of the returned slice, and its contents
+// may change on the next call to Next.
+func (it *pebbleIterator) Value() []byte {
+	if it.first || !it.iter.Valid() {
+		return nil
+	}
+	return it.iter.Value()
+}
+
+// Release releases associated resources. Release should always succeed and can
+// be called multiple times without causing error.
+func (it *pebbleIterator) Release() {
+	if !it.released {
+		it.iter.Close()
+		it.released = true
+	}
+}
+
+// pebbleBatch is a write-only pebble batch that commits changes to its host database
+// when Write is called. A batch cannot be used concurrently.
+type pebbleBatch struct {
+	b    *pebble.Batch
+	db   *pebbleDB
+	size int
+}
+
+// Put inserts the given value into the batch for later committing.
+func (b *pebbleBatch) Put(key, value []byte) error {
+	if err := b.b.Set(key, value, nil); err != nil {
+		return err
+	}
+	b.size += len(value)
+	return nil
+}
+
+// Delete inserts a key removal into the batch for later committing.
+func (b *pebbleBatch) Delete(key []byte) error {
+	if err := b.b.Delete(key, nil); err != nil {
+		return err
+	}
+	b.size++
+	return nil
+}
+
+// Write flushes any accumulated data to disk.
+func (b *pebbleBatch) Write() error {
+	if b.db.perfCheck {
+		start := time.Now()
+		err := b.write()
+		b.db.batchWriteTimer.Update(time.Since(start))
+		return err
+	}
+	return b.write()
+}
+
+func (b *pebbleBatch) write() error {
+	return b.b.Commit(pebble.NoSync)
+}
+
+// ValueSize retrieves the amount of data queued up for writing.
+func (b *pebbleBatch) ValueSize() int {
+	return b.size
+}
+
+// Reset resets the batch for reuse.
+func (b *pebbleBatch) Reset() {
+	b.b.Reset()
+	b.size = 0
+}
+
+// Release returns the batch to the pool of pebble.
+func (b *pebbleBatch) Release() {
+	b.b.Close()
+}
+
+// Replay replays the batch contents.
+func (b *pebbleBatch) Replay(w KeyValueWriter) error {
+	reader := b.b.Reader()
+	for {
+		kind, key, value, ok := reader.Next()
+		if !ok {
+			return nil
+		}
+		switch kind {
+		case pebble.InternalKeyKindSet:
+			if err := w.Put(key, value); err != nil {
+				return err
+			}
+		case pebble.InternalKeyKindDelete:
+			if err := w.Delete(key); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// pebbleLogger routes the logs of pebble to the logger of the database module.
+type pebbleLogger struct {
+	logger log.Logger
+}
+
+func (l pebbleLogger) Infof(format string, args ...interface{}) {
+	l.logger.Debug(fmt.Sprintf(format, args...))
+}
+
+func (l pebbleLogger) Fatalf(format string, args ...interface{}) {
+	l.logger.Crit(fmt.Sprintf(format, args...))
+}
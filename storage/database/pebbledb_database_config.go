@@ -0,0 +1,44 @@
+// Copyright 2024 The klaytn Authors
+// This file is part of the klaytn library.
+//
+// The klaytn library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The klaytn library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the klaytn library. If not, see <http://www.gnu.org/licenses/>.
+
+package database
+
+const (
+	defaultPebbleDBCacheSize    = 768 // 768MB
+	minCacheSizeForPebbleDB     = 16
+	defaultOpenFilesForPebbleDB = 1024
+	minOpenFilesForPebbleDB     = 16
+
+	// pebbleMemTableLimit is the number of memtables, a live one and a frozen one
+	// being flushed, which is identical to leveldb.
+	pebbleMemTableLimit = 2
+	// maxPebbleMemTableSize is the upper bound of a memtable size set by pebble.
+	maxPebbleMemTableSize = 4<<30 - 1
+)
+
+type PebbleDBConfig struct {
+	CacheSize      uint64 // MiB, half for the block cache and the rest for the memtables
+	MaxOpenFiles   int
+	DisableMetrics bool
+}
+
+func GetDefaultPebbleDBConfig() *PebbleDBConfig {
+	return &PebbleDBConfig{
+		CacheSize:      defaultPebbleDBCacheSize,
+		MaxOpenFiles:   defaultOpenFilesForPebbleDB,
+		DisableMetrics: false,
+	}
+}
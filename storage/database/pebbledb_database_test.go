@@ -0,0 +1,102 @@
+// Copyright 2024 The klaytn Authors
+// This file is part of the klaytn library.
+//
+// The klaytn library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The klaytn library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the klaytn library. If not, see <http://www.gnu.org/licenses/>.
+
+package database
+
+import (
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPebbleDB_BatchReplay(t *testing.T) {
+	db, remove, _ := newTestPebbleDB()
+	defer remove()
+
+	require.NoError(t, db.Put([]byte("k2"), []byte("old")))
+
+	batch := db.NewBatch()
+	defer batch.Release()
+	assert.NoError(t, batch.Put([]byte("k1"), []byte("v1")))
+	assert.NoError(t, batch.Delete([]byte("k2")))
+	assert.Equal(t, 3, batch.ValueSize())
+
+	memDB := NewMemDB()
+	require.NoError(t, memDB.Put([]byte("k2"), []byte("old")))
+	require.NoError(t, batch.Replay(memDB))
+	v, err := memDB.Get([]byte("k1"))
+	assert.NoError(t, err)
+	assert.Equal(t, []byte("v1"), v)
+	has, _ := memDB.Has([]byte("k2"))
+	assert.False(t, has)
+
+	require.NoError(t, batch.Write())
+	has, _ = db.Has([]byte("k2"))
+	assert.False(t, has)
+
+	batch.Reset()
+	assert.Equal(t, 0, batch.ValueSize())
+}
+
+func TestPebbleDB_ReadOnly(t *testing.T) {
+	dir, err := os.MkdirTemp("", "klay_pebbledb_readonly_test_")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	_, err = NewPebbleDB(dir+"/missing", nil, true)
+	assert.Error(t, err)
+
+	db, err := NewPebbleDB(dir, nil, false)
+	require.NoError(t, err)
+	require.NoError(t, db.Put([]byte("key"), []byte("value")))
+	db.Close()
+
+	db, err = NewPebbleDB(dir, nil, true)
+	require.NoError(t, err)
+	defer db.Close()
+
+	v, err := db.Get([]byte("key"))
+	assert.NoError(t, err)
+	assert.Equal(t, []byte("value"), v)
+	assert.Error(t, db.Put([]byte("key"), []byte("other")))
+}
+
+func TestPebbleDB_Migration(t *testing.T) {
+	srcDir, err := os.MkdirTemp("", "klay_pebbledb_migration_src_")
+	require.NoError(t, err)
+	defer os.RemoveAll(srcDir)
+	dstDir, err := os.MkdirTemp("", "klay_pebbledb_migration_dst_")
+	require.NoError(t, err)
+	defer os.RemoveAll(dstDir)
+
+	src := NewDBManager(&DBConfig{Dir: srcDir, DBType: LevelDB, LevelDBCacheSize: 16, OpenFilesLimit: 16})
+	headers := writeTestChain(src, 3)
+
+	dst := NewDBManager(&DBConfig{Dir: dstDir, DBType: PebbleDB, NumStateTrieShards: 2, PebbleDBConfig: GetDefaultPebbleDBConfig()})
+	require.NoError(t, src.StartDBMigration(dst))
+	src.Close()
+	dst.Close()
+
+	dst = NewDBManager(&DBConfig{Dir: dstDir, DBType: PebbleDB, NumStateTrieShards: 2, PebbleDBConfig: GetDefaultPebbleDBConfig()})
+	defer dst.Close()
+	assert.Equal(t, headers[2].Hash(), dst.ReadHeadBlockHash())
+	for i, header := range headers {
+		assert.Equal(t, header.Hash(), dst.ReadCanonicalHash(uint64(i)))
+		assert.True(t, dst.HasBody(header.Hash(), uint64(i)))
+	}
+}
@@ -108,6 +108,7 @@ var (
 	lastServiceChainTxReceiptKey    = []byte("LastServiceChainTxReceipt")
 	lastIndexedBlockKey             = []byte("LastIndexedBlockKey")
 	receiptFromParentChainKeyPrefix = []byte("receiptFromParentChain")
+	anchoringProofKeyPrefix         = []byte("anchoringProof")
 
 	parentOperatorFeePayerPrefix = []byte("parentOperatorFeePayer")
 	childOperatorFeePayerPrefix  = []byte("childOperatorFeePayer")
@@ -128,6 +129,10 @@ var (
 
 	stakingInfoPrefix = []byte("stakingInfo")
 
+	treasuryRebalancePrefix = []byte("treasuryRebalance")
+
+	contractABIPrefix = []byte("contractABI")
+
 	chaindatafetcherCheckpointKey = []byte("chaindatafetcherCheckpoint")
 )
 
@@ -245,10 +250,19 @@ func receiptFromParentChainKey(blockHash common.Hash) []byte {
 	return append(receiptFromParentChainKeyPrefix, blockHash.Bytes()...)
 }
 
+func anchoringProofKey(blockNum uint64) []byte {
+	return append(anchoringProofKeyPrefix, common.Int64ToByteBigEndian(blockNum)...)
+}
+
 func valueTransferTxHashKey(rTxHash common.Hash) []byte {
 	return append(valueTransferTxHashPrefix, rTxHash.Bytes()...)
 }
 
+// contractABIKey = contractABIPrefix + address
+func contractABIKey(addr common.Address) []byte {
+	return append(contractABIPrefix, addr.Bytes()...)
+}
+
 // bloomBitsKey = bloomBitsPrefix + bit (uint16 big endian) + section (uint64 big endian) + hash
 func BloomBitsKey(bit uint, section uint64, hash common.Hash) []byte {
 	key := append(append(bloomBitsPrefix, make([]byte, 10)...), hash.Bytes()...)
@@ -26,6 +26,7 @@ import (
 	"sync"
 
 	"github.com/klaytn/klaytn/common"
+	"github.com/rcrowley/go-metrics"
 )
 
 var errKeyLengthZero = fmt.Errorf("database key for sharded database should be greater than 0")
@@ -37,6 +38,15 @@ type shardedDB struct {
 	shards    []Database
 	numShards uint
 
+	// mirrors[i] keeps a copy of shards[i] with checksums. It is nil if mirroring is disabled.
+	mirrors     []Database
+	scrubLock   *sync.RWMutex // held exclusively while the scrubber checks items
+	scrubQuitCh chan struct{}
+	scrubWg     *sync.WaitGroup
+
+	mirrorReadMeter  metrics.Meter
+	scrubRepairMeter metrics.Meter
+
 	sdbBatchTaskCh chan sdbBatchTask
 }
 
@@ -53,6 +63,10 @@ type sdbBatchResult struct {
 
 // newShardedDB creates database with numShards shards, or partitions.
 // The type of database is specified DBConfig.DBType.
+// If DBConfig.ShardMirror is set, each shard has a mirror and the resources for
+// a shard are split between them. The mirror of a shard is stored in the
+// directory of the next shard, so that a shard and its mirror are placed on
+// different disks if the shards are.
 func newShardedDB(dbc *DBConfig, et DBEntryType, numShards uint) (*shardedDB, error) {
 	if numShards == 0 {
 		logger.Crit("numShards should be greater than 0!")
@@ -66,15 +80,24 @@ func newShardedDB(dbc *DBConfig, et DBEntryType, numShards uint) (*shardedDB, er
 		logger.Crit(fmt.Sprintf("numShards should be power of two, but it is %v", numShards))
 	}
 
+	numDBs := numShards
+	if dbc.ShardMirror {
+		numDBs *= 2
+	}
+
 	shards := make([]Database, 0, numShards)
-	sdbBatchTaskCh := make(chan sdbBatchTask, numShards*2)
-	sdbLevelDBCacheSize := dbc.LevelDBCacheSize / int(numShards)
-	sdbOpenFilesLimit := dbc.OpenFilesLimit / int(numShards)
-	sdbRocksDBCacheSize := GetDefaultRocksDBConfig().CacheSize / uint64(numShards)
-	sdbRocksDBMaxOpenFiles := GetDefaultRocksDBConfig().MaxOpenFiles / int(numShards)
+	sdbBatchTaskCh := make(chan sdbBatchTask, numDBs*2)
+	sdbLevelDBCacheSize := dbc.LevelDBCacheSize / int(numDBs)
+	sdbOpenFilesLimit := dbc.OpenFilesLimit / int(numDBs)
+	sdbRocksDBCacheSize := GetDefaultRocksDBConfig().CacheSize / uint64(numDBs)
+	sdbRocksDBMaxOpenFiles := GetDefaultRocksDBConfig().MaxOpenFiles / int(numDBs)
 	if dbc.RocksDBConfig != nil {
-		sdbRocksDBCacheSize = dbc.RocksDBConfig.CacheSize / uint64(numShards)
-		sdbRocksDBMaxOpenFiles = dbc.RocksDBConfig.MaxOpenFiles / int(numShards)
+		sdbRocksDBCacheSize = dbc.RocksDBConfig.CacheSize / uint64(numDBs)
+		sdbRocksDBMaxOpenFiles = dbc.RocksDBConfig.MaxOpenFiles / int(numDBs)
+	}
+	var mirrors []Database
+	if dbc.ShardMirror {
+		mirrors = make([]Database, 0, numShards)
 	}
 	for i := 0; i < int(numShards); i++ {
 		copiedDBC := *dbc
@@ -85,6 +108,12 @@ func newShardedDB(dbc *DBConfig, et DBEntryType, numShards uint) (*shardedDB, er
 			copiedDBC.RocksDBConfig.CacheSize = sdbRocksDBCacheSize
 			copiedDBC.RocksDBConfig.MaxOpenFiles = sdbRocksDBMaxOpenFiles
 		}
+		if dbc.PebbleDBConfig != nil {
+			copiedPebbleDBConfig := *dbc.PebbleDBConfig
+			copiedPebbleDBConfig.CacheSize /= uint64(numDBs)
+			copiedPebbleDBConfig.MaxOpenFiles /= int(numDBs)
+			copiedDBC.PebbleDBConfig = &copiedPebbleDBConfig
+		}
 
 		db, err := newDatabase(&copiedDBC, et)
 		if err != nil {
@@ -92,13 +121,43 @@ func newShardedDB(dbc *DBConfig, et DBEntryType, numShards uint) (*shardedDB, er
 		}
 		shards = append(shards, db)
 		go batchWriteWorker(sdbBatchTaskCh)
+
+		if dbc.ShardMirror {
+			copiedDBC.Dir = shardMirrorDir(dbc.Dir, i, numShards)
+			mirror, err := newDatabase(&copiedDBC, et)
+			if err != nil {
+				return nil, err
+			}
+			mirrors = append(mirrors, mirror)
+			go batchWriteWorker(sdbBatchTaskCh)
+		}
 	}
 
-	logger.Info("Created a sharded database", "dbType", et, "numShards", numShards)
-	return &shardedDB{
-		fn: dbc.Dir, shards: shards,
+	if dbc.ShardMirror && numShards == 1 {
+		logger.Warn("The only shard and its mirror are stored in the same directory", "dir", dbc.Dir)
+	}
+	logger.Info("Created a sharded database", "dbType", et, "numShards", numShards, "mirror", dbc.ShardMirror)
+	db := &shardedDB{
+		fn: dbc.Dir, shards: shards, mirrors: mirrors,
 		numShards: numShards, sdbBatchTaskCh: sdbBatchTaskCh,
-	}, nil
+		scrubLock:        &sync.RWMutex{},
+		scrubQuitCh:      make(chan struct{}),
+		scrubWg:          &sync.WaitGroup{},
+		mirrorReadMeter:  &metrics.NilMeter{},
+		scrubRepairMeter: &metrics.NilMeter{},
+	}
+	if dbc.ShardMirror && !dbc.ReadOnly && dbc.ShardScrubInterval > 0 {
+		db.scrubWg.Add(1)
+		go db.runScrubber(dbc.ShardScrubInterval)
+	}
+	return db, nil
+}
+
+// shardMirrorDir returns the directory of the mirror of the given shard,
+// which is "<dir>/<next shard>/mirror-<shard>".
+func shardMirrorDir(dir string, shardIndex int, numShards uint) string {
+	next := (shardIndex + 1) % int(numShards)
+	return path.Join(dir, strconv.Itoa(next), "mirror-"+strconv.Itoa(shardIndex))
 }
 
 // batchWriteWorker executes passed batch tasks.
@@ -132,44 +191,87 @@ func (db *shardedDB) getShardByKey(key []byte) (Database, error) {
 	}
 }
 
+// Put writes the item to the mirror first if mirroring is enabled,
+// so that an item missing in the shard can be restored from the mirror.
 func (db *shardedDB) Put(key []byte, value []byte) error {
-	if shard, err := db.getShardByKey(key); err != nil {
+	shardIndex, err := shardIndexByKey(key, db.numShards)
+	if err != nil {
+		return err
+	}
+	if db.mirrors == nil {
+		return db.shards[shardIndex].Put(key, value)
+	}
+
+	db.scrubLock.RLock()
+	defer db.scrubLock.RUnlock()
+
+	if err := db.mirrors[shardIndex].Put(key, encodeMirrorValue(key, value)); err != nil {
 		return err
-	} else {
-		return shard.Put(key, value)
 	}
+	return db.shards[shardIndex].Put(key, value)
 }
 
+// Get reads the item from the mirror if the shard fails to read it.
 func (db *shardedDB) Get(key []byte) ([]byte, error) {
-	if shard, err := db.getShardByKey(key); err != nil {
+	shardIndex, err := shardIndexByKey(key, db.numShards)
+	if err != nil {
 		return nil, err
-	} else {
-		return shard.Get(key)
 	}
+	value, err := db.shards[shardIndex].Get(key)
+	if err == nil || db.mirrors == nil {
+		return value, err
+	}
+	return db.getFromMirror(shardIndex, key, err)
 }
 
 func (db *shardedDB) Has(key []byte) (bool, error) {
-	if shard, err := db.getShardByKey(key); err != nil {
+	shardIndex, err := shardIndexByKey(key, db.numShards)
+	if err != nil {
 		return false, err
-	} else {
-		return shard.Has(key)
 	}
+	exist, err := db.shards[shardIndex].Has(key)
+	if (exist && err == nil) || db.mirrors == nil {
+		return exist, err
+	}
+	if mirrorExist, mirrorErr := db.mirrors[shardIndex].Has(key); mirrorErr == nil && mirrorExist {
+		db.mirrorReadMeter.Mark(1)
+		return true, nil
+	}
+	return exist, err
 }
 
+// Delete removes the item from the mirror first if mirroring is enabled,
+// so that a deleted item is not restored from the mirror.
 func (db *shardedDB) Delete(key []byte) error {
-	if shard, err := db.getShardByKey(key); err != nil {
+	shardIndex, err := shardIndexByKey(key, db.numShards)
+	if err != nil {
 		return err
-	} else {
-		return shard.Delete(key)
 	}
+	if db.mirrors == nil {
+		return db.shards[shardIndex].Delete(key)
+	}
+
+	db.scrubLock.RLock()
+	defer db.scrubLock.RUnlock()
+
+	if err := db.mirrors[shardIndex].Delete(key); err != nil {
+		return err
+	}
+	return db.shards[shardIndex].Delete(key)
 }
 
 func (db *shardedDB) Close() {
+	close(db.scrubQuitCh)
+	db.scrubWg.Wait()
+
 	close(db.sdbBatchTaskCh)
 
 	for _, shard := range db.shards {
 		shard.Close()
 	}
+	for _, mirror := range db.mirrors {
+		mirror.Close()
+	}
 }
 
 // Not enough size of channel slows down the iterator
@@ -422,9 +524,18 @@ func (db *shardedDB) NewBatch() Batch {
 		batches = append(batches, db.shards[i].NewBatch())
 	}
 
+	var mirrorBatches []Batch
+	if db.mirrors != nil {
+		mirrorBatches = make([]Batch, 0, db.numShards)
+		for i := 0; i < int(db.numShards); i++ {
+			mirrorBatches = append(mirrorBatches, db.mirrors[i].NewBatch())
+		}
+	}
+
 	return &shardedDBBatch{
-		batches: batches, numBatches: db.numShards,
-		taskCh: db.sdbBatchTaskCh, resultCh: make(chan sdbBatchResult, db.numShards),
+		batches: batches, mirrorBatches: mirrorBatches, numBatches: db.numShards,
+		scrubLock: db.scrubLock,
+		taskCh:    db.sdbBatchTaskCh, resultCh: make(chan sdbBatchResult, db.numShards),
 	}
 }
 
@@ -436,6 +547,13 @@ func (db *shardedDB) Meter(prefix string) {
 	for index, shard := range db.shards {
 		shard.Meter(prefix + strconv.Itoa(index))
 	}
+	for index, mirror := range db.mirrors {
+		mirror.Meter(prefix + strconv.Itoa(index) + "-mirror")
+	}
+	if db.mirrors != nil {
+		db.mirrorReadMeter = metrics.NewRegisteredMeter(prefix+"mirror/read", nil)
+		db.scrubRepairMeter = metrics.NewRegisteredMeter(prefix+"mirror/repair", nil)
+	}
 }
 
 func (db *shardedDB) GetProperty(name string) string {
@@ -456,8 +574,10 @@ func (db *shardedDB) TryCatchUpWithPrimary() error {
 }
 
 type shardedDBBatch struct {
-	batches    []Batch
-	numBatches uint
+	batches       []Batch
+	mirrorBatches []Batch // nil if mirroring is disabled
+	numBatches    uint
+	scrubLock     *sync.RWMutex
 
 	taskCh   chan sdbBatchTask
 	resultCh chan sdbBatchResult
@@ -467,6 +587,11 @@ func (sdbBatch *shardedDBBatch) Put(key []byte, value []byte) error {
 	if ShardIndex, err := shardIndexByKey(key, sdbBatch.numBatches); err != nil {
 		return err
 	} else {
+		if sdbBatch.mirrorBatches != nil {
+			if err := sdbBatch.mirrorBatches[ShardIndex].Put(key, encodeMirrorValue(key, value)); err != nil {
+				return err
+			}
+		}
 		return sdbBatch.batches[ShardIndex].Put(key, value)
 	}
 }
@@ -475,6 +600,11 @@ func (sdbBatch *shardedDBBatch) Delete(key []byte) error {
 	if ShardIndex, err := shardIndexByKey(key, sdbBatch.numBatches); err != nil {
 		return err
 	} else {
+		if sdbBatch.mirrorBatches != nil {
+			if err := sdbBatch.mirrorBatches[ShardIndex].Delete(key); err != nil {
+				return err
+			}
+		}
 		return sdbBatch.batches[ShardIndex].Delete(key)
 	}
 }
@@ -494,13 +624,28 @@ func (sdbBatch *shardedDBBatch) ValueSize() int {
 
 // Write passes the list of batch tasks to taskCh so batch can be processed
 // by underlying workers. Write waits until all workers return the result.
+// If mirroring is enabled, the mirror batches are written before the shard batches.
 func (sdbBatch *shardedDBBatch) Write() error {
-	for index, batch := range sdbBatch.batches {
+	if sdbBatch.mirrorBatches == nil {
+		return sdbBatch.writeBatches(sdbBatch.batches)
+	}
+
+	sdbBatch.scrubLock.RLock()
+	defer sdbBatch.scrubLock.RUnlock()
+
+	if err := sdbBatch.writeBatches(sdbBatch.mirrorBatches); err != nil {
+		return err
+	}
+	return sdbBatch.writeBatches(sdbBatch.batches)
+}
+
+func (sdbBatch *shardedDBBatch) writeBatches(batches []Batch) error {
+	for index, batch := range batches {
 		sdbBatch.taskCh <- sdbBatchTask{batch, index, sdbBatch.resultCh}
 	}
 
 	var err error
-	for range sdbBatch.batches {
+	for range batches {
 		if batchResult := <-sdbBatch.resultCh; batchResult.err != nil {
 			logger.Error("Error while writing sharded batch", "index", batchResult.index, "err", batchResult.err)
 			err = batchResult.err
@@ -514,12 +659,18 @@ func (sdbBatch *shardedDBBatch) Reset() {
 	for _, batch := range sdbBatch.batches {
 		batch.Reset()
 	}
+	for _, batch := range sdbBatch.mirrorBatches {
+		batch.Reset()
+	}
 }
 
 func (sdbBatch *shardedDBBatch) Release() {
 	for _, batch := range sdbBatch.batches {
 		batch.Release()
 	}
+	for _, batch := range sdbBatch.mirrorBatches {
+		batch.Release()
+	}
 }
 
 func (sdbBatch *shardedDBBatch) Replay(w KeyValueWriter) error {
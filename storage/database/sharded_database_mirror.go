@@ -0,0 +1,277 @@
+// Copyright 2024 The klaytn Authors
+// This file is part of the klaytn library.
+//
+// The klaytn library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The klaytn library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the klaytn library. If not, see <http://www.gnu.org/licenses/>.
+
+package database
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"hash/crc32"
+	"time"
+)
+
+var (
+	errNotShardedDB        = errors.New("database is not sharded")
+	errShardMirrorDisabled = errors.New("shard mirror is not enabled")
+	errShardScrubStopped   = errors.New("shard scrubbing is stopped")
+)
+
+const (
+	mirrorChecksumLen    = 4
+	shardScrubChunkItems = 1024 // Number of items verified at once while holding the scrub lock
+)
+
+var mirrorChecksumTable = crc32.MakeTable(crc32.Castagnoli)
+
+// ShardVerifyResult is the result of verifying a shard against its mirror.
+type ShardVerifyResult struct {
+	Shard          int
+	PrimaryEntries uint64 // Number of items in the shard
+	MirrorEntries  uint64 // Number of items in the mirror
+	MissingPrimary uint64 // Items only in the mirror
+	CorruptPrimary uint64 // Items whose value differs from the mirror
+	MissingMirror  uint64 // Items only in the shard
+	CorruptMirror  uint64 // Items whose checksum in the mirror is invalid
+	Unrecoverable  uint64 // Items whose checksum in the mirror is invalid and missing in the shard
+	Repaired       uint64
+}
+
+// Issues returns the number of the items which are missing or corrupt.
+func (r ShardVerifyResult) Issues() uint64 {
+	return r.MissingPrimary + r.CorruptPrimary + r.MissingMirror + r.CorruptMirror + r.Unrecoverable
+}
+
+// mirrorChecksum returns the checksum of an item stored in a mirror.
+func mirrorChecksum(key, value []byte) uint32 {
+	h := crc32.New(mirrorChecksumTable)
+	h.Write(key)
+	h.Write(value)
+	return h.Sum32()
+}
+
+// encodeMirrorValue appends the checksum of the item to the value.
+func encodeMirrorValue(key, value []byte) []byte {
+	enc := make([]byte, len(value)+mirrorChecksumLen)
+	copy(enc, value)
+	binary.BigEndian.PutUint32(enc[len(value):], mirrorChecksum(key, value))
+	return enc
+}
+
+// decodeMirrorValue returns the value of an item stored in a mirror.
+// It returns false if the checksum does not match.
+func decodeMirrorValue(key, enc []byte) ([]byte, bool) {
+	if len(enc) < mirrorChecksumLen {
+		return nil, false
+	}
+	value := enc[:len(enc)-mirrorChecksumLen]
+	if binary.BigEndian.Uint32(enc[len(value):]) != mirrorChecksum(key, value) {
+		return nil, false
+	}
+	return value, true
+}
+
+// getFromMirror reads an item from the mirror of the shard when the shard
+// failed to read it with shardErr.
+func (db *shardedDB) getFromMirror(shardIndex int, key []byte, shardErr error) ([]byte, error) {
+	enc, err := db.mirrors[shardIndex].Get(key)
+	if err != nil {
+		return nil, shardErr
+	}
+	value, ok := decodeMirrorValue(key, enc)
+	if !ok {
+		logger.Error("Corrupt item in shard mirror", "db", db.fn, "shard", shardIndex, "key", key)
+		return nil, shardErr
+	}
+	logger.Warn("Read an item from shard mirror", "db", db.fn, "shard", shardIndex, "key", key, "shardErr", shardErr)
+	db.mirrorReadMeter.Mark(1)
+	return value, nil
+}
+
+// VerifyShards compares every shard of a sharded database with its mirror.
+// If repair is true, missing or corrupt items are restored from the other side.
+// An item missing in the shard is restored from the mirror, since items are
+// written to the mirror first.
+func VerifyShards(db Database, repair bool) ([]ShardVerifyResult, error) {
+	sdb, ok := db.(*shardedDB)
+	if !ok {
+		return nil, errNotShardedDB
+	}
+	return sdb.verifyShards(repair, nil)
+}
+
+func (db *shardedDB) verifyShards(repair bool, quit <-chan struct{}) ([]ShardVerifyResult, error) {
+	if db.mirrors == nil {
+		return nil, errShardMirrorDisabled
+	}
+
+	results := make([]ShardVerifyResult, len(db.shards))
+	for i := range db.shards {
+		results[i].Shard = i
+		if err := db.verifyShard(&results[i], repair, quit); err != nil {
+			return results, err
+		}
+	}
+	return results, nil
+}
+
+// verifyShard checks the items of the mirror and then the items of the shard.
+func (db *shardedDB) verifyShard(result *ShardVerifyResult, repair bool, quit <-chan struct{}) error {
+	shard, mirror := db.shards[result.Shard], db.mirrors[result.Shard]
+
+	checkMirror := func(key []byte) error {
+		enc, err := mirror.Get(key)
+		if err == dataNotFoundErr {
+			return nil // deleted after the iterator is created
+		} else if err != nil {
+			return err
+		}
+		stored, err := shard.Get(key)
+		if err != nil && err != dataNotFoundErr {
+			return err
+		}
+		shardMissing := err == dataNotFoundErr
+
+		value, ok := decodeMirrorValue(key, enc)
+		switch {
+		case ok && shardMissing:
+			result.MissingPrimary++
+			return db.repairItem(result, repair, shard, key, value)
+		case ok && !bytes.Equal(stored, value):
+			result.CorruptPrimary++
+			return db.repairItem(result, repair, shard, key, value)
+		case !ok && !shardMissing:
+			result.CorruptMirror++
+			return db.repairItem(result, repair, mirror, key, encodeMirrorValue(key, stored))
+		case !ok:
+			result.Unrecoverable++
+			logger.Error("Unrecoverable item in shard mirror", "db", db.fn, "shard", result.Shard, "key", key)
+		}
+		return nil
+	}
+	if err := db.scanShard(mirror, &result.MirrorEntries, checkMirror, quit); err != nil {
+		return err
+	}
+
+	checkShard := func(key []byte) error {
+		if exist, err := mirror.Has(key); err != nil {
+			return err
+		} else if exist {
+			return nil
+		}
+		stored, err := shard.Get(key)
+		if err == dataNotFoundErr {
+			return nil // deleted after the iterator is created
+		} else if err != nil {
+			return err
+		}
+		result.MissingMirror++
+		return db.repairItem(result, repair, mirror, key, encodeMirrorValue(key, stored))
+	}
+	return db.scanShard(shard, &result.PrimaryEntries, checkShard, quit)
+}
+
+// scanShard calls check for every key of the given database. Keys are checked
+// in chunks while holding the scrub lock so that concurrent writes to the
+// shard and the mirror are not interleaved with checks.
+func (db *shardedDB) scanShard(target Database, count *uint64, check func(key []byte) error, quit <-chan struct{}) error {
+	it := target.NewIterator(nil, nil)
+	defer it.Release()
+
+	checkKeys := func(keys [][]byte) error {
+		db.scrubLock.Lock()
+		defer db.scrubLock.Unlock()
+
+		for _, key := range keys {
+			if err := check(key); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	keys := make([][]byte, 0, shardScrubChunkItems)
+	for it.Next() {
+		key := make([]byte, len(it.Key()))
+		copy(key, it.Key())
+		keys = append(keys, key)
+		*count++
+		if len(keys) < shardScrubChunkItems {
+			continue
+		}
+
+		if err := checkKeys(keys); err != nil {
+			return err
+		}
+		keys = keys[:0]
+
+		select {
+		case <-quit:
+			return errShardScrubStopped
+		default:
+		}
+	}
+	if err := it.Error(); err != nil {
+		return err
+	}
+	return checkKeys(keys)
+}
+
+func (db *shardedDB) repairItem(result *ShardVerifyResult, repair bool, target Database, key, value []byte) error {
+	if !repair {
+		return nil
+	}
+	if err := target.Put(key, value); err != nil {
+		return err
+	}
+	result.Repaired++
+	db.scrubRepairMeter.Mark(1)
+	return nil
+}
+
+// runScrubber verifies and repairs the shards periodically until Close is called.
+func (db *shardedDB) runScrubber(interval time.Duration) {
+	defer db.scrubWg.Done()
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-db.scrubQuitCh:
+			return
+		case <-ticker.C:
+		}
+
+		start := time.Now()
+		results, err := db.verifyShards(true, db.scrubQuitCh)
+		if err == errShardScrubStopped {
+			return
+		} else if err != nil {
+			logger.Error("Failed to scrub shards", "db", db.fn, "err", err)
+			continue
+		}
+		for _, result := range results {
+			if result.Issues() > 0 {
+				logger.Warn("Repaired shard items", "db", db.fn, "shard", result.Shard,
+					"missingPrimary", result.MissingPrimary, "corruptPrimary", result.CorruptPrimary,
+					"missingMirror", result.MissingMirror, "corruptMirror", result.CorruptMirror,
+					"unrecoverable", result.Unrecoverable, "repaired", result.Repaired)
+			}
+		}
+		logger.Info("Scrubbed shards", "db", db.fn, "elapsed", time.Since(start))
+	}
+}
@@ -0,0 +1,187 @@
+// Copyright 2024 The klaytn Authors
+// This file is part of the klaytn library.
+//
+// The klaytn library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The klaytn library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the klaytn library. If not, see <http://www.gnu.org/licenses/>.
+
+package database
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newTestMirroredShardedDB returns a sharded database with 4 shards and their mirrors.
+// The first byte of a key is its shard index.
+func newTestMirroredShardedDB(t *testing.T, scrubInterval time.Duration) *shardedDB {
+	db, err := newShardedDB(&DBConfig{DBType: MemoryDB, ShardMirror: true, ShardScrubInterval: scrubInterval}, StateTrieDB, 4)
+	require.NoError(t, err)
+	require.Len(t, db.mirrors, 4)
+	return db
+}
+
+func TestShardedDBMirror_ReadFallback(t *testing.T) {
+	db := newTestMirroredShardedDB(t, 0)
+	defer db.Close()
+
+	keys := [][]byte{{0, 1}, {1, 1}, {1, 2}, {2, 1}}
+	assert.NoError(t, db.Put(keys[0], []byte("v0")))
+	batch := db.NewBatch()
+	for i, key := range keys[1:] {
+		assert.NoError(t, batch.Put(key, []byte{byte(i + 1)}))
+	}
+	assert.NoError(t, batch.Write())
+
+	// Lose shard 1.
+	db.shards[1] = NewMemDB()
+	value, err := db.Get(keys[1])
+	assert.NoError(t, err)
+	assert.Equal(t, []byte{1}, value)
+	exist, err := db.Has(keys[2])
+	assert.NoError(t, err)
+	assert.True(t, exist)
+
+	// Deleted items are not read from the mirror.
+	assert.NoError(t, db.Delete(keys[1]))
+	_, err = db.Get(keys[1])
+	assert.Equal(t, dataNotFoundErr, err)
+
+	// Corrupt items in the mirror are not read.
+	assert.NoError(t, db.mirrors[1].Put(keys[2], []byte{2, 0, 0, 0, 0}))
+	_, err = db.Get(keys[2])
+	assert.Equal(t, dataNotFoundErr, err)
+}
+
+func TestShardedDBMirror_Verify(t *testing.T) {
+	db := newTestMirroredShardedDB(t, 0)
+	defer db.Close()
+
+	for i := 0; i < 4; i++ {
+		for j := 0; j < 3; j++ {
+			assert.NoError(t, db.Put([]byte{byte(i), byte(j)}, []byte{byte(j)}))
+		}
+	}
+
+	results, err := VerifyShards(db, false)
+	require.NoError(t, err)
+	for _, result := range results {
+		assert.Equal(t, uint64(3), result.PrimaryEntries)
+		assert.Equal(t, uint64(3), result.MirrorEntries)
+		assert.Zero(t, result.Issues())
+	}
+
+	db.shards[0] = NewMemDB()                                         // missing in shard 0
+	assert.NoError(t, db.shards[1].Put([]byte{1, 0}, []byte("bad")))  // corrupt in shard 1
+	assert.NoError(t, db.mirrors[2].Delete([]byte{2, 0}))             // missing in mirror 2
+	assert.NoError(t, db.mirrors[3].Put([]byte{3, 0}, []byte("bad"))) // corrupt in mirror 3
+	assert.NoError(t, db.mirrors[3].Put([]byte{3, 1}, []byte("bad")))
+	assert.NoError(t, db.shards[3].Delete([]byte{3, 1})) // unrecoverable
+
+	results, err = VerifyShards(db, false)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(3), results[0].MissingPrimary)
+	assert.Equal(t, uint64(1), results[1].CorruptPrimary)
+	assert.Equal(t, uint64(1), results[2].MissingMirror)
+	assert.Equal(t, uint64(1), results[3].CorruptMirror)
+	assert.Equal(t, uint64(1), results[3].Unrecoverable)
+	for _, result := range results {
+		assert.Zero(t, result.Repaired)
+	}
+
+	results, err = VerifyShards(db, true)
+	require.NoError(t, err)
+	assert.Equal(t, []uint64{3, 1, 1, 1}, []uint64{results[0].Repaired, results[1].Repaired, results[2].Repaired, results[3].Repaired})
+
+	results, err = VerifyShards(db, false)
+	require.NoError(t, err)
+	for _, result := range results[:3] {
+		assert.Zero(t, result.Issues())
+	}
+	assert.Equal(t, uint64(1), results[3].Issues())
+
+	value, err := db.shards[1].Get([]byte{1, 0})
+	assert.NoError(t, err)
+	assert.Equal(t, []byte{0}, value)
+	value, err = db.shards[0].Get([]byte{0, 2})
+	assert.NoError(t, err)
+	assert.Equal(t, []byte{2}, value)
+
+	_, err = VerifyShards(NewMemDB(), false)
+	assert.Equal(t, errNotShardedDB, err)
+	noMirrorDB, err := newShardedDB(&DBConfig{DBType: MemoryDB}, StateTrieDB, 2)
+	require.NoError(t, err)
+	defer noMirrorDB.Close()
+	_, err = VerifyShards(noMirrorDB, false)
+	assert.Equal(t, errShardMirrorDisabled, err)
+}
+
+func TestShardedDBMirror_Scrubber(t *testing.T) {
+	db := newTestMirroredShardedDB(t, 10*time.Millisecond)
+	defer db.Close()
+
+	assert.NoError(t, db.Put([]byte{1, 1}, []byte{1}))
+	assert.NoError(t, db.shards[1].Delete([]byte{1, 1}))
+	for i := 0; i < 500; i++ {
+		if ok, _ := db.shards[1].Has([]byte{1, 1}); ok {
+			return
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	t.Fatal("shard is not repaired by the scrubber")
+}
+
+func TestShardedDBMirror_Dir(t *testing.T) {
+	dir, err := os.MkdirTemp("", "klay_sharded_mirror_")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	dbc := &DBConfig{Dir: dir, DBType: LevelDB, LevelDBCacheSize: 16, OpenFilesLimit: 16, ShardMirror: true}
+	db, err := newShardedDB(dbc, StateTrieDB, 2)
+	require.NoError(t, err)
+	assert.NoError(t, db.Put([]byte{0}, []byte{0}))
+	assert.NoError(t, db.Put([]byte{1}, []byte{1}))
+	db.Close()
+
+	// The mirror of a shard is in the directory of the next shard.
+	for _, name := range []string{"0", "1/mirror-0", "1", "0/mirror-1"} {
+		assert.DirExists(t, filepath.Join(dir, name))
+	}
+
+	// Losing the directory of shard 1 loses the mirror of shard 0 as well,
+	// but the items of both shards are still readable and repaired.
+	require.NoError(t, os.RemoveAll(filepath.Join(dir, "1")))
+	db, err = newShardedDB(dbc, StateTrieDB, 2)
+	require.NoError(t, err)
+	defer db.Close()
+	value, err := db.Get([]byte{1})
+	assert.NoError(t, err)
+	assert.Equal(t, []byte{1}, value)
+	value, err = db.Get([]byte{0})
+	assert.NoError(t, err)
+	assert.Equal(t, []byte{0}, value)
+
+	results, err := VerifyShards(db, true)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(1), results[0].MissingMirror)
+	assert.Equal(t, uint64(1), results[1].MissingPrimary)
+	results, err = VerifyShards(db, false)
+	require.NoError(t, err)
+	for _, result := range results {
+		assert.Zero(t, result.Issues())
+	}
+}
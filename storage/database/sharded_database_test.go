@@ -32,6 +32,7 @@ import (
 
 var ShardedDBConfig = []*DBConfig{
 	{DBType: LevelDB, SingleDB: false, NumStateTrieShards: 2, ParallelDBWrite: true},
+	{DBType: PebbleDB, SingleDB: false, NumStateTrieShards: 2, ParallelDBWrite: true},
 	{DBType: LevelDB, SingleDB: false, NumStateTrieShards: 4, ParallelDBWrite: true},
 }
 
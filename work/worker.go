@@ -448,6 +448,12 @@ func (self *worker) wait(TxResendUseLegacy bool) {
 				if err := istanbul.UpdateParam(block.NumberU64()); err != nil {
 					logger.Error("Failed to update governance parameters", "err", err)
 				}
+				// store the treasury rebalance result only when the block is written on the canonical chain
+				if result.Status == blockchain.CanonStatTy {
+					if err := istanbul.WriteTreasuryRebalanceResult(block); err != nil {
+						logger.Warn("Failed to store treasury rebalancing result", "number", block.Number(), "err", err)
+					}
+				}
 			}
 
 			logger.Info("Successfully wrote mined block", "num", block.NumberU64(),